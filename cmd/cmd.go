@@ -441,7 +441,8 @@ func makeLocalGenesis(w wallet.Wallet) *genesis.Genesis {
 
 	// create genesis
 	params := param.DefaultParams()
-	params.BlockVersion = 0
+	// The local network activates all the features from the genesis.
+	params.BlockVersion = param.BlockVersionV2
 	gen := genesis.MakeGenesis(util.RoundNow(60), accs, vals, params)
 
 	return gen
@@ -473,10 +474,13 @@ func MakeConfig(genDoc *genesis.Genesis, confPath, walletsDir string) (*config.C
 	}
 
 	// Now we can update the private filed, if any
-	genParams := genDoc.Params()
-
-	conf.Store.TxCacheSize = genParams.TransactionToLiveInterval
-	conf.Store.SortitionCacheSize = genParams.SortitionInterval
+	// The caches should be large enough for the parameters after all the upgrades.
+	conf.Store.TxCacheSize = 0
+	conf.Store.SortitionCacheSize = 0
+	for _, params := range genDoc.ParamsHistory() {
+		conf.Store.TxCacheSize = max(conf.Store.TxCacheSize, params.TransactionToLiveInterval)
+		conf.Store.SortitionCacheSize = max(conf.Store.SortitionCacheSize, params.SortitionInterval)
+	}
 	conf.Store.AccountCacheSize = 1024
 	conf.Store.PublicKeyCacheSize = 1024
 
@@ -23,6 +23,10 @@ func main() {
 	buildVersionCmd(rootCmd)
 	buildInitCmd(rootCmd)
 	buildStartCmd(rootCmd)
+	buildSnapshotCmd(rootCmd)
+	buildVerifyCmd(rootCmd)
+	buildReplayCmd(rootCmd)
+	buildRollbackCmd(rootCmd)
 
 	err := rootCmd.Execute()
 	if err != nil {
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"errors"
+	"os"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/store"
+	"github.com/spf13/cobra"
+)
+
+// buildReplayCmd builds a sub-command to re-execute all the blocks and detect the state divergence.
+func buildReplayCmd(parentCmd *cobra.Command) {
+	replayCmd := &cobra.Command{
+		Use:   "replay",
+		Short: "re-execute all the blocks from the genesis and report the first state divergence",
+	}
+
+	parentCmd.AddCommand(replayCmd)
+
+	workingDirOpt := replayCmd.Flags().StringP("working-dir", "w", cmd.PactusDefaultHomeDir(),
+		"the path to the working directory of the node")
+
+	replayCmd.Run = func(_ *cobra.Command, _ []string) {
+		gen, storeConf := loadStoreConfig(*workingDirOpt)
+		storeConf.ReadOnly = true
+
+		str, err := store.NewStore(storeConf)
+		cmd.FatalErrorCheck(err)
+		defer func() { _ = str.Close() }()
+
+		lastHeight := uint32(0)
+		if lastCert := str.LastCertificate(); lastCert != nil {
+			lastHeight = lastCert.Height()
+		}
+
+		cmd.PrintInfoMsgf("Replaying %v blocks in %v", lastHeight, storeConf.StorePath())
+		replayed := uint32(0)
+		err = state.Replay(gen, str, func(height uint32) {
+			replayed = height
+			if height%10000 == 0 {
+				cmd.PrintInfoMsgf("Replayed %v/%v blocks", height, lastHeight)
+			}
+		})
+
+		cmd.PrintLine()
+		cmd.PrintInfoMsgf("Replayed blocks: %v", replayed)
+		var divergenceErr state.ReplayDivergenceError
+		if errors.As(err, &divergenceErr) {
+			cmd.PrintErrorMsgf("First divergent height: %v", divergenceErr.Height)
+			cmd.PrintErrorMsgf("Reason: %v", divergenceErr.Reason)
+			for _, trx := range divergenceErr.Transactions {
+				cmd.PrintErrorMsgf("Transaction %v: %v", trx.ID, trx.Reason)
+			}
+			cmd.PrintLine()
+			cmd.PrintErrorMsgf("The replayed state diverges from the blockchain data")
+
+			os.Exit(1)
+		}
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintLine()
+		cmd.PrintSuccessMsgf("All the blocks are replayed without divergence")
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/store"
+	"github.com/spf13/cobra"
+)
+
+// buildRollbackCmd builds a sub-command to roll back the blockchain to a previous height.
+func buildRollbackCmd(parentCmd *cobra.Command) {
+	rollbackCmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "roll back the blockchain state to a previous height",
+	}
+
+	parentCmd.AddCommand(rollbackCmd)
+
+	workingDirOpt := rollbackCmd.Flags().StringP("working-dir", "w", cmd.PactusDefaultHomeDir(),
+		"the path to the working directory of the node")
+
+	heightOpt := rollbackCmd.Flags().Uint32("height", 0,
+		"the height to roll back to, the blocks after this height will be deleted")
+
+	noConfirmOpt := rollbackCmd.Flags().Bool("no-confirm", false,
+		"no confirmation question")
+
+	_ = rollbackCmd.MarkFlagRequired("height")
+
+	rollbackCmd.Run = func(_ *cobra.Command, _ []string) {
+		_, storeConf := loadStoreConfig(*workingDirOpt)
+
+		if !*noConfirmOpt {
+			cmd.PrintWarnMsgf("You are going to delete all the blocks after height %v", *heightOpt)
+			cmd.PrintWarnMsgf("THIS ACTION IS NOT REVERSIBLE")
+			confirmed := cmd.PromptConfirm("Do you want to continue")
+			if !confirmed {
+				return
+			}
+		}
+
+		info, err := store.Rollback(storeConf, *heightOpt)
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintLine()
+		cmd.PrintInfoMsgf("From height: %v", info.FromHeight)
+		cmd.PrintInfoMsgf("To height: %v", info.ToHeight)
+		cmd.PrintInfoMsgf("Block hash: %v", info.BlockHash)
+		cmd.PrintLine()
+		cmd.PrintSuccessMsgf("Blockchain is successfully rolled back to height %v", info.ToHeight)
+	}
+}
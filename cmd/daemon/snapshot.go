@@ -0,0 +1,155 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/spf13/cobra"
+)
+
+// buildSnapshotCmd builds a sub-command to export or import a snapshot of the blockchain state.
+func buildSnapshotCmd(parentCmd *cobra.Command) {
+	snapshotCmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "export or import a snapshot of the blockchain state",
+	}
+
+	parentCmd.AddCommand(snapshotCmd)
+
+	buildSnapshotExportCmd(snapshotCmd)
+	buildSnapshotImportCmd(snapshotCmd)
+}
+
+// buildSnapshotExportCmd builds a sub-command to export a snapshot of the blockchain state.
+func buildSnapshotExportCmd(parentCmd *cobra.Command) {
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "export a snapshot of the blockchain state into a file",
+	}
+
+	parentCmd.AddCommand(exportCmd)
+
+	workingDirOpt := exportCmd.Flags().StringP("working-dir", "w", cmd.PactusDefaultHomeDir(),
+		"the path to the working directory of the node")
+
+	fileOpt := exportCmd.Flags().StringP("file", "f", "pactus.snapshot",
+		"the path to the snapshot file")
+
+	exportCmd.Run = func(_ *cobra.Command, _ []string) {
+		snapshotPath, _ := filepath.Abs(*fileOpt)
+		gen, storeConf := loadStoreConfig(*workingDirOpt)
+
+		file, err := os.Create(snapshotPath)
+		cmd.FatalErrorCheck(err)
+
+		info, err := store.ExportSnapshot(storeConf, gen.Hash(), file)
+		cmd.FatalErrorCheck(err)
+		cmd.FatalErrorCheck(file.Close())
+
+		cmd.PrintLine()
+		cmd.PrintInfoMsgf("Height: %v", info.Height)
+		cmd.PrintInfoMsgf("State root: %v", info.StateRoot)
+		cmd.PrintInfoMsgf("Accounts root: %v", info.AccountsRoot)
+		cmd.PrintInfoMsgf("Number of blocks: %v", info.NumBlocks)
+		cmd.PrintLine()
+		cmd.PrintInfoMsgf("The state root should match the state root of the block at height %v.", info.Height+1)
+		cmd.PrintInfoMsgf("The accounts root binds the accounts to their addresses and is needed for importing.")
+		cmd.PrintSuccessMsgf("Snapshot is successfully exported to %v", snapshotPath)
+	}
+}
+
+// buildSnapshotImportCmd builds a sub-command to import a snapshot of the blockchain state.
+func buildSnapshotImportCmd(parentCmd *cobra.Command) {
+	importCmd := &cobra.Command{
+		Use:   "import",
+		Short: "import a snapshot of the blockchain state from a file",
+	}
+
+	parentCmd.AddCommand(importCmd)
+
+	workingDirOpt := importCmd.Flags().StringP("working-dir", "w", cmd.PactusDefaultHomeDir(),
+		"the path to the working directory of the node")
+
+	fileOpt := importCmd.Flags().StringP("file", "f", "pactus.snapshot",
+		"the path to the snapshot file")
+
+	stateRootOpt := importCmd.Flags().String("state-root", "",
+		"the trusted state root, which is the state root of the block after the snapshot height")
+	_ = importCmd.MarkFlagRequired("state-root")
+
+	accountsRootOpt := importCmd.Flags().String("accounts-root", "",
+		"the trusted accounts root, which is reported by exporting a snapshot at the same height on a trusted node")
+	_ = importCmd.MarkFlagRequired("accounts-root")
+
+	importCmd.Run = func(_ *cobra.Command, _ []string) {
+		snapshotPath, _ := filepath.Abs(*fileOpt)
+		gen, storeConf := loadStoreConfig(*workingDirOpt)
+
+		stateRoot, err := hash.FromString(*stateRootOpt)
+		cmd.FatalErrorCheck(err)
+
+		accountsRoot, err := hash.FromString(*accountsRootOpt)
+		cmd.FatalErrorCheck(err)
+
+		file, err := os.Open(snapshotPath)
+		cmd.FatalErrorCheck(err)
+		defer func() { _ = file.Close() }()
+
+		info, err := store.ImportSnapshot(storeConf, gen.Hash(), stateRoot, accountsRoot, file)
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintLine()
+		cmd.PrintInfoMsgf("Height: %v", info.Height)
+		cmd.PrintInfoMsgf("State root: %v", info.StateRoot)
+		cmd.PrintInfoMsgf("Number of blocks: %v", info.NumBlocks)
+		cmd.PrintLine()
+		cmd.PrintSuccessMsgf("Snapshot is successfully imported into %v", storeConf.StorePath())
+	}
+}
+
+// loadStoreConfig loads the genesis and the store configuration of the node.
+// It changes the current directory to the working directory,
+// since the store path is relative to it.
+// Only warnings and errors are logged by the store commands.
+func loadStoreConfig(workingDirOpt string) (*genesis.Genesis, *store.Config) {
+	logConf := logger.DefaultConfig()
+	logConf.Targets = []string{"console"}
+	for module := range logConf.Levels {
+		logConf.Levels[module] = "warn"
+	}
+	logger.InitGlobalLogger(logConf)
+
+	return readStoreConfig(workingDirOpt)
+}
+
+// readStoreConfig is the same as loadStoreConfig, but it doesn't initialize the logger.
+func readStoreConfig(workingDirOpt string) (*genesis.Genesis, *store.Config) {
+	workingDir, _ := filepath.Abs(workingDirOpt)
+	err := os.Chdir(workingDir)
+	cmd.FatalErrorCheck(err)
+
+	gen, err := genesis.LoadFromFile(cmd.PactusGenesisPath(workingDir))
+	cmd.FatalErrorCheck(err)
+
+	if !gen.ChainType().IsMainnet() {
+		crypto.AddressHRP = "tpc"
+		crypto.PublicKeyHRP = "tpublic"
+		crypto.PrivateKeyHRP = "tsecret"
+		crypto.XPublicKeyHRP = "txpublic"
+		crypto.XPrivateKeyHRP = "txsecret"
+	}
+
+	conf, err := cmd.MakeConfig(gen, cmd.PactusConfigPath(workingDir), cmd.PactusWalletDir(workingDir))
+	cmd.FatalErrorCheck(err)
+
+	err = conf.Store.BasicCheck()
+	cmd.FatalErrorCheck(err)
+
+	return gen, conf.Store
+}
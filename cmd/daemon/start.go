@@ -9,6 +9,7 @@ import (
 
 	flock "github.com/gofrs/flock"
 	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/wallet"
 	"github.com/spf13/cobra"
 )
@@ -31,6 +32,9 @@ func buildStartCmd(parentCmd *cobra.Command) {
 	pprofOpt := startCmd.Flags().String("pprof", "",
 		"pprof server address (for debugging)")
 
+	forkBranchOpt := startCmd.Flags().String("fork-branch", "",
+		"the branch to keep if a fork is detected: 'local' or 'remote'")
+
 	startCmd.Run = func(_ *cobra.Command, _ []string) {
 		workingDir, _ := filepath.Abs(*workingDirOpt)
 		// change working directory
@@ -65,6 +69,8 @@ func buildStartCmd(parentCmd *cobra.Command) {
 			}()
 		}
 
+		checkFork(workingDir, *forkBranchOpt)
+
 		passwordFetcher := func(wlt *wallet.Wallet) (string, bool) {
 			if !wlt.IsEncrypted() {
 				return "", true
@@ -93,3 +99,33 @@ func buildStartCmd(parentCmd *cobra.Command) {
 		select {}
 	}
 }
+
+// checkFork resolves a detected fork by keeping the given branch.
+// If no branch is given, the node starts halted and waits for the operator.
+func checkFork(workingDir, branch string) {
+	_, storeConf := readStoreConfig(workingDir)
+	ev, err := store.LoadForkEvidence(storeConf)
+	cmd.FatalErrorCheck(err)
+
+	if ev == nil {
+		if branch != "" {
+			cmd.PrintWarnMsgf("No fork is detected, ignoring the fork branch.")
+		}
+
+		return
+	}
+
+	if branch == "" {
+		cmd.PrintWarnMsgf("A possible fork is detected at height %d.", ev.Height)
+		cmd.PrintWarnMsgf("The evidence is saved in %s.", storeConf.ForkEvidencePath())
+		cmd.PrintWarnMsgf("The node is halted. Restart it with '--fork-branch=local' to keep the local blocks, " +
+			"or with '--fork-branch=remote' to revert the last block and sync with the network.")
+
+		return
+	}
+
+	_, err = store.ResolveFork(storeConf, store.ForkBranch(branch))
+	cmd.FatalErrorCheck(err)
+
+	cmd.PrintSuccessMsgf("The fork at height %d is resolved by keeping the %s branch.", ev.Height, branch)
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"errors"
+	"os"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/store"
+	"github.com/spf13/cobra"
+)
+
+// buildVerifyCmd builds a sub-command to verify the integrity of the blockchain data.
+func buildVerifyCmd(parentCmd *cobra.Command) {
+	verifyCmd := &cobra.Command{
+		Use:   "verify",
+		Short: "verify the integrity of the blockchain data without executing the blocks",
+	}
+
+	parentCmd.AddCommand(verifyCmd)
+
+	workingDirOpt := verifyCmd.Flags().StringP("working-dir", "w", cmd.PactusDefaultHomeDir(),
+		"the path to the working directory of the node")
+
+	verifyCmd.Run = func(_ *cobra.Command, _ []string) {
+		gen, storeConf := loadStoreConfig(*workingDirOpt)
+		storeConf.ReadOnly = true
+
+		str, err := store.NewStore(storeConf)
+		cmd.FatalErrorCheck(err)
+		defer func() { _ = str.Close() }()
+
+		lastHeight := uint32(0)
+		if lastCert := str.LastCertificate(); lastCert != nil {
+			lastHeight = lastCert.Height()
+		}
+
+		cmd.PrintInfoMsgf("Verifying blocks %v to %v in %v", str.PruningHeight()+1, lastHeight, storeConf.StorePath())
+		verified := uint32(0)
+		err = state.Verify(gen, str, func(height uint32) {
+			verified = height
+			if height%10000 == 0 {
+				cmd.PrintInfoMsgf("Verified %v/%v blocks", height, lastHeight)
+			}
+		})
+
+		cmd.PrintLine()
+		cmd.PrintInfoMsgf("Verified blocks: %v", verified)
+		var verifyErr state.VerifyError
+		if errors.As(err, &verifyErr) {
+			cmd.PrintErrorMsgf("First bad height: %v", verifyErr.Height)
+			cmd.PrintErrorMsgf("Reason: %v", verifyErr.Reason)
+			cmd.PrintLine()
+			cmd.PrintErrorMsgf("The blockchain data is corrupted")
+
+			os.Exit(1)
+		}
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintLine()
+		cmd.PrintSuccessMsgf("The blockchain data is verified successfully")
+	}
+}
@@ -122,6 +122,8 @@ func buildUnbondTxCmd(parentCmd *cobra.Command) {
 
 	lockTime, feeOpt, memoOpt, noConfirmOpt := addCommonTxOptions(unbondCmd)
 	passOpt := addPasswordOption(unbondCmd)
+	amountOpt := unbondCmd.Flags().Float64("amount", 0,
+		"amount to unbond in PAC, if not specified the whole stake will be unbonded")
 
 	unbondCmd.Run = func(_ *cobra.Command, args []string) {
 		from := args[0]
@@ -129,6 +131,9 @@ func buildUnbondTxCmd(parentCmd *cobra.Command) {
 		fee, err := amount.NewAmount(*feeOpt)
 		cmd.FatalErrorCheck(err)
 
+		amt, err := amount.NewAmount(*amountOpt)
+		cmd.FatalErrorCheck(err)
+
 		w, err := openWallet()
 		cmd.FatalErrorCheck(err)
 
@@ -138,12 +143,20 @@ func buildUnbondTxCmd(parentCmd *cobra.Command) {
 			wallet.OptionMemo(*memoOpt),
 		}
 
-		trx, err := w.MakeUnbondTx(from, opts...)
+		var trx *tx.Tx
+		if amt > 0 {
+			trx, err = w.MakePartialUnbondTx(from, amt, opts...)
+		} else {
+			trx, err = w.MakeUnbondTx(from, opts...)
+		}
 		cmd.FatalErrorCheck(err)
 
 		cmd.PrintLine()
 		cmd.PrintInfoMsgf("You are going to sign this \033[1mUnbond\033[0m transition:")
 		cmd.PrintInfoMsgf("Validator: %s", from)
+		if amt > 0 {
+			cmd.PrintInfoMsgf("Amount   : %s", amt)
+		}
 		cmd.PrintInfoMsgf("Fee      : %s", trx.Fee())
 
 		signAndPublishTx(w, trx, *noConfirmOpt, *passOpt)
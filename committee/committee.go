@@ -108,6 +108,12 @@ func (c *committee) Update(lastRound int16, joined []*validator.Validator) {
 	}
 }
 
+// SetSize changes the maximum size of the committee.
+// The committee is adjusted to the new size on the next update.
+func (c *committee) SetSize(committeeSize int) {
+	c.committeeSize = committeeSize
+}
+
 // Validators retrieves a list of all validators in the committee.
 // A cloned instance of each validator is returned to avoid modification of the original objects.
 func (c *committee) Validators() []*validator.Validator {
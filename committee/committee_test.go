@@ -386,3 +386,29 @@ func TestTotalPower(t *testing.T) {
 	assert.Equal(t, cmt.TotalPower(), totalPower)
 	assert.Equal(t, cmt.TotalPower(), int64(totalStake+1))
 }
+
+func TestSetSize(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	val1, _ := ts.GenerateTestValidator(1)
+	val2, _ := ts.GenerateTestValidator(2)
+	val3, _ := ts.GenerateTestValidator(3)
+	val4, _ := ts.GenerateTestValidator(4)
+	val5, _ := ts.GenerateTestValidator(5)
+
+	cmt, err := committee.NewCommittee([]*validator.Validator{val1, val2, val3, val4}, 4, val1.Address())
+	assert.NoError(t, err)
+
+	// The committee is adjusted on the next update.
+	cmt.SetSize(2)
+	assert.Equal(t, 4, cmt.Size())
+
+	cmt.Update(0, nil)
+	assert.Equal(t, 2, cmt.Size())
+
+	cmt.SetSize(3)
+	val5.UpdateLastSortitionHeight(10)
+	cmt.Update(0, []*validator.Validator{val5})
+	assert.Equal(t, 3, cmt.Size())
+	assert.True(t, cmt.Contains(val5.Address()))
+}
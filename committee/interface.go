@@ -20,4 +20,5 @@ type Committee interface {
 	Reader
 
 	Update(lastRound int16, joined []*validator.Validator)
+	SetSize(committeeSize int)
 }
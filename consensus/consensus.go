@@ -13,10 +13,12 @@ import (
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/evidence"
 	"github.com/pactus-project/pactus/types/proposal"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/logger"
 )
 
@@ -49,6 +51,7 @@ type consensus struct {
 	currentState    consState
 	broadcaster     broadcaster
 	mediator        mediator
+	evidences       *evidencePool
 	active          bool
 }
 
@@ -99,6 +102,7 @@ func newConsensus(
 	cs.cpDecideState = &cpDecideState{cs.changeProposer}
 	cs.currentState = cs.newHeightState
 	cs.mediator = mediator
+	cs.evidences = newEvidencePool()
 
 	cs.height = 0
 	cs.round = 0
@@ -209,6 +213,10 @@ func (cs *consensus) SetProposal(p *proposal.Proposal) {
 	cs.lk.Lock()
 	defer cs.lk.Unlock()
 
+	if cs.checkHalted() {
+		return
+	}
+
 	if !cs.active {
 		cs.logger.Trace("we are not in the committee")
 
@@ -272,6 +280,10 @@ func (cs *consensus) handleTimeout(t *ticker) {
 
 	cs.logger.Trace("handle ticker", "ticker", t)
 
+	if cs.checkHalted() {
+		return
+	}
+
 	// Old tickers might be triggered now. Ignore them.
 	if cs.height != t.Height || cs.round != t.Round {
 		cs.logger.Trace("stale ticker", "ticker", t)
@@ -287,6 +299,10 @@ func (cs *consensus) AddVote(v *vote.Vote) {
 	cs.lk.Lock()
 	defer cs.lk.Unlock()
 
+	if cs.checkHalted() {
+		return
+	}
+
 	if !cs.active {
 		cs.logger.Trace("we are not in the committee")
 
@@ -313,6 +329,10 @@ func (cs *consensus) AddVote(v *vote.Vote) {
 	added, err := cs.log.AddVote(v)
 	if err != nil {
 		cs.logger.Error("error on adding a vote", "vote", v, "error", err)
+
+		if errors.Code(err) == errors.ErrDuplicateVote {
+			cs.reportDoubleSigns()
+		}
 	}
 	if added {
 		cs.logger.Info("new vote added", "vote", v)
@@ -321,6 +341,49 @@ func (cs *consensus) AddVote(v *vote.Vote) {
 	}
 }
 
+// AddDoubleSign keeps the double-sign evidence that is received from other nodes.
+func (cs *consensus) AddDoubleSign(ev *evidence.DoubleSign) {
+	cs.lk.Lock()
+	defer cs.lk.Unlock()
+
+	if cs.evidences.add(ev, cs.height) {
+		cs.logger.Warn("double-sign evidence received", "evidence", ev)
+	}
+}
+
+// DoubleSigns returns the double-sign evidences that are detected or received recently.
+func (cs *consensus) DoubleSigns() []*evidence.DoubleSign {
+	cs.lk.RLock()
+	defer cs.lk.RUnlock()
+
+	return cs.evidences.all()
+}
+
+// reportDoubleSigns keeps the new double-sign evidences in the consensus log and broadcasts them.
+func (cs *consensus) reportDoubleSigns() {
+	for _, ev := range cs.log.DoubleSigns() {
+		if cs.evidences.add(ev, cs.height) {
+			cs.logger.Warn("double-sign detected", "evidence", ev)
+			cs.broadcastDoubleSign(ev)
+		}
+	}
+}
+
+// checkHalted deactivates the consensus if the state is halted due to a detected fork.
+// No proposal or vote should be signed until the operator resolves the fork.
+func (cs *consensus) checkHalted() bool {
+	if !cs.bcState.IsHalted() {
+		return false
+	}
+
+	if cs.active {
+		cs.logger.Warn("state is halted, stop participating in consensus")
+		cs.active = false
+	}
+
+	return true
+}
+
 func (cs *consensus) proposer(round int16) *validator.Validator {
 	return cs.bcState.Proposer(round)
 }
@@ -400,6 +463,11 @@ func (cs *consensus) broadcastVote(v *vote.Vote) {
 		message.NewVoteMessage(v))
 }
 
+func (cs *consensus) broadcastDoubleSign(ev *evidence.DoubleSign) {
+	cs.broadcaster(cs.valKey.Address(),
+		message.NewDoubleSignMessage(ev))
+}
+
 func (cs *consensus) announceNewBlock(blk *block.Block, cert *certificate.Certificate) {
 	go cs.mediator.OnBlockAnnounce(cs)
 	cs.broadcaster(cs.valKey.Address(),
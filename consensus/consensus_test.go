@@ -16,6 +16,7 @@ import (
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/evidence"
 	"github.com/pactus-project/pactus/types/param"
 	"github.com/pactus-project/pactus/types/proposal"
 	"github.com/pactus-project/pactus/types/tx"
@@ -431,6 +432,32 @@ func TestNotInCommittee(t *testing.T) {
 	assert.Equal(t, cons.currentState.name(), "new-height")
 }
 
+func TestHaltedState(t *testing.T) {
+	td := setup(t)
+
+	valKey := td.consX.valKey
+	str := store.MockingStore(td.TestSuite)
+	str.Evidence = &store.ForkEvidence{Height: 1}
+
+	st, err := state.LoadOrNewState(td.genDoc, []*bls.ValidatorKey{valKey}, str, td.txPool, nil)
+	require.NoError(t, err)
+	assert.True(t, st.IsHalted())
+	assert.True(t, st.IsInCommittee(valKey.Address()))
+
+	Cons := NewConsensus(testConfig(), st, valKey, valKey.Address(), make(chan message.Message, 100),
+		newConcreteMediator())
+	cons := Cons.(*consensus)
+
+	td.enterNewHeight(cons)
+	assert.False(t, cons.IsActive())
+
+	td.newHeightTimeout(cons)
+	assert.Equal(t, "new-height", cons.currentState.name())
+
+	v := td.addPrepareVote(cons, td.RandHash(), 1, 0, tIndexY)
+	assert.False(t, cons.HasVote(v.Hash()))
+}
+
 func TestVoteWithInvalidHeight(t *testing.T) {
 	td := setup(t)
 
@@ -664,6 +691,49 @@ func TestDuplicateProposal(t *testing.T) {
 	assert.Equal(t, td.consX.Proposal().Hash(), p1.Hash())
 }
 
+func TestDoubleSign(t *testing.T) {
+	td := setup(t)
+
+	td.enterNewHeight(td.consX)
+
+	countDoubleSignMessages := func() int {
+		count := 0
+		for _, consMsg := range td.consMessages {
+			if consMsg.sender == td.consX.valKey.Address() &&
+				consMsg.message.Type() == message.TypeDoubleSign {
+				count++
+			}
+		}
+
+		return count
+	}
+
+	v1 := td.addPrepareVote(td.consX, td.RandHash(), 1, 0, tIndexB)
+	assert.Empty(t, td.consX.DoubleSigns())
+
+	v2 := td.addPrepareVote(td.consX, td.RandHash(), 1, 0, tIndexB)
+	evs := td.consX.DoubleSigns()
+	require.Len(t, evs, 1)
+	assert.Equal(t, evidence.NewDoubleSign(v1, v2).Hash(), evs[0].Hash())
+	assert.Equal(t, 1, countDoubleSignMessages())
+
+	// Receiving the conflicting vote again doesn't broadcast the evidence again.
+	td.consX.AddVote(v2)
+	assert.Len(t, td.consX.DoubleSigns(), 1)
+	assert.Equal(t, 1, countDoubleSignMessages())
+
+	// The evidences are kept after moving to the next height.
+	td.commitBlockForAllStates(t)
+	td.enterNewHeight(td.consX)
+	assert.Len(t, td.consX.DoubleSigns(), 1)
+
+	// Receiving the evidence from other nodes.
+	td.enterNewHeight(td.consY)
+	td.consY.AddDoubleSign(evs[0])
+	td.consY.AddDoubleSign(evs[0])
+	assert.Len(t, td.consY.DoubleSigns(), 1)
+}
+
 func TestNonActiveValidator(t *testing.T) {
 	td := setup(t)
 
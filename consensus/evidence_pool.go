@@ -0,0 +1,82 @@
+package consensus
+
+import (
+	"sync"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/evidence"
+)
+
+const (
+	// evidenceRetention is the number of blocks that the evidences are kept in the pool.
+	evidenceRetention = uint32(8640)
+	// maxEvidences is the maximum number of evidences in the pool.
+	maxEvidences = 1024
+)
+
+// IsEvidenceInWindow checks if the evidence is for the given height or one of the retained heights before it.
+// The evidences outside this window are not kept in the pool.
+func IsEvidenceInWindow(ev *evidence.DoubleSign, height uint32) bool {
+	return ev.Height() <= height && ev.Height()+evidenceRetention >= height
+}
+
+// evidencePool keeps the double-sign evidences that are detected or received recently.
+type evidencePool struct {
+	lk sync.RWMutex
+
+	evidences map[hash.Hash]*evidence.DoubleSign
+}
+
+func newEvidencePool() *evidencePool {
+	return &evidencePool{
+		evidences: make(map[hash.Hash]*evidence.DoubleSign),
+	}
+}
+
+// add adds the evidence to the pool at the given height.
+// It returns false if the evidence is already in the pool, it is outside the retention window,
+// or the pool is full.
+func (p *evidencePool) add(ev *evidence.DoubleSign, height uint32) bool {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+
+	if !IsEvidenceInWindow(ev, height) {
+		return false
+	}
+
+	h := ev.Hash()
+	if _, ok := p.evidences[h]; ok {
+		return false
+	}
+	if len(p.evidences) >= maxEvidences {
+		return false
+	}
+	p.evidences[h] = ev
+
+	return true
+}
+
+// all returns the evidences in the pool.
+func (p *evidencePool) all() []*evidence.DoubleSign {
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	evs := make([]*evidence.DoubleSign, 0, len(p.evidences))
+	for _, ev := range p.evidences {
+		evs = append(evs, ev)
+	}
+
+	return evs
+}
+
+// prune removes the evidences that are older than the retention period.
+func (p *evidencePool) prune(height uint32) {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+
+	for h, ev := range p.evidences {
+		if ev.Height()+evidenceRetention < height {
+			delete(p.evidences, h)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvidencePoolPrune(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pool := newEvidencePool()
+	ev1, _ := ts.GenerateTestDoubleSign(100, 0)
+	ev2, _ := ts.GenerateTestDoubleSign(200, 0)
+
+	assert.True(t, pool.add(ev1, 200))
+	assert.True(t, pool.add(ev2, 200))
+	assert.False(t, pool.add(ev1, 200))
+
+	pool.prune(100 + evidenceRetention)
+	assert.Len(t, pool.all(), 2)
+
+	pool.prune(101 + evidenceRetention)
+	assert.Len(t, pool.all(), 1)
+	assert.Equal(t, ev2.Hash(), pool.all()[0].Hash())
+}
+
+func TestEvidencePoolWindow(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pool := newEvidencePool()
+	height := 1000 + evidenceRetention
+	evFuture, _ := ts.GenerateTestDoubleSign(height+1, 0)
+	evExpired, _ := ts.GenerateTestDoubleSign(999, 0)
+	evOldest, _ := ts.GenerateTestDoubleSign(1000, 0)
+	evCurrent, _ := ts.GenerateTestDoubleSign(height, 0)
+
+	assert.False(t, pool.add(evFuture, height))
+	assert.False(t, pool.add(evExpired, height))
+	assert.True(t, pool.add(evOldest, height))
+	assert.True(t, pool.add(evCurrent, height))
+	assert.Len(t, pool.all(), 2)
+}
+
+func TestEvidencePoolCap(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pool := newEvidencePool()
+	height := ts.RandHeight()
+	for i := 0; i < maxEvidences; i++ {
+		ev, _ := ts.GenerateTestDoubleSign(height, 0)
+		assert.True(t, pool.add(ev, height))
+	}
+
+	ev, _ := ts.GenerateTestDoubleSign(height, 0)
+	assert.False(t, pool.add(ev, height))
+	assert.Len(t, pool.all(), maxEvidences)
+}
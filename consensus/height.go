@@ -22,7 +22,8 @@ func (s *newHeightState) decide() {
 	s.validators = validators
 	s.height = sateHeight + 1
 	s.round = 0
-	s.active = s.bcState.IsInCommittee(s.valKey.Address())
+	s.evidences.prune(s.height)
+	s.active = s.bcState.IsInCommittee(s.valKey.Address()) && !s.bcState.IsHalted()
 	s.logger.Info("entering new height", "height", s.height, "active", s.active)
 
 	sleep := s.bcState.LastBlockTime().Add(s.bcState.Params().BlockInterval()).Sub(util.Now())
@@ -3,6 +3,7 @@ package consensus
 import (
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/evidence"
 	"github.com/pactus-project/pactus/types/proposal"
 	"github.com/pactus-project/pactus/types/vote"
 )
@@ -15,6 +16,7 @@ type Reader interface {
 	HasVote(h hash.Hash) bool
 	HeightRound() (uint32, int16)
 	IsActive() bool
+	DoubleSigns() []*evidence.DoubleSign
 }
 
 type Consensus interface {
@@ -24,6 +26,7 @@ type Consensus interface {
 	MoveToNewHeight()
 	AddVote(vote *vote.Vote)
 	SetProposal(proposal *proposal.Proposal)
+	AddDoubleSign(ev *evidence.DoubleSign)
 }
 
 type ManagerReader interface {
@@ -32,6 +35,7 @@ type ManagerReader interface {
 	Proposal() *proposal.Proposal
 	HeightRound() (uint32, int16)
 	HasActiveInstance() bool
+	DoubleSigns() []*evidence.DoubleSign
 }
 
 type Manager interface {
@@ -42,4 +46,5 @@ type Manager interface {
 	MoveToNewHeight()
 	AddVote(vote *vote.Vote)
 	SetProposal(proposal *proposal.Proposal)
+	AddDoubleSign(ev *evidence.DoubleSign)
 }
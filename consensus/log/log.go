@@ -4,6 +4,7 @@ import (
 	"github.com/pactus-project/pactus/consensus/voteset"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/evidence"
 	"github.com/pactus-project/pactus/types/proposal"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
@@ -57,6 +58,16 @@ func (log *Log) AddVote(v *vote.Vote) (bool, error) {
 	return m.addVote(v)
 }
 
+// DoubleSigns returns the evidences of the conflicting votes at the current height.
+func (log *Log) DoubleSigns() []*evidence.DoubleSign {
+	evs := []*evidence.DoubleSign{}
+	for _, m := range log.roundMessages {
+		evs = append(evs, m.DoubleSigns()...)
+	}
+
+	return evs
+}
+
 func (log *Log) PrepareVoteSet(round int16) *voteset.BlockVoteSet {
 	m := log.mustGetRoundMessages(round)
 
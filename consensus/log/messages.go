@@ -5,6 +5,7 @@ import (
 
 	"github.com/pactus-project/pactus/consensus/voteset"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/evidence"
 	"github.com/pactus-project/pactus/types/proposal"
 	"github.com/pactus-project/pactus/types/vote"
 )
@@ -56,3 +57,15 @@ func (m *Messages) AllVotes() []*vote.Vote {
 
 	return votes
 }
+
+// DoubleSigns returns the evidences of the conflicting votes in all the vote sets.
+func (m *Messages) DoubleSigns() []*evidence.DoubleSign {
+	evs := []*evidence.DoubleSign{}
+	evs = append(evs, m.prepareVotes.DoubleSigns()...)
+	evs = append(evs, m.precommitVotes.DoubleSigns()...)
+	evs = append(evs, m.cpPreVotes.DoubleSigns()...)
+	evs = append(evs, m.cpMainVotes.DoubleSigns()...)
+	evs = append(evs, m.cpDecidedVotes.DoubleSigns()...)
+
+	return evs
+}
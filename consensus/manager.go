@@ -3,8 +3,10 @@ package consensus
 import (
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/evidence"
 	"github.com/pactus-project/pactus/types/proposal"
 	"github.com/pactus-project/pactus/types/vote"
 	"github.com/pactus-project/pactus/util/logger"
@@ -191,6 +193,29 @@ func (mgr *manager) SetProposal(p *proposal.Proposal) {
 	}
 }
 
+// AddDoubleSign adds a double-sign evidence to all consensus instances.
+func (mgr *manager) AddDoubleSign(ev *evidence.DoubleSign) {
+	for _, cons := range mgr.instances {
+		cons.AddDoubleSign(ev)
+	}
+}
+
+// DoubleSigns returns the double-sign evidences of all consensus instances.
+func (mgr *manager) DoubleSigns() []*evidence.DoubleSign {
+	evs := make([]*evidence.DoubleSign, 0)
+	seen := make(map[hash.Hash]bool)
+	for _, cons := range mgr.instances {
+		for _, ev := range cons.DoubleSigns() {
+			if !seen[ev.Hash()] {
+				seen[ev.Hash()] = true
+				evs = append(evs, ev)
+			}
+		}
+	}
+
+	return evs
+}
+
 // getBestInstance iterates through all consensus instances and returns the instance
 // that is currently active, if there is one.
 // If there are no active instances, it returns the first instance.
@@ -152,6 +152,17 @@ func TestManager(t *testing.T) {
 
 		assert.Len(t, mgr.upcomingProposals, 1)
 	})
+
+	t.Run("Testing add double-sign evidence", func(t *testing.T) {
+		ev, _ := ts.GenerateTestDoubleSign(stateHeight, 0)
+
+		mgr.AddDoubleSign(ev)
+		mgr.AddDoubleSign(ev)
+
+		assert.Len(t, consA.DoubleSigns(), 1)
+		assert.Len(t, consB.DoubleSigns(), 1)
+		assert.Len(t, mgr.DoubleSigns(), 1)
+	})
 }
 
 func TestMediator(t *testing.T) {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/evidence"
 	"github.com/pactus-project/pactus/types/proposal"
 	"github.com/pactus-project/pactus/types/vote"
 	"github.com/pactus-project/pactus/util/testsuite"
@@ -23,6 +24,7 @@ type MockConsensus struct {
 	Active      bool
 	Height      uint32
 	Round       int16
+	Evidences   []*evidence.DoubleSign
 }
 
 func MockingManager(ts *testsuite.TestSuite, valKeys []*bls.ValidatorKey) (Manager, []*MockConsensus) {
@@ -138,3 +140,17 @@ func (m *MockConsensus) SetActive(active bool) {
 
 	m.Active = active
 }
+
+func (m *MockConsensus) AddDoubleSign(ev *evidence.DoubleSign) {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	m.Evidences = append(m.Evidences, ev)
+}
+
+func (m *MockConsensus) DoubleSigns() []*evidence.DoubleSign {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	return m.Evidences
+}
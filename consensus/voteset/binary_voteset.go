@@ -100,6 +100,7 @@ func (vs *BinaryVoteSet) AddVote(v *vote.Vote) (bool, error) {
 	existingVote, ok := roundVotes.allVotes[v.Signer()]
 	if ok {
 		if existingVote.Hash() != v.Hash() {
+			vs.checkDoubleSign(existingVote, v)
 			err = errors.Error(errors.ErrDuplicateVote)
 		} else {
 			// The vote is already added
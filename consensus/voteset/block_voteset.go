@@ -79,6 +79,7 @@ func (vs *BlockVoteSet) AddVote(v *vote.Vote) (bool, error) {
 	existingVote, ok := vs.allVotes[v.Signer()]
 	if ok {
 		if existingVote.Hash() != v.Hash() {
+			vs.checkDoubleSign(existingVote, v)
 			err = errors.Error(errors.ErrDuplicateVote)
 		} else {
 			// The vote is already added
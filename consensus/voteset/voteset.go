@@ -2,24 +2,28 @@ package voteset
 
 import (
 	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/evidence"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
 	"github.com/pactus-project/pactus/util/errors"
 )
 
 type voteSet struct {
-	round      int16
-	validators map[crypto.Address]*validator.Validator
-	totalPower int64
+	round       int16
+	validators  map[crypto.Address]*validator.Validator
+	totalPower  int64
+	doubleSigns map[hash.Hash]*evidence.DoubleSign
 }
 
 func newVoteSet(round int16, totalPower int64,
 	validators map[crypto.Address]*validator.Validator,
 ) *voteSet {
 	return &voteSet{
-		round:      round,
-		validators: validators,
-		totalPower: totalPower,
+		round:       round,
+		validators:  validators,
+		totalPower:  totalPower,
+		doubleSigns: make(map[hash.Hash]*evidence.DoubleSign),
 	}
 }
 
@@ -46,6 +50,25 @@ func (vs *voteSet) verifyVote(v *vote.Vote) (int64, error) {
 	return val.Power(), nil
 }
 
+// checkDoubleSign keeps both votes as evidence if the signer has signed
+// two different block hashes.
+func (vs *voteSet) checkDoubleSign(existingVote, v *vote.Vote) {
+	if evidence.IsConflicting(existingVote, v) {
+		ev := evidence.NewDoubleSign(existingVote, v)
+		vs.doubleSigns[ev.Hash()] = ev
+	}
+}
+
+// DoubleSigns returns the evidences of the validators that have signed conflicting votes.
+func (vs *voteSet) DoubleSigns() []*evidence.DoubleSign {
+	evs := make([]*evidence.DoubleSign, 0, len(vs.doubleSigns))
+	for _, ev := range vs.doubleSigns {
+		evs = append(evs, ev)
+	}
+
+	return evs
+}
+
 func (vs *voteSet) isTwoThirdOfTotalPower(power int64) bool {
 	return power > (vs.totalPower * 2 / 3)
 }
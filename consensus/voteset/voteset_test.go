@@ -151,6 +151,18 @@ func TestDuplicateBlockVote(t *testing.T) {
 	assert.Equal(t, errors.Code(err), errors.ErrDuplicateVote)
 	assert.True(t, added)
 
+	// Adding the conflicting vote again doesn't make a new evidence.
+	_, err = vs.AddVote(duplicatedVote1)
+	assert.Equal(t, errors.Code(err), errors.ErrDuplicateVote)
+
+	evs := vs.DoubleSigns()
+	assert.Len(t, evs, 2)
+	for _, ev := range evs {
+		assert.NoError(t, ev.BasicCheck())
+		assert.NoError(t, ev.Verify(valKeys[0].PublicKey()))
+		assert.Equal(t, addr, ev.Signer())
+	}
+
 	bv1 := vs.BlockVotes(h1)
 	bv2 := vs.BlockVotes(h2)
 	bv3 := vs.BlockVotes(h3)
@@ -193,6 +205,28 @@ func TestDuplicateBinaryVote(t *testing.T) {
 	assert.True(t, added)
 
 	assert.False(t, vs.HasOneThirdOfTotalPower(0))
+	assert.Len(t, vs.DoubleSigns(), 2)
+}
+
+func TestNoDoubleSignForDifferentCPRounds(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valsMap, valKeys, totalPower := setupCommittee(ts, 1, 1, 1, 1)
+
+	addr := valKeys[0].Address()
+	vs := NewCPPreVoteVoteSet(0, totalPower, valsMap)
+
+	vote1 := vote.NewCPPreVote(ts.RandHash(), 1, 0, 0, vote.CPValueOne, &vote.JustInitOne{}, addr)
+	vote2 := vote.NewCPPreVote(ts.RandHash(), 1, 0, 1, vote.CPValueOne, &vote.JustInitOne{}, addr)
+	ts.HelperSignVote(valKeys[0], vote1)
+	ts.HelperSignVote(valKeys[0], vote2)
+
+	_, err := vs.AddVote(vote1)
+	assert.NoError(t, err)
+	_, err = vs.AddVote(vote2)
+	assert.NoError(t, err)
+
+	assert.Empty(t, vs.DoubleSigns())
 }
 
 func TestQuorum(t *testing.T) {
@@ -17,11 +17,14 @@ const (
 	AddressTypeTreasury   AddressType = 0
 	AddressTypeValidator  AddressType = 1
 	AddressTypeBLSAccount AddressType = 2
+	// AddressTypeMultisigAccount is an m-of-n account, defined by a set of BLS public keys and a threshold.
+	AddressTypeMultisigAccount AddressType = 3
 )
 
 const (
-	SignatureTypeTreasury byte = 0
-	SignatureTypeBLS      byte = 1
+	SignatureTypeTreasury    byte = 0
+	SignatureTypeBLS         byte = 1
+	SignatureTypeBLSMultisig byte = 2
 )
 
 const (
@@ -52,7 +55,7 @@ func AddressFromString(text string) (Address, error) {
 	}
 
 	// check type is valid
-	validTypes := []AddressType{AddressTypeValidator, AddressTypeBLSAccount}
+	validTypes := []AddressType{AddressTypeValidator, AddressTypeBLSAccount, AddressTypeMultisigAccount}
 	if !slices.Contains(validTypes, AddressType(typ)) {
 		return Address{}, InvalidAddressTypeError(typ)
 	}
@@ -114,7 +117,8 @@ func (addr Address) Encode(w io.Writer) error {
 	case AddressTypeTreasury:
 		return encoding.WriteElement(w, uint8(0))
 	case AddressTypeValidator,
-		AddressTypeBLSAccount:
+		AddressTypeBLSAccount,
+		AddressTypeMultisigAccount:
 		return encoding.WriteElement(w, addr)
 	default:
 		return InvalidAddressTypeError(t)
@@ -130,7 +134,8 @@ func (addr *Address) Decode(r io.Reader) error {
 	case AddressTypeTreasury:
 		return nil
 	case AddressTypeValidator,
-		AddressTypeBLSAccount:
+		AddressTypeBLSAccount,
+		AddressTypeMultisigAccount:
 		return encoding.ReadElement(r, addr[1:])
 	default:
 		return InvalidAddressTypeError(t)
@@ -143,7 +148,8 @@ func (addr Address) SerializeSize() int {
 	case AddressTypeTreasury:
 		return 1
 	case AddressTypeValidator,
-		AddressTypeBLSAccount:
+		AddressTypeBLSAccount,
+		AddressTypeMultisigAccount:
 		return AddressSize
 	default:
 		return 0
@@ -156,7 +162,12 @@ func (addr Address) IsTreasuryAddress() bool {
 
 func (addr Address) IsAccountAddress() bool {
 	return addr.Type() == AddressTypeTreasury ||
-		addr.Type() == AddressTypeBLSAccount
+		addr.Type() == AddressTypeBLSAccount ||
+		addr.Type() == AddressTypeMultisigAccount
+}
+
+func (addr Address) IsMultisigAddress() bool {
+	return addr.Type() == AddressTypeMultisigAccount
 }
 
 func (addr Address) IsValidatorAddress() bool {
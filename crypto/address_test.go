@@ -91,8 +91,8 @@ func TestToString(t *testing.T) {
 			nil,
 		},
 		{
-			"pc1r0hrct7eflrpw4ccrttxzs4qud2axex4dwc9mn4",
-			crypto.InvalidAddressTypeError(3),
+			"pc1y0hrct7eflrpw4ccrttxzs4qud2axex4dksmred",
+			crypto.InvalidAddressTypeError(4),
 			nil,
 		},
 		{
@@ -111,6 +111,14 @@ func TestToString(t *testing.T) {
 				0x3, 0x5a, 0xcc, 0x28, 0x54, 0x1c, 0x6a, 0xba, 0x6c, 0x9a, 0xad,
 			},
 		},
+		{
+			"pc1r0hrct7eflrpw4ccrttxzs4qud2axex4dwc9mn4",
+			nil,
+			&crypto.Address{
+				0x3, 0x7d, 0xc7, 0x85, 0xfb, 0x29, 0xf8, 0xc2, 0xea, 0xe3,
+				0x3, 0x5a, 0xcc, 0x28, 0x54, 0x1c, 0x6a, 0xba, 0x6c, 0x9a, 0xad,
+			},
+		},
 	}
 	for no, test := range tests {
 		addr, err := crypto.AddressFromString(test.encoded)
@@ -137,13 +145,13 @@ func TestAddressEncoding(t *testing.T) {
 		},
 		{
 			0,
-			"030000000000000000000000000000000000000000",
-			crypto.InvalidAddressTypeError(3),
+			"040000000000000000000000000000000000000000",
+			crypto.InvalidAddressTypeError(4),
 		},
 		{
 			0,
-			"03000102030405060708090a0b0c0d0e0f0001020304",
-			crypto.InvalidAddressTypeError(3),
+			"04000102030405060708090a0b0c0d0e0f0001020304",
+			crypto.InvalidAddressTypeError(4),
 		},
 		{
 			21,
@@ -165,6 +173,11 @@ func TestAddressEncoding(t *testing.T) {
 			"02000102030405060708090a0b0c0d0e0f00010203",
 			nil,
 		},
+		{
+			21,
+			"03000102030405060708090a0b0c0d0e0f00010203",
+			nil,
+		},
 	}
 	for no, test := range tests {
 		data, _ := hex.DecodeString(test.hex)
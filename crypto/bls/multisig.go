@@ -0,0 +1,392 @@
+package bls
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"math/bits"
+
+	cbor "github.com/fxamacker/cbor/v2"
+	bls12381 "github.com/kilic/bls12-381"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util/bech32m"
+	"github.com/pactus-project/pactus/util/encoding"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+var (
+	_ crypto.PublicKey = &MultisigPublicKey{}
+	_ crypto.Signature = &MultisigSignature{}
+)
+
+const (
+	// MaxMultisigKeys is the maximum number of public keys in a multisig public key.
+	// It is bounded by the size of the signer bitmap.
+	MaxMultisigKeys = 32
+
+	// MultisigSignatureSize is the size of a multisig signature,
+	// the signer bitmap followed by the aggregated signature.
+	MultisigSignatureSize = 4 + SignatureSize
+)
+
+// set Ciphersuite for Message Augmentation mode
+// https://datatracker.ietf.org/doc/html/draft-irtf-cfrg-bls-signature-04#section-4.2.2
+//
+// The signers of a multisig account sign the same message, therefore their public keys
+// are prepended to the message to prevent the rogue public key attacks.
+var dstAug = []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_AUG_")
+
+// MultisigPublicKey defines an m-of-n multisig account by a set of BLS public keys and a threshold.
+type MultisigPublicKey struct {
+	threshold int
+	keys      []*PublicKey
+}
+
+// NewMultisigPublicKey creates a multisig public key that requires
+// at least `threshold` signatures of the given keys.
+// The order of the keys matters, since the account address is derived from it.
+func NewMultisigPublicKey(threshold int, keys []*PublicKey) (*MultisigPublicKey, error) {
+	if len(keys) == 0 || len(keys) > MaxMultisigKeys {
+		return nil, errors.Errorf(errors.ErrInvalidPublicKey,
+			"number of keys should be between 1 and %d, but it is %d", MaxMultisigKeys, len(keys))
+	}
+	if threshold < 1 || threshold > len(keys) {
+		return nil, errors.Errorf(errors.ErrInvalidPublicKey,
+			"threshold should be between 1 and %d, but it is %d", len(keys), threshold)
+	}
+	for i, key := range keys {
+		for _, other := range keys[:i] {
+			if key.EqualsTo(other) {
+				return nil, errors.Errorf(errors.ErrInvalidPublicKey,
+					"duplicated public key: %s", key.String())
+			}
+		}
+	}
+
+	return &MultisigPublicKey{
+		threshold: threshold,
+		keys:      keys,
+	}, nil
+}
+
+// MultisigPublicKeyFromString decodes the string encoding of a multisig public key.
+func MultisigPublicKeyFromString(text string) (*MultisigPublicKey, error) {
+	hrp, typ, data, err := bech32m.DecodeToBase256WithTypeNoLimit(text)
+	if err != nil {
+		return nil, errors.Errorf(errors.ErrInvalidPublicKey, err.Error())
+	}
+
+	if hrp != crypto.PublicKeyHRP {
+		return nil, errors.Errorf(errors.ErrInvalidPublicKey, "invalid hrp: %v", hrp)
+	}
+
+	if typ != crypto.SignatureTypeBLSMultisig {
+		return nil, errors.Errorf(errors.ErrInvalidPublicKey, "invalid public key type: %v", typ)
+	}
+
+	return MultisigPublicKeyFromBytes(data)
+}
+
+// MultisigPublicKeyFromBytes constructs a multisig public key from the raw bytes.
+func MultisigPublicKeyFromBytes(data []byte) (*MultisigPublicKey, error) {
+	r := bytes.NewReader(data)
+	pub := new(MultisigPublicKey)
+	if err := pub.Decode(r); err != nil {
+		return nil, err
+	}
+	if r.Len() != 0 {
+		return nil, errors.Errorf(errors.ErrInvalidPublicKey,
+			"%d extra bytes", r.Len())
+	}
+
+	return pub, nil
+}
+
+// Threshold returns the minimum number of signatures that are required.
+func (pub *MultisigPublicKey) Threshold() int {
+	return pub.threshold
+}
+
+// Keys returns the public keys of the signers.
+func (pub *MultisigPublicKey) Keys() []*PublicKey {
+	keys := make([]*PublicKey, len(pub.keys))
+	copy(keys, pub.keys)
+
+	return keys
+}
+
+func (pub *MultisigPublicKey) Bytes() []byte {
+	w := bytes.NewBuffer(make([]byte, 0, pub.SerializeSize()))
+	_ = pub.Encode(w)
+
+	return w.Bytes()
+}
+
+// String returns a human-readable string for the multisig public key.
+func (pub *MultisigPublicKey) String() string {
+	str, _ := bech32m.EncodeFromBase256WithType(
+		crypto.PublicKeyHRP,
+		crypto.SignatureTypeBLSMultisig,
+		pub.Bytes())
+
+	return str
+}
+
+// SerializeSize returns the number of bytes it would take to serialize the multisig public key.
+func (pub *MultisigPublicKey) SerializeSize() int {
+	return 2 + len(pub.keys)*PublicKeySize
+}
+
+func (pub *MultisigPublicKey) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(pub.Bytes())
+}
+
+func (pub *MultisigPublicKey) UnmarshalCBOR(bs []byte) error {
+	var data []byte
+	if err := cbor.Unmarshal(bs, &data); err != nil {
+		return err
+	}
+
+	return pub.Decode(bytes.NewReader(data))
+}
+
+func (pub *MultisigPublicKey) Encode(w io.Writer) error {
+	err := encoding.WriteElements(w, uint8(pub.threshold), uint8(len(pub.keys)))
+	if err != nil {
+		return err
+	}
+
+	for _, key := range pub.keys {
+		if err := key.Encode(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (pub *MultisigPublicKey) Decode(r io.Reader) error {
+	threshold := uint8(0)
+	count := uint8(0)
+	err := encoding.ReadElements(r, &threshold, &count)
+	if err != nil {
+		return err
+	}
+	if count > MaxMultisigKeys {
+		return errors.Errorf(errors.ErrInvalidPublicKey,
+			"number of keys should be between 1 and %d, but it is %d", MaxMultisigKeys, count)
+	}
+
+	keys := make([]*PublicKey, count)
+	for i := range keys {
+		keys[i] = new(PublicKey)
+		if err := keys[i].Decode(r); err != nil {
+			return err
+		}
+	}
+
+	p, err := NewMultisigPublicKey(int(threshold), keys)
+	if err != nil {
+		return err
+	}
+	*pub = *p
+
+	return nil
+}
+
+// Verify checks that the signature is an aggregated signature of at least
+// `threshold` signers for the given message.
+func (pub *MultisigPublicKey) Verify(msg []byte, sig crypto.Signature) error {
+	msig, ok := sig.(*MultisigSignature)
+	if !ok {
+		return errors.Error(errors.ErrInvalidSignature)
+	}
+	if msig.signers>>len(pub.keys) != 0 {
+		return errors.Errorf(errors.ErrInvalidSignature,
+			"invalid signer bitmap: %032b", msig.signers)
+	}
+	if numSigners := bits.OnesCount32(msig.signers); numSigners < pub.threshold {
+		return errors.Errorf(errors.ErrInvalidSignature,
+			"not enough signers: %d < %d", numSigners, pub.threshold)
+	}
+
+	g1 := bls12381.NewG1()
+	if g1.IsZero(&msig.aggSig.pointG1) {
+		return errors.Errorf(errors.ErrInvalidSignature,
+			"signature is zero")
+	}
+
+	eng := bls12381.NewEngine()
+	for _, i := range msig.Signers() {
+		key := pub.keys[i]
+		q, err := g1.HashToCurve(augmentMessage(key, msg), dstAug)
+		if err != nil {
+			panic(err)
+		}
+		eng.AddPair(q, key.point())
+	}
+	g2one := bls12381.NewG2().New().Set(&bls12381.G2One)
+	eng.AddPairInv(&msig.aggSig.pointG1, g2one)
+
+	if !eng.Check() {
+		return crypto.ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func (pub *MultisigPublicKey) EqualsTo(right crypto.PublicKey) bool {
+	other, ok := right.(*MultisigPublicKey)
+	if !ok {
+		return false
+	}
+
+	return bytes.Equal(pub.Bytes(), other.Bytes())
+}
+
+// AccountAddress returns the address of the multisig account.
+func (pub *MultisigPublicKey) AccountAddress() crypto.Address {
+	data := hash.Hash160(hash.Hash256(pub.Bytes()))
+	addr := crypto.NewAddress(crypto.AddressTypeMultisigAccount, data)
+
+	return addr
+}
+
+func (pub *MultisigPublicKey) VerifyAddress(addr crypto.Address) error {
+	if addr != pub.AccountAddress() {
+		return crypto.AddressMismatchError{
+			Expected: pub.AccountAddress(),
+			Got:      addr,
+		}
+	}
+
+	return nil
+}
+
+// MultisigSignature is the aggregated signature of the signers of a multisig account.
+// The signer bitmap indicates the keys that have signed the message.
+type MultisigSignature struct {
+	signers uint32
+	aggSig  Signature
+}
+
+// NewMultisigSignature aggregates the partial signatures of the signers.
+// The signers are the indexes of the signing keys in the multisig public key,
+// and each of them should sign the message by `SignMultisig`.
+func NewMultisigSignature(signers []int, sigs []*Signature) (*MultisigSignature, error) {
+	if len(signers) == 0 || len(signers) != len(sigs) {
+		return nil, errors.Errorf(errors.ErrInvalidSignature,
+			"number of signers and signatures are not equal: %d != %d", len(signers), len(sigs))
+	}
+
+	bitmap := uint32(0)
+	for _, i := range signers {
+		if i < 0 || i >= MaxMultisigKeys {
+			return nil, errors.Errorf(errors.ErrInvalidSignature,
+				"invalid signer index: %d", i)
+		}
+		if bitmap&(1<<i) != 0 {
+			return nil, errors.Errorf(errors.ErrInvalidSignature,
+				"duplicated signer index: %d", i)
+		}
+		bitmap |= 1 << i
+	}
+
+	return &MultisigSignature{
+		signers: bitmap,
+		aggSig:  *SignatureAggregate(sigs...),
+	}, nil
+}
+
+// MultisigSignatureFromBytes constructs a multisig signature from the raw bytes.
+func MultisigSignatureFromBytes(data []byte) (*MultisigSignature, error) {
+	if len(data) != MultisigSignatureSize {
+		return nil, errors.Errorf(errors.ErrInvalidSignature,
+			"signature should be %d bytes, but it is %v bytes", MultisigSignatureSize, len(data))
+	}
+
+	sig := new(MultisigSignature)
+	if err := sig.Decode(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+// Signers returns the indexes of the keys that have signed the message.
+func (sig *MultisigSignature) Signers() []int {
+	signers := make([]int, 0, bits.OnesCount32(sig.signers))
+	for i := 0; i < MaxMultisigKeys; i++ {
+		if sig.signers&(1<<i) != 0 {
+			signers = append(signers, i)
+		}
+	}
+
+	return signers
+}
+
+// Aggregated returns the aggregated signature of the signers.
+func (sig *MultisigSignature) Aggregated() *Signature {
+	return &Signature{pointG1: *sig.aggSig.point()}
+}
+
+func (sig *MultisigSignature) Bytes() []byte {
+	w := bytes.NewBuffer(make([]byte, 0, MultisigSignatureSize))
+	_ = sig.Encode(w)
+
+	return w.Bytes()
+}
+
+func (sig *MultisigSignature) String() string {
+	return hex.EncodeToString(sig.Bytes())
+}
+
+func (sig *MultisigSignature) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(sig.Bytes())
+}
+
+func (sig *MultisigSignature) UnmarshalCBOR(bs []byte) error {
+	var data []byte
+	if err := cbor.Unmarshal(bs, &data); err != nil {
+		return err
+	}
+
+	return sig.Decode(bytes.NewReader(data))
+}
+
+func (sig *MultisigSignature) Encode(w io.Writer) error {
+	return encoding.WriteElements(w, sig.signers, sig.aggSig.Bytes())
+}
+
+func (sig *MultisigSignature) Decode(r io.Reader) error {
+	err := encoding.ReadElement(r, &sig.signers)
+	if err != nil {
+		return err
+	}
+
+	return sig.aggSig.Decode(r)
+}
+
+func (sig *MultisigSignature) EqualsTo(right crypto.Signature) bool {
+	other, ok := right.(*MultisigSignature)
+	if !ok {
+		return false
+	}
+
+	return sig.signers == other.signers &&
+		sig.aggSig.EqualsTo(&other.aggSig)
+}
+
+// SignMultisig signs the message as a signer of a multisig account.
+// The partial signatures are aggregated by `NewMultisigSignature`.
+func (prv *PrivateKey) SignMultisig(msg []byte) *Signature {
+	return prv.sign(augmentMessage(prv.PublicKeyNative(), msg), dstAug)
+}
+
+func augmentMessage(pub *PublicKey, msg []byte) []byte {
+	augMsg := make([]byte, 0, PublicKeySize+len(msg))
+	augMsg = append(augMsg, pub.Bytes()...)
+
+	return append(augMsg, msg...)
+}
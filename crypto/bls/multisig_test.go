@@ -0,0 +1,155 @@
+package bls_test
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMultisigPublicKey(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pub1, _ := ts.RandBLSKeyPair()
+	pub2, _ := ts.RandBLSKeyPair()
+
+	_, err := bls.NewMultisigPublicKey(1, nil)
+	assert.Error(t, err, "no key")
+
+	_, err = bls.NewMultisigPublicKey(0, []*bls.PublicKey{pub1, pub2})
+	assert.Error(t, err, "zero threshold")
+
+	_, err = bls.NewMultisigPublicKey(3, []*bls.PublicKey{pub1, pub2})
+	assert.Error(t, err, "threshold is greater than the number of keys")
+
+	_, err = bls.NewMultisigPublicKey(1, []*bls.PublicKey{pub1, pub1})
+	assert.Error(t, err, "duplicated keys")
+
+	tooManyKeys := make([]*bls.PublicKey, bls.MaxMultisigKeys+1)
+	for i := range tooManyKeys {
+		tooManyKeys[i], _ = ts.RandBLSKeyPair()
+	}
+	_, err = bls.NewMultisigPublicKey(1, tooManyKeys)
+	assert.Error(t, err, "too many keys")
+
+	pub, err := bls.NewMultisigPublicKey(2, []*bls.PublicKey{pub1, pub2})
+	require.NoError(t, err)
+	assert.Equal(t, 2, pub.Threshold())
+	assert.Equal(t, []*bls.PublicKey{pub1, pub2}, pub.Keys())
+}
+
+func TestMultisigPublicKeyEncoding(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pub, _ := ts.RandMultisigKey(2, 3)
+	assert.Len(t, pub.Bytes(), pub.SerializeSize())
+
+	pub2, err := bls.MultisigPublicKeyFromBytes(pub.Bytes())
+	require.NoError(t, err)
+	assert.True(t, pub.EqualsTo(pub2))
+
+	pub3, err := bls.MultisigPublicKeyFromString(pub.String())
+	require.NoError(t, err)
+	assert.True(t, pub.EqualsTo(pub3))
+
+	_, err = bls.MultisigPublicKeyFromBytes(append(pub.Bytes(), 0))
+	assert.Error(t, err, "extra bytes")
+
+	blsPub, _ := ts.RandBLSKeyPair()
+	_, err = bls.MultisigPublicKeyFromString(blsPub.String())
+	assert.Error(t, err, "invalid public key type")
+	assert.False(t, pub.EqualsTo(blsPub))
+
+	addr := pub.AccountAddress()
+	assert.True(t, addr.IsAccountAddress())
+	assert.True(t, addr.IsMultisigAddress())
+	assert.NoError(t, pub.VerifyAddress(addr))
+	assert.ErrorIs(t, pub.VerifyAddress(blsPub.AccountAddress()), crypto.AddressMismatchError{
+		Expected: addr,
+		Got:      blsPub.AccountAddress(),
+	})
+
+	// Changing the order of the keys changes the address.
+	keys := pub.Keys()
+	keys[0], keys[1] = keys[1], keys[0]
+	reordered, _ := bls.NewMultisigPublicKey(pub.Threshold(), keys)
+	assert.NotEqual(t, addr, reordered.AccountAddress())
+}
+
+func TestMultisigSignature(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pub, prvs := ts.RandMultisigKey(2, 3)
+	msg := ts.RandBytes(32)
+
+	sign := func(signers ...int) *bls.MultisigSignature {
+		sigs := make([]*bls.Signature, 0, len(signers))
+		for _, i := range signers {
+			sigs = append(sigs, prvs[i].SignMultisig(msg))
+		}
+		sig, err := bls.NewMultisigSignature(signers, sigs)
+		require.NoError(t, err)
+
+		return sig
+	}
+
+	t.Run("Enough signers", func(t *testing.T) {
+		sig := sign(0, 2)
+		assert.Equal(t, []int{0, 2}, sig.Signers())
+		assert.NoError(t, pub.Verify(msg, sig))
+		assert.NoError(t, pub.Verify(msg, sign(0, 1, 2)))
+		assert.Error(t, pub.Verify(ts.RandBytes(32), sig))
+	})
+
+	t.Run("Not enough signers", func(t *testing.T) {
+		assert.Error(t, pub.Verify(msg, sign(1)))
+	})
+
+	t.Run("Invalid signer bitmap", func(t *testing.T) {
+		sig, _ := bls.NewMultisigSignature([]int{0, 5},
+			[]*bls.Signature{prvs[0].SignMultisig(msg), prvs[1].SignMultisig(msg)})
+		assert.Error(t, pub.Verify(msg, sig))
+	})
+
+	t.Run("Wrong signer in the bitmap", func(t *testing.T) {
+		sig, _ := bls.NewMultisigSignature([]int{0, 2},
+			[]*bls.Signature{prvs[0].SignMultisig(msg), prvs[1].SignMultisig(msg)})
+		assert.Error(t, pub.Verify(msg, sig))
+	})
+
+	t.Run("Plain BLS signatures", func(t *testing.T) {
+		sig, _ := bls.NewMultisigSignature([]int{0, 1},
+			[]*bls.Signature{prvs[0].SignNative(msg), prvs[1].SignNative(msg)})
+		assert.Error(t, pub.Verify(msg, sig))
+		assert.Error(t, pub.Verify(msg, prvs[0].SignNative(msg)))
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		_, err := bls.NewMultisigSignature([]int{0, 1}, []*bls.Signature{prvs[0].SignMultisig(msg)})
+		assert.Error(t, err)
+
+		_, err = bls.NewMultisigSignature([]int{1, 1},
+			[]*bls.Signature{prvs[1].SignMultisig(msg), prvs[1].SignMultisig(msg)})
+		assert.Error(t, err)
+
+		_, err = bls.NewMultisigSignature([]int{bls.MaxMultisigKeys}, []*bls.Signature{prvs[0].SignMultisig(msg)})
+		assert.Error(t, err)
+	})
+
+	t.Run("Encoding", func(t *testing.T) {
+		sig := sign(1, 2)
+		assert.Len(t, sig.Bytes(), bls.MultisigSignatureSize)
+
+		sig2, err := bls.MultisigSignatureFromBytes(sig.Bytes())
+		require.NoError(t, err)
+		assert.True(t, sig.EqualsTo(sig2))
+		assert.NoError(t, pub.Verify(msg, sig2))
+		assert.False(t, sig.EqualsTo(sign(0, 2)))
+
+		_, err = bls.MultisigSignatureFromBytes(sig.Bytes()[1:])
+		assert.Error(t, err)
+	})
+}
@@ -130,6 +130,10 @@ func (prv *PrivateKey) Sign(msg []byte) crypto.Signature {
 }
 
 func (prv *PrivateKey) SignNative(msg []byte) *Signature {
+	return prv.sign(msg, dst)
+}
+
+func (prv *PrivateKey) sign(msg, dst []byte) *Signature {
 	g1 := bls12381.NewG1()
 
 	q, err := g1.HashToCurve(msg, dst)
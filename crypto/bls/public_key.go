@@ -119,7 +119,10 @@ func (pub *PublicKey) Verify(msg []byte, sig crypto.Signature) error {
 	}
 	g1 := bls12381.NewG1()
 
-	r := sig.(*Signature)
+	r, ok := sig.(*Signature)
+	if !ok {
+		return errors.Error(errors.ErrInvalidSignature)
+	}
 	if g1.IsZero(&r.pointG1) {
 		return errors.Errorf(errors.ErrInvalidSignature,
 			"signature is zero")
@@ -63,3 +63,15 @@ type InvalidFeeError struct {
 func (e InvalidFeeError) Error() string {
 	return fmt.Sprintf("fee is invalid, expected: %s, got: %s", e.Expected, e.Fee)
 }
+
+// NotActivatedError is returned when the transaction uses a feature
+// that is not activated at the current block version.
+type NotActivatedError struct {
+	Feature      string
+	BlockVersion uint8
+}
+
+func (e NotActivatedError) Error() string {
+	return fmt.Sprintf("%s is not activated before block version %v",
+		e.Feature, e.BlockVersion)
+}
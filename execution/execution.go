@@ -12,6 +12,16 @@ import (
 	"github.com/pactus-project/pactus/util"
 )
 
+// activationVersions holds the block versions that activate the payload types
+// added after the first block version.
+var activationVersions = map[payload.Type]uint8{
+	payload.TypeSlash:           param.BlockVersionV2,
+	payload.TypeBatchTransfer:   param.BlockVersionV2,
+	payload.TypeVestingTransfer: param.BlockVersionV2,
+	payload.TypePartialUnbond:   param.BlockVersionV2,
+	payload.TypeRotateKey:       param.BlockVersionV2,
+}
+
 type Executor interface {
 	Execute(trx *tx.Tx, sb sandbox.Sandbox) error
 }
@@ -27,6 +37,11 @@ func newExecution(strict bool) *Execution {
 	execs[payload.TypeSortition] = executor.NewSortitionExecutor(strict)
 	execs[payload.TypeUnbond] = executor.NewUnbondExecutor(strict)
 	execs[payload.TypeWithdraw] = executor.NewWithdrawExecutor(strict)
+	execs[payload.TypeSlash] = executor.NewSlashExecutor(strict)
+	execs[payload.TypeBatchTransfer] = executor.NewBatchTransferExecutor(strict)
+	execs[payload.TypeVestingTransfer] = executor.NewVestingTransferExecutor(strict)
+	execs[payload.TypePartialUnbond] = executor.NewPartialUnbondExecutor(strict)
+	execs[payload.TypeRotateKey] = executor.NewRotateKeyExecutor(strict)
 
 	return &Execution{
 		executors: execs,
@@ -49,6 +64,10 @@ func (exe *Execution) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 		}
 	}
 
+	if err := exe.checkActivation(trx, sb); err != nil {
+		return err
+	}
+
 	if err := exe.checkLockTime(trx, sb); err != nil {
 		return err
 	}
@@ -73,6 +92,33 @@ func (exe *Execution) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 	return nil
 }
 
+// checkActivation rejects the transactions that use the payload types or the multisig accounts
+// before the block version that activates them.
+func (*Execution) checkActivation(trx *tx.Tx, sb sandbox.Sandbox) error {
+	blockVersion := sb.Params().BlockVersion
+
+	payloadType := trx.Payload().Type()
+	if version, ok := activationVersions[payloadType]; ok && blockVersion < version {
+		return NotActivatedError{
+			Feature:      payloadType.String(),
+			BlockVersion: version,
+		}
+	}
+
+	if blockVersion < param.BlockVersionV2 {
+		receiver := trx.Payload().Receiver()
+		if trx.Payload().Signer().IsMultisigAddress() ||
+			(receiver != nil && receiver.IsMultisigAddress()) {
+			return NotActivatedError{
+				Feature:      "multisig account",
+				BlockVersion: param.BlockVersionV2,
+			}
+		}
+	}
+
+	return nil
+}
+
 func (exe *Execution) checkLockTime(trx *tx.Tx, sb sandbox.Sandbox) error {
 	interval := sb.Params().TransactionToLiveInterval
 
@@ -108,6 +154,8 @@ func (exe *Execution) checkFee(trx *tx.Tx, sb sandbox.Sandbox) error {
 	var fee amount.Amount
 	if trx.IsSubsidyTx() {
 		fee = 0
+	} else if pld, ok := trx.Payload().(*payload.BatchTransferPayload); ok {
+		fee = CalculateBatchTransferFee(pld.Value(), len(pld.Recipients), sb.Params())
 	} else {
 		fee = CalculateFee(trx.Payload().Value(), trx.Payload().Type(), sb.Params())
 	}
@@ -136,11 +184,16 @@ func (exe *Execution) checkFee(trx *tx.Tx, sb sandbox.Sandbox) error {
 func CalculateFee(amt amount.Amount, payloadType payload.Type, params *param.Params) amount.Amount {
 	switch payloadType {
 	case payload.TypeUnbond,
-		payload.TypeSortition:
+		payload.TypePartialUnbond,
+		payload.TypeRotateKey,
+		payload.TypeSortition,
+		payload.TypeSlash:
 
 		return 0
 
 	case payload.TypeTransfer,
+		payload.TypeBatchTransfer,
+		payload.TypeVestingTransfer,
 		payload.TypeBond,
 		payload.TypeWithdraw:
 		fee := amt.MulF64(params.FeeFraction)
@@ -153,3 +206,12 @@ func CalculateFee(amt amount.Amount, payloadType payload.Type, params *param.Par
 		return 0
 	}
 }
+
+// CalculateBatchTransferFee calculates the fee of a batch transfer with the given number of recipients.
+// The minimum fee is charged per recipient, so a batch transfer can't be cheaper than
+// the transfers it replaces.
+func CalculateBatchTransferFee(amt amount.Amount, recipients int, params *param.Params) amount.Amount {
+	fee := CalculateFee(amt, payload.TypeBatchTransfer, params)
+
+	return util.Max(fee, params.MinimumFee*amount.Amount(recipients))
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/sandbox"
 	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/param"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/util/errors"
@@ -178,6 +179,82 @@ func TestExecution(t *testing.T) {
 	})
 }
 
+func TestActivation(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	sb := sandbox.MockingSandbox(ts)
+	exe := NewExecutor()
+
+	lockTime := sb.CurrentHeight()
+	multisigPub, _ := ts.RandMultisigKey(2, 3)
+	multisigAddr := multisigPub.AccountAddress()
+
+	vote1, _ := ts.GenerateTestPrepareVote(lockTime, 0)
+	vote2, _ := ts.GenerateTestPrepareVote(lockTime, 0)
+	newPub, _ := ts.RandBLSKeyPair()
+
+	tests := []struct {
+		name    string
+		trx     *tx.Tx
+		feature string
+	}{
+		{
+			"Slash",
+			tx.NewSlashTx(lockTime, ts.RandAccAddress(), vote1, vote2, ""),
+			payload.TypeSlash.String(),
+		},
+		{
+			"Vesting transfer",
+			tx.NewVestingTransferTx(lockTime, ts.RandAccAddress(), ts.RandAccAddress(),
+				1e9, lockTime+100, lockTime+200, 1e6, ""),
+			payload.TypeVestingTransfer.String(),
+		},
+		{
+			"Rotate key",
+			tx.NewRotateKeyTx(lockTime, ts.RandValAddress(), newPub, ts.RandBLSSignature(), ""),
+			payload.TypeRotateKey.String(),
+		},
+		{
+			"Partial unbond",
+			tx.NewPartialUnbondTx(lockTime, ts.RandValAddress(), 1e9, ""),
+			payload.TypePartialUnbond.String(),
+		},
+		{
+			"Batch transfer",
+			tx.NewBatchTransferTx(lockTime, ts.RandAccAddress(),
+				[]payload.BatchTransferRecipient{{To: ts.RandAccAddress(), Amount: 1e9}}, 1e6, ""),
+			payload.TypeBatchTransfer.String(),
+		},
+		{
+			"Transfer from multisig account",
+			tx.NewTransferTx(lockTime, multisigAddr, ts.RandAccAddress(), 1e9, 1e6, ""),
+			"multisig account",
+		},
+		{
+			"Transfer to multisig account",
+			tx.NewTransferTx(lockTime, ts.RandAccAddress(), multisigAddr, 1e9, 1e6, ""),
+			"multisig account",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sb.TestParams.BlockVersion = 1
+			expectedErr := NotActivatedError{Feature: tt.feature, BlockVersion: param.BlockVersionV2}
+			assert.ErrorIs(t, exe.Execute(tt.trx, sb), expectedErr)
+
+			sb.TestParams.BlockVersion = param.BlockVersionV2
+			assert.NoError(t, exe.checkActivation(tt.trx, sb))
+		})
+	}
+
+	t.Run("Transfer between BLS accounts", func(t *testing.T) {
+		sb.TestParams.BlockVersion = 1
+		trx := tx.NewTransferTx(lockTime, ts.RandAccAddress(), ts.RandAccAddress(), 1e9, 1e6, "")
+		assert.NoError(t, exe.checkActivation(trx, sb))
+	})
+}
+
 func TestReplay(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
@@ -279,3 +356,44 @@ func TestFee(t *testing.T) {
 		assert.Equal(t, expectedFee, test.expectedFee, "test %v failed. invalid fee", i)
 	}
 }
+
+func TestBatchTransferFee(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	exe := NewChecker()
+	sb := sandbox.MockingSandbox(ts)
+
+	tests := []struct {
+		amounts     []amount.Amount
+		expectedFee amount.Amount
+	}{
+		{[]amount.Amount{1}, sb.TestParams.MinimumFee},
+		{[]amount.Amount{1, 1, 1}, 3 * sb.TestParams.MinimumFee},
+		{[]amount.Amount{1e6, 1e6}, 2 * sb.TestParams.MinimumFee},
+		{[]amount.Amount{1e9, 2e9, 3e9}, 600000},
+		{[]amount.Amount{1e12, 1e12}, sb.TestParams.MaximumFee},
+	}
+
+	sender := ts.RandAccAddress()
+	for i, test := range tests {
+		recipients := make([]payload.BatchTransferRecipient, 0, len(test.amounts))
+		total := amount.Amount(0)
+		for _, amt := range test.amounts {
+			recipients = append(recipients, payload.BatchTransferRecipient{
+				To: ts.RandAccAddress(), Amount: amt,
+			})
+			total += amt
+		}
+
+		expectedFee := CalculateBatchTransferFee(total, len(recipients), sb.Params())
+		assert.Equal(t, test.expectedFee, expectedFee, "test %v failed. invalid fee", i)
+
+		trx := tx.NewBatchTransferTx(sb.CurrentHeight()+1, sender, recipients, test.expectedFee,
+			"testing fee")
+		assert.NoError(t, exe.checkFee(trx, sb), "test %v failed. unexpected error", i)
+
+		trx = tx.NewBatchTransferTx(sb.CurrentHeight()+1, sender, recipients, sb.TestParams.MinimumFee-2,
+			"testing fee")
+		assert.Error(t, exe.checkFee(trx, sb), "test %v failed. expected error", i)
+	}
+}
@@ -0,0 +1,48 @@
+package executor
+
+import (
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+type BatchTransferExecutor struct {
+	strict bool
+}
+
+func NewBatchTransferExecutor(strict bool) *BatchTransferExecutor {
+	return &BatchTransferExecutor{strict: strict}
+}
+
+func (e *BatchTransferExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.BatchTransferPayload)
+
+	senderAcc := sb.Account(pld.From)
+	if senderAcc == nil {
+		return errors.Errorf(errors.ErrInvalidAddress,
+			"unable to retrieve sender account")
+	}
+
+	total := pld.Value()
+	if sb.SpendableBalance(senderAcc) < total+trx.Fee() {
+		return ErrInsufficientFunds
+	}
+
+	senderAcc.SubtractFromBalance(total + trx.Fee())
+	sb.UpdateAccount(pld.From, senderAcc)
+
+	// The accounts are retrieved after updating the sender,
+	// so the sender can be one of the recipients.
+	for _, rcp := range pld.Recipients {
+		receiverAcc := sb.Account(rcp.To)
+		if receiverAcc == nil {
+			receiverAcc = sb.MakeNewAccount(rcp.To)
+		}
+
+		receiverAcc.AddToBalance(rcp.Amount)
+		sb.UpdateAccount(rcp.To, receiverAcc)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteBatchTransferTx(t *testing.T) {
+	td := setup(t)
+	exe := NewBatchTransferExecutor(true)
+
+	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
+	senderBalance := senderAcc.Balance()
+	// Transferring to the treasury, as an existing account.
+	existingAddr := crypto.TreasuryAddress
+	existingBalance := td.sandbox.Account(existingAddr).Balance()
+	lockTime := td.sandbox.CurrentHeight()
+
+	recipients := []payload.BatchTransferRecipient{
+		{To: td.RandAccAddress(), Amount: senderBalance / 4},
+		{To: td.RandAccAddress(), Amount: senderBalance / 8},
+		{To: existingAddr, Amount: senderBalance / 16},
+	}
+	total := senderBalance/4 + senderBalance/8 + senderBalance/16
+	fee := total.MulF64(td.sandbox.Params().FeeFraction)
+
+	t.Run("Should fail, Sender has no account", func(t *testing.T) {
+		trx := tx.NewBatchTransferTx(lockTime, td.RandAccAddress(),
+			recipients, fee, "non-existing account")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidAddress)
+	})
+
+	t.Run("Should fail, insufficient balance", func(t *testing.T) {
+		trx := tx.NewBatchTransferTx(lockTime, senderAddr,
+			[]payload.BatchTransferRecipient{
+				{To: td.RandAccAddress(), Amount: senderBalance},
+				{To: td.RandAccAddress(), Amount: 1},
+			}, 0, "insufficient balance")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx := tx.NewBatchTransferTx(lockTime, senderAddr, recipients, fee, "ok")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+	})
+
+	assert.Equal(t, senderBalance-(total+fee), td.sandbox.Account(senderAddr).Balance())
+	assert.Equal(t, recipients[0].Amount, td.sandbox.Account(recipients[0].To).Balance())
+	assert.Equal(t, recipients[1].Amount, td.sandbox.Account(recipients[1].To).Balance())
+	assert.Equal(t, existingBalance+recipients[2].Amount, td.sandbox.Account(existingAddr).Balance())
+
+	td.checkTotalCoin(t, fee)
+}
+
+func TestBatchTransferToSelf(t *testing.T) {
+	td := setup(t)
+	exe := NewBatchTransferExecutor(true)
+
+	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
+	senderBalance := senderAcc.Balance()
+	receiverAddr := td.RandAccAddress()
+	amt := senderBalance / 2
+	fee := amount.Amount(1e9)
+	lockTime := td.sandbox.CurrentHeight()
+
+	trx := tx.NewBatchTransferTx(lockTime, senderAddr, []payload.BatchTransferRecipient{
+		{To: senderAddr, Amount: amt},
+		{To: receiverAddr, Amount: 1},
+	}, fee, "ok")
+	err := exe.Execute(trx, td.sandbox)
+	assert.NoError(t, err)
+
+	assert.Equal(t, senderBalance-fee-1, td.sandbox.Account(senderAddr).Balance())
+	assert.Equal(t, amount.Amount(1), td.sandbox.Account(receiverAddr).Balance())
+}
@@ -30,6 +30,11 @@ func (e *BondExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 			return errors.Errorf(errors.ErrInvalidPublicKey,
 				"public key is not set")
 		}
+		// The rotated keys are reserved, so the votes signed by them are attributed to their validators.
+		if sb.ValidatorByPreviousKey(pld.To) != nil {
+			return errors.Errorf(errors.ErrInvalidPublicKey,
+				"key %v is rotated out by a validator", pld.To)
+		}
 		// TODO: remove me in future
 		if pld.Stake < sb.Params().MinimumStake {
 			return errors.Errorf(errors.ErrInvalidTx,
@@ -63,10 +68,10 @@ func (e *BondExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 				"validator %v joins committee in the next height", pld.To)
 		}
 	}
-	if senderAcc.Balance() < pld.Stake+trx.Fee() {
+	if sb.SpendableBalance(senderAcc) < pld.Stake+trx.Fee() {
 		return ErrInsufficientFunds
 	}
-	if receiverVal.Stake()+pld.Stake > sb.Params().MaximumStake {
+	if receiverVal.BondedStake()+pld.Stake > sb.Params().MaximumStake {
 		return errors.Errorf(errors.ErrInvalidAmount,
 			"validator's stake can't be more than %v", sb.Params().MaximumStake)
 	}
@@ -76,7 +81,7 @@ func (e *BondExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 	// However, since they were committed in the past, they should be accepted by new nodes.
 	if sb.CurrentHeight() > 740_000 {
 		if pld.Stake < sb.Params().MinimumStake {
-			if pld.Stake == 0 || receiverVal.Stake()+pld.Stake != sb.Params().MaximumStake {
+			if pld.Stake == 0 || receiverVal.BondedStake()+pld.Stake != sb.Params().MaximumStake {
 				return errors.Errorf(errors.ErrInvalidTx,
 					"stake amount should not be less than %v", sb.Params().MinimumStake)
 			}
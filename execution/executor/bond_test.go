@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/util/errors"
 	"github.com/stretchr/testify/assert"
@@ -66,6 +67,18 @@ func TestExecuteBondTx(t *testing.T) {
 		assert.Equal(t, errors.Code(err), errors.ErrInvalidHeight)
 	})
 
+	t.Run("Should fail, key is rotated out by a validator", func(t *testing.T) {
+		rotatedPub, _ := td.RandBLSKeyPair()
+		val := td.sandbox.MakeNewValidator(rotatedPub)
+		val.RotatePublicKey(td.RandValKey().PublicKey(), td.sandbox.CurrentHeight())
+		td.sandbox.UpdateValidator(val)
+		trx := tx.NewBondTx(lockTime, senderAddr,
+			rotatedPub.ValidatorAddress(), rotatedPub, amt, fee, "rotated key")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidPublicKey)
+	})
+
 	t.Run("Should fail, public key is not set", func(t *testing.T) {
 		trx := tx.NewBondTx(lockTime, senderAddr,
 			receiverAddr, nil, amt, fee, "no public key")
@@ -240,3 +253,21 @@ func TestSmallBond(t *testing.T) {
 		assert.Error(t, err, "Zero bond amount on full stake should be rejected")
 	})
 }
+
+func TestBondLockedBalance(t *testing.T) {
+	td := setup(t)
+	exe := NewBondExecutor(true)
+
+	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
+	curHeight := td.sandbox.CurrentHeight()
+	senderAcc.AddVesting(account.NewVesting(curHeight+1, curHeight+1, senderAcc.Balance()))
+	td.sandbox.UpdateAccount(senderAddr, senderAcc)
+
+	pub, _ := td.RandBLSKeyPair()
+	amt, fee := td.randomAmountAndFee(td.sandbox.TestParams.MinimumStake, td.sandbox.TestParams.MaximumStake)
+	trx := tx.NewBondTx(curHeight, senderAddr,
+		pub.ValidatorAddress(), pub, amt, fee, "locked coins")
+
+	err := exe.Execute(trx, td.sandbox)
+	assert.ErrorIs(t, err, ErrInsufficientFunds)
+}
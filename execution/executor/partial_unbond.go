@@ -0,0 +1,63 @@
+package executor
+
+import (
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+type PartialUnbondExecutor struct {
+	strict bool
+}
+
+func NewPartialUnbondExecutor(strict bool) *PartialUnbondExecutor {
+	return &PartialUnbondExecutor{strict: strict}
+}
+
+func (e *PartialUnbondExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.PartialUnbondPayload)
+
+	val := sb.Validator(pld.Signer())
+	if val == nil {
+		return errors.Errorf(errors.ErrInvalidAddress,
+			"unable to retrieve validator")
+	}
+
+	if val.UnbondingHeight() > 0 {
+		return errors.Errorf(errors.ErrInvalidHeight,
+			"validator has unbonded at height %v", val.UnbondingHeight())
+	}
+	if len(val.UnbondingEntries()) >= validator.MaxUnbondingEntries {
+		return errors.Errorf(errors.ErrInvalidTx,
+			"validator has %v unbonding entries, withdraw them first", len(val.UnbondingEntries()))
+	}
+	if e.strict {
+		// Similar to the unbond transaction, the stake of the validators
+		// in the committee can't be changed.
+		if sb.Committee().Contains(pld.Validator) {
+			return errors.Errorf(errors.ErrInvalidTx,
+				"validator %v is in committee", pld.Validator)
+		}
+
+		if sb.IsJoinedCommittee(pld.Validator) {
+			return errors.Errorf(errors.ErrInvalidHeight,
+				"validator %v joins committee in the next height", pld.Validator)
+		}
+	}
+
+	// The remaining stake should be enough to keep validating.
+	// To unbond the whole stake, the unbond transaction should be used.
+	if val.BondedStake()-pld.Amount < sb.Params().MinimumStake {
+		return errors.Errorf(errors.ErrInvalidAmount,
+			"remaining stake can't be less than %v", sb.Params().MinimumStake)
+	}
+
+	val.AddUnbondingEntry(pld.Amount, sb.CurrentHeight())
+
+	sb.UpdatePowerDelta(-1 * int64(pld.Amount))
+	sb.UpdateValidator(val)
+
+	return nil
+}
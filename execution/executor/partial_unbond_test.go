@@ -0,0 +1,117 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutePartialUnbondTx(t *testing.T) {
+	td := setup(t)
+	exe := NewPartialUnbondExecutor(true)
+
+	minStake := td.sandbox.TestParams.MinimumStake
+	pub, _ := td.RandBLSKeyPair()
+	valAddr := pub.ValidatorAddress()
+	val := td.sandbox.MakeNewValidator(pub)
+	val.AddToStake(3 * minStake)
+	td.sandbox.UpdateValidator(val)
+	lockTime := td.sandbox.CurrentHeight()
+
+	// Taking the stake from an account to keep the total coins unchanged.
+	bonderAddr, bonderAcc := td.sandbox.TestStore.RandomTestAcc()
+	bonderAcc.SubtractFromBalance(3 * minStake)
+	td.sandbox.UpdateAccount(bonderAddr, bonderAcc)
+
+	t.Run("Should fail, Invalid validator", func(t *testing.T) {
+		trx := tx.NewPartialUnbondTx(lockTime, td.RandValAddress(), minStake, "invalid validator")
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidAddress)
+	})
+
+	t.Run("Should fail, Inside committee", func(t *testing.T) {
+		val0 := td.sandbox.Committee().Proposer(0)
+		trx := tx.NewPartialUnbondTx(lockTime, val0.Address(), 1, "inside committee")
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+	})
+
+	t.Run("Should fail, Cannot unbond if unbonded already", func(t *testing.T) {
+		pub, _ := td.RandBLSKeyPair()
+		unbondedVal := td.sandbox.MakeNewValidator(pub)
+		unbondedVal.UpdateUnbondingHeight(td.sandbox.CurrentHeight())
+		td.sandbox.UpdateValidator(unbondedVal)
+
+		trx := tx.NewPartialUnbondTx(lockTime, pub.ValidatorAddress(), 1, "unbonded")
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidHeight)
+	})
+
+	t.Run("Should fail, Remaining stake is less than minimum stake", func(t *testing.T) {
+		trx := tx.NewPartialUnbondTx(lockTime, valAddr, 2*minStake+1, "less than minimum")
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidAmount)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx := tx.NewPartialUnbondTx(lockTime, valAddr, minStake, "ok")
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should fail, Remaining stake after the second unbonding", func(t *testing.T) {
+		trx := tx.NewPartialUnbondTx(lockTime, valAddr, minStake+1, "less than minimum")
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidAmount)
+	})
+
+	updatedVal := td.sandbox.Validator(valAddr)
+	assert.Equal(t, 3*minStake, updatedVal.Stake())
+	assert.Equal(t, int64(2*minStake), updatedVal.Power())
+	assert.Zero(t, updatedVal.UnbondingHeight())
+	assert.Equal(t, []validator.UnbondingEntry{
+		{Amount: minStake, Height: td.sandbox.CurrentHeight()},
+	}, updatedVal.UnbondingEntries())
+	assert.Equal(t, -int64(minStake), td.sandbox.PowerDelta())
+
+	td.checkTotalCoin(t, 0)
+}
+
+func TestPartialUnbondTooManyEntries(t *testing.T) {
+	td := setup(t)
+	exe := NewPartialUnbondExecutor(true)
+
+	pub, _ := td.RandBLSKeyPair()
+	val := td.sandbox.MakeNewValidator(pub)
+	val.AddToStake(td.sandbox.TestParams.MaximumStake)
+	for i := 0; i < validator.MaxUnbondingEntries; i++ {
+		val.AddUnbondingEntry(1, td.sandbox.CurrentHeight())
+	}
+	td.sandbox.UpdateValidator(val)
+
+	trx := tx.NewPartialUnbondTx(td.sandbox.CurrentHeight(), pub.ValidatorAddress(), 1, "too many")
+	err := exe.Execute(trx, td.sandbox)
+	assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+}
+
+// TestPartialUnbondInsideCommittee checks if a validator inside the committee tries to
+// unbond part of the stake.
+// In non-strict mode it should be accepted.
+func TestPartialUnbondInsideCommittee(t *testing.T) {
+	td := setup(t)
+
+	exe1 := NewPartialUnbondExecutor(true)
+	exe2 := NewPartialUnbondExecutor(false)
+	lockTime := td.sandbox.CurrentHeight()
+
+	val := td.sandbox.Committee().Proposer(0)
+	val.AddToStake(2 * td.sandbox.TestParams.MinimumStake)
+	td.sandbox.UpdateValidator(val)
+	trx := tx.NewPartialUnbondTx(lockTime, val.Address(), td.sandbox.TestParams.MinimumStake, "")
+
+	assert.Error(t, exe1.Execute(trx, td.sandbox))
+	assert.NoError(t, exe2.Execute(trx, td.sandbox))
+}
@@ -0,0 +1,71 @@
+package executor
+
+import (
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+type RotateKeyExecutor struct {
+	strict bool
+}
+
+func NewRotateKeyExecutor(strict bool) *RotateKeyExecutor {
+	return &RotateKeyExecutor{strict: strict}
+}
+
+func (e *RotateKeyExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.RotateKeyPayload)
+
+	val := sb.Validator(pld.Signer())
+	if val == nil {
+		return errors.Errorf(errors.ErrInvalidAddress,
+			"unable to retrieve validator")
+	}
+
+	newAddr := pld.NewAddress()
+	if sb.Validator(newAddr) != nil {
+		return errors.Errorf(errors.ErrInvalidPublicKey,
+			"validator %v already exists", newAddr)
+	}
+	// The rotated keys are reserved, so the votes signed by them are attributed to their validators.
+	if sb.ValidatorByPreviousKey(newAddr) != nil {
+		return errors.Errorf(errors.ErrInvalidPublicKey,
+			"key %v is rotated out by a validator", newAddr)
+	}
+	if e.strict {
+		// The committee verifies the votes and the certificates using the public keys
+		// of its members. Therefore, the key of a validator can't be rotated while it is
+		// in the committee or joining it.
+		if sb.Committee().Contains(pld.Validator) {
+			return errors.Errorf(errors.ErrInvalidTx,
+				"validator %v is in committee", pld.Validator)
+		}
+
+		if sb.IsJoinedCommittee(pld.Validator) {
+			return errors.Errorf(errors.ErrInvalidHeight,
+				"validator %v joins committee in the next height", pld.Validator)
+		}
+	}
+
+	// The votes signed by a previous key can be used for slashing until they expire,
+	// which is after the unbonding interval. The expired keys are removed.
+	if sb.CurrentHeight() > sb.Params().UnbondInterval {
+		val.RemovePreviousKeys(sb.CurrentHeight() - sb.Params().UnbondInterval)
+	}
+	if len(val.PreviousKeys()) >= validator.MaxPreviousKeys {
+		return errors.Errorf(errors.ErrInvalidTx,
+			"validator %v has rotated its key more than %v times in the unbonding interval",
+			pld.Validator, validator.MaxPreviousKeys)
+	}
+
+	// The new key is effective from the current height, which is the height of the block
+	// that includes the transaction. The previous key is effective until this height.
+	val.RotatePublicKey(pld.NewPublicKey, sb.CurrentHeight())
+
+	sb.RotateValidatorKey(pld.Validator, val)
+
+	return nil
+}
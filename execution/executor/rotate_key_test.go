@@ -0,0 +1,145 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (td *testData) rotateKeyTx(valAddr crypto.Address) (*tx.Tx, *bls.PublicKey) {
+	newPub, newPrv := td.RandBLSKeyPair()
+	newKeySig := newPrv.SignNative(payload.RotateKeySignBytes(valAddr, newPub))
+	trx := tx.NewRotateKeyTx(td.sandbox.CurrentHeight(), valAddr, newPub, newKeySig, "rotate key")
+
+	return trx, newPub
+}
+
+func TestExecuteRotateKeyTx(t *testing.T) {
+	td := setup(t)
+	exe := NewRotateKeyExecutor(true)
+
+	pub, _ := td.RandBLSKeyPair()
+	valAddr := pub.ValidatorAddress()
+	val := td.sandbox.MakeNewValidator(pub)
+	val.AddToStake(td.sandbox.TestParams.MinimumStake)
+	val.UpdateLastBondingHeight(td.RandHeight())
+	val.UpdateLastSortitionHeight(td.RandHeight())
+	td.sandbox.UpdateValidator(val)
+
+	// Taking the stake from an account to keep the total coins unchanged.
+	bonderAddr, bonderAcc := td.sandbox.TestStore.RandomTestAcc()
+	bonderAcc.SubtractFromBalance(val.Stake())
+	td.sandbox.UpdateAccount(bonderAddr, bonderAcc)
+
+	t.Run("Should fail, Invalid validator", func(t *testing.T) {
+		trx, _ := td.rotateKeyTx(td.RandValAddress())
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidAddress)
+	})
+
+	t.Run("Should fail, Inside committee", func(t *testing.T) {
+		val0 := td.sandbox.Committee().Proposer(0)
+		trx, _ := td.rotateKeyTx(val0.Address())
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+	})
+
+	t.Run("Should fail, Joining committee", func(t *testing.T) {
+		td.sandbox.JoinedToCommittee(valAddr)
+		defer delete(td.sandbox.TestJoinedValidators, valAddr)
+
+		trx, _ := td.rotateKeyTx(valAddr)
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidHeight)
+	})
+
+	t.Run("Should fail, New key is rotated out by another validator", func(t *testing.T) {
+		prevPub, prevPrv := td.RandBLSKeyPair()
+		otherVal := td.sandbox.MakeNewValidator(prevPub)
+		otherVal.RotatePublicKey(td.RandValKey().PublicKey(), td.sandbox.CurrentHeight())
+		td.sandbox.UpdateValidator(otherVal)
+
+		newKeySig := prevPrv.SignNative(payload.RotateKeySignBytes(valAddr, prevPub))
+		trx := tx.NewRotateKeyTx(td.sandbox.CurrentHeight(), valAddr, prevPub, newKeySig, "rotated key")
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidPublicKey)
+	})
+
+	t.Run("Should fail, New key belongs to another validator", func(t *testing.T) {
+		otherVal := td.sandbox.TestStore.RandomTestVal()
+		trx := tx.NewRotateKeyTx(td.sandbox.CurrentHeight(), valAddr,
+			otherVal.PublicKey(), td.RandBLSSignature(), "existing key")
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidPublicKey)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx, newPub := td.rotateKeyTx(valAddr)
+		err := exe.Execute(trx, td.sandbox)
+		require.NoError(t, err)
+
+		assert.Nil(t, td.sandbox.Validator(valAddr))
+		rotatedVal := td.sandbox.Validator(newPub.ValidatorAddress())
+		require.NotNil(t, rotatedVal)
+		assert.Equal(t, newPub, rotatedVal.PublicKey())
+		assert.Equal(t, val.Number(), rotatedVal.Number())
+		assert.Equal(t, val.Stake(), rotatedVal.Stake())
+		assert.Equal(t, val.LastBondingHeight(), rotatedVal.LastBondingHeight())
+		assert.Equal(t, val.LastSortitionHeight(), rotatedVal.LastSortitionHeight())
+		assert.Equal(t, []validator.PreviousKey{
+			{PublicKey: val.PublicKey(), Height: td.sandbox.CurrentHeight()},
+		}, rotatedVal.PreviousKeys())
+		assert.Equal(t, rotatedVal.Hash(), td.sandbox.ValidatorByPreviousKey(valAddr).Hash())
+
+		t.Run("Should fail, Previous key is not valid anymore", func(t *testing.T) {
+			trx, _ := td.rotateKeyTx(valAddr)
+			err := exe.Execute(trx, td.sandbox)
+			assert.Equal(t, errors.Code(err), errors.ErrInvalidAddress)
+		})
+
+		t.Run("Should fail, Too many rotations in the unbonding interval", func(t *testing.T) {
+			addr := newPub.ValidatorAddress()
+			for i := 1; i < validator.MaxPreviousKeys; i++ {
+				trx, pub := td.rotateKeyTx(addr)
+				require.NoError(t, exe.Execute(trx, td.sandbox))
+				addr = pub.ValidatorAddress()
+			}
+
+			trx, _ := td.rotateKeyTx(addr)
+			err := exe.Execute(trx, td.sandbox)
+			assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+
+			// The expired keys are removed once the unbonding interval has passed.
+			td.sandbox.TestStore.LastHeight += td.sandbox.TestParams.UnbondInterval + 1
+			trx, pub := td.rotateKeyTx(addr)
+			require.NoError(t, exe.Execute(trx, td.sandbox))
+			assert.Len(t, td.sandbox.Validator(pub.ValidatorAddress()).PreviousKeys(), 1)
+			assert.Nil(t, td.sandbox.ValidatorByPreviousKey(valAddr))
+		})
+	})
+
+	assert.Zero(t, td.sandbox.PowerDelta())
+	td.checkTotalCoin(t, 0)
+}
+
+func TestRotateKeyNonStrictMode(t *testing.T) {
+	td := setup(t)
+	exe1 := NewRotateKeyExecutor(true)
+	exe2 := NewRotateKeyExecutor(false)
+
+	val0 := td.sandbox.Committee().Proposer(0)
+	trx, _ := td.rotateKeyTx(val0.Address())
+
+	err := exe1.Execute(trx, td.sandbox)
+	assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+
+	err = exe2.Execute(trx, td.sandbox)
+	assert.NoError(t, err)
+}
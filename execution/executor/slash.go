@@ -0,0 +1,129 @@
+package executor
+
+import (
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+const (
+	// slashFraction is the fraction of the offender's stake that is slashed.
+	slashFraction = 0.1
+	// reporterFraction is the fraction of the slashed stake that is rewarded to the reporter.
+	// The rest of the slashed stake goes to the treasury.
+	reporterFraction = 0.1
+)
+
+type SlashExecutor struct {
+	strict bool
+}
+
+func NewSlashExecutor(strict bool) *SlashExecutor {
+	return &SlashExecutor{strict: strict}
+}
+
+func (e *SlashExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.SlashPayload)
+
+	height := pld.Vote1.Height()
+	if height >= sb.CurrentHeight() {
+		return errors.Errorf(errors.ErrInvalidHeight,
+			"votes are not for a committed height: %v", height)
+	}
+	if sb.CurrentHeight()-height > sb.Params().UnbondInterval {
+		return errors.Errorf(errors.ErrInvalidHeight,
+			"votes are expired, signed at height %v", height)
+	}
+
+	val, pubKey, err := offenderKey(pld.Offender(), height, sb)
+	if err != nil {
+		return err
+	}
+
+	// The offender is unbonded after being slashed, so it can't be slashed twice.
+	if val.UnbondingHeight() > 0 {
+		return errors.Errorf(errors.ErrInvalidHeight,
+			"validator has unbonded at height %v", val.UnbondingHeight())
+	}
+
+	if e.strict {
+		// In strict mode, slash transactions will be rejected if the offender is
+		// in the committee.
+		// In non-strict mode, they are added to the transaction pool and
+		// processed once eligible.
+		if sb.Committee().Contains(val.Address()) {
+			return errors.Errorf(errors.ErrInvalidTx,
+				"validator %v is in committee", val.Address())
+		}
+
+		// In strict mode, slash transactions will be rejected if the offender is
+		// going to be in the committee for the next height.
+		// In non-strict mode, they are added to the transaction pool and
+		// processed once eligible.
+		if sb.IsJoinedCommittee(val.Address()) {
+			return errors.Errorf(errors.ErrInvalidHeight,
+				"validator %v joins committee in the next height", val.Address())
+		}
+	}
+
+	if err := pld.Vote1.Verify(pubKey); err != nil {
+		return errors.Errorf(errors.ErrInvalidSignature,
+			"invalid first vote: %v", err)
+	}
+	if err := pld.Vote2.Verify(pubKey); err != nil {
+		return errors.Errorf(errors.ErrInvalidSignature,
+			"invalid second vote: %v", err)
+	}
+
+	reporter := sb.Account(pld.Reporter)
+	if reporter == nil {
+		reporter = sb.MakeNewAccount(pld.Reporter)
+	}
+
+	slashed := val.Stake().MulF64(slashFraction)
+	reward := slashed.MulF64(reporterFraction)
+
+	// The offender loses its power and is forced to unbond.
+	sb.UpdatePowerDelta(-1 * val.Power())
+	val.SlashStake(slashed)
+	val.UpdateUnbondingHeight(sb.CurrentHeight())
+	sb.UpdateValidator(val)
+
+	reporter.AddToBalance(reward)
+	sb.UpdateAccount(pld.Reporter, reporter)
+
+	treasury := sb.Account(crypto.TreasuryAddress)
+	treasury.AddToBalance(slashed - reward)
+	sb.UpdateAccount(crypto.TreasuryAddress, treasury)
+
+	return nil
+}
+
+// offenderKey returns the validator that has signed the votes at the given height,
+// alongside the public key that has signed them.
+// The validator might have rotated its key after signing the votes,
+// so the votes are verified by the key that was effective at their height.
+func offenderKey(offender crypto.Address, height uint32, sb sandbox.Sandbox,
+) (*validator.Validator, *bls.PublicKey, error) {
+	if val := sb.Validator(offender); val != nil {
+		return val, val.PublicKey(), nil
+	}
+
+	val := sb.ValidatorByPreviousKey(offender)
+	if val == nil {
+		return nil, nil, errors.Errorf(errors.ErrInvalidAddress,
+			"unable to retrieve validator")
+	}
+
+	prevKey, _ := val.PreviousKey(offender)
+	if height >= prevKey.Height {
+		return nil, nil, errors.Errorf(errors.ErrInvalidHeight,
+			"key is rotated out at height %v, before the votes", prevKey.Height)
+	}
+
+	return val, prevKey.PublicKey, nil
+}
@@ -0,0 +1,235 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func (td *testData) conflictingVotes(valKey *bls.ValidatorKey, height uint32) (*vote.Vote, *vote.Vote) {
+	vote1 := vote.NewPrecommitVote(td.RandHash(), height, 0, valKey.Address())
+	vote2 := vote.NewPrecommitVote(td.RandHash(), height, 0, valKey.Address())
+	td.HelperSignVote(valKey, vote1)
+	td.HelperSignVote(valKey, vote2)
+
+	return vote1, vote2
+}
+
+func TestExecuteSlashTx(t *testing.T) {
+	td := setup(t)
+	exe := NewSlashExecutor(true)
+	td.sandbox.TestParams.UnbondInterval = 100
+
+	bonderAddr, bonderAcc := td.sandbox.TestStore.RandomTestAcc()
+	stake, _ := td.randomAmountAndFee(td.sandbox.TestParams.MinimumStake, bonderAcc.Balance())
+	bonderAcc.SubtractFromBalance(stake)
+	td.sandbox.UpdateAccount(bonderAddr, bonderAcc)
+
+	valKey := td.RandValKey()
+	valAddr := valKey.Address()
+	val := td.sandbox.MakeNewValidator(valKey.PublicKey())
+	val.AddToStake(stake)
+	td.sandbox.UpdateValidator(val)
+
+	reporterAddr := td.RandAccAddress()
+	lockTime := td.sandbox.CurrentHeight()
+	height := td.sandbox.CurrentHeight() - 1
+
+	t.Run("Should fail, Invalid validator", func(t *testing.T) {
+		vote1, vote2 := td.conflictingVotes(td.RandValKey(), height)
+		trx := tx.NewSlashTx(lockTime, reporterAddr, vote1, vote2, "invalid validator")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidAddress)
+	})
+
+	t.Run("Should fail, Unbonded validator", func(t *testing.T) {
+		unbondedKey := td.RandValKey()
+		unbondedVal := td.sandbox.MakeNewValidator(unbondedKey.PublicKey())
+		unbondedVal.UpdateUnbondingHeight(td.sandbox.CurrentHeight())
+		td.sandbox.UpdateValidator(unbondedVal)
+
+		vote1, vote2 := td.conflictingVotes(unbondedKey, height)
+		trx := tx.NewSlashTx(lockTime, reporterAddr, vote1, vote2, "unbonded validator")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidHeight)
+	})
+
+	t.Run("Should fail, Votes for an uncommitted height", func(t *testing.T) {
+		vote1, vote2 := td.conflictingVotes(valKey, td.sandbox.CurrentHeight())
+		trx := tx.NewSlashTx(lockTime, reporterAddr, vote1, vote2, "uncommitted height")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidHeight)
+	})
+
+	t.Run("Should fail, Expired votes", func(t *testing.T) {
+		expiredHeight := td.sandbox.CurrentHeight() - td.sandbox.TestParams.UnbondInterval - 1
+		vote1, vote2 := td.conflictingVotes(valKey, expiredHeight)
+		trx := tx.NewSlashTx(lockTime, reporterAddr, vote1, vote2, "expired votes")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidHeight)
+	})
+
+	t.Run("Should fail, Invalid signature", func(t *testing.T) {
+		vote1, _ := td.conflictingVotes(valKey, height)
+		vote2 := vote.NewPrecommitVote(td.RandHash(), height, 0, valAddr)
+		vote2.SetSignature(td.RandBLSSignature())
+		trx := tx.NewSlashTx(lockTime, reporterAddr, vote1, vote2, "invalid signature")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidSignature)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		treasuryBalance := td.sandbox.Account(crypto.TreasuryAddress).Balance()
+		vote1, vote2 := td.conflictingVotes(valKey, height)
+		trx := tx.NewSlashTx(lockTime, reporterAddr, vote1, vote2, "ok")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+
+		slashed := stake.MulF64(slashFraction)
+		reward := slashed.MulF64(reporterFraction)
+		slashedVal := td.sandbox.Validator(valAddr)
+		assert.Equal(t, stake-slashed, slashedVal.Stake())
+		assert.Zero(t, slashedVal.Power())
+		assert.Equal(t, td.sandbox.CurrentHeight(), slashedVal.UnbondingHeight())
+		assert.Equal(t, int64(-stake), td.sandbox.PowerDelta())
+		assert.Equal(t, reward, td.sandbox.Account(reporterAddr).Balance())
+		assert.Equal(t, treasuryBalance+slashed-reward,
+			td.sandbox.Account(crypto.TreasuryAddress).Balance())
+
+		// Execute again, should fail
+		err = exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidHeight)
+	})
+
+	td.checkTotalCoin(t, 0)
+}
+
+func TestSlashRotatedKey(t *testing.T) {
+	td := setup(t)
+	exe := NewSlashExecutor(true)
+	td.sandbox.TestParams.UnbondInterval = 100
+
+	bonderAddr, bonderAcc := td.sandbox.TestStore.RandomTestAcc()
+	stake, _ := td.randomAmountAndFee(td.sandbox.TestParams.MinimumStake, bonderAcc.Balance())
+	bonderAcc.SubtractFromBalance(stake)
+	td.sandbox.UpdateAccount(bonderAddr, bonderAcc)
+
+	// The validator has rotated its key ten blocks ago.
+	prevKey := td.RandValKey()
+	rotationHeight := td.sandbox.CurrentHeight() - 10
+	val := td.sandbox.MakeNewValidator(prevKey.PublicKey())
+	val.AddToStake(stake)
+	val.RotatePublicKey(td.RandValKey().PublicKey(), rotationHeight)
+	td.sandbox.UpdateValidator(val)
+
+	reporterAddr := td.RandAccAddress()
+	lockTime := td.sandbox.CurrentHeight()
+
+	t.Run("Should fail, Votes are signed after the rotation", func(t *testing.T) {
+		vote1, vote2 := td.conflictingVotes(prevKey, rotationHeight)
+		trx := tx.NewSlashTx(lockTime, reporterAddr, vote1, vote2, "after rotation")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidHeight)
+	})
+
+	t.Run("Ok, Votes are signed before the rotation", func(t *testing.T) {
+		vote1, vote2 := td.conflictingVotes(prevKey, rotationHeight-1)
+		trx := tx.NewSlashTx(lockTime, reporterAddr, vote1, vote2, "before rotation")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+
+		slashedVal := td.sandbox.Validator(val.Address())
+		assert.Equal(t, stake-stake.MulF64(slashFraction), slashedVal.Stake())
+		assert.Equal(t, td.sandbox.CurrentHeight(), slashedVal.UnbondingHeight())
+	})
+
+	td.checkTotalCoin(t, 0)
+}
+
+func TestSlashPartiallyUnbonded(t *testing.T) {
+	td := setup(t)
+	exe := NewSlashExecutor(true)
+	td.sandbox.TestParams.UnbondInterval = 100
+
+	bonderAddr, bonderAcc := td.sandbox.TestStore.RandomTestAcc()
+	stake, _ := td.randomAmountAndFee(td.sandbox.TestParams.MinimumStake, bonderAcc.Balance())
+	bonderAcc.SubtractFromBalance(stake)
+	td.sandbox.UpdateAccount(bonderAddr, bonderAcc)
+
+	// The validator has partially unbonded half of its stake.
+	valKey := td.RandValKey()
+	val := td.sandbox.MakeNewValidator(valKey.PublicKey())
+	val.AddToStake(stake)
+	val.AddUnbondingEntry(stake/2, td.sandbox.CurrentHeight()-1)
+	td.sandbox.UpdateValidator(val)
+	entries := val.UnbondingEntries()
+
+	lockTime := td.sandbox.CurrentHeight()
+	vote1, vote2 := td.conflictingVotes(valKey, td.sandbox.CurrentHeight()-1)
+	trx := tx.NewSlashTx(lockTime, td.RandAccAddress(), vote1, vote2, "partially unbonded")
+
+	err := exe.Execute(trx, td.sandbox)
+	assert.NoError(t, err)
+
+	// The penalty is taken from the bonded stake, so the unbonding entries are untouched.
+	slashedVal := td.sandbox.Validator(val.Address())
+	assert.Equal(t, stake-stake.MulF64(slashFraction), slashedVal.Stake())
+	assert.Equal(t, entries, slashedVal.UnbondingEntries())
+
+	td.checkTotalCoin(t, 0)
+}
+
+func TestSlashInsideCommittee(t *testing.T) {
+	td := setup(t)
+	exe1 := NewSlashExecutor(true)
+	exe2 := NewSlashExecutor(false)
+
+	cmt, valKeys := td.GenerateTestCommittee(4)
+	td.sandbox.TestCommittee = cmt
+	for _, val := range cmt.Validators() {
+		td.sandbox.UpdateValidator(val)
+	}
+
+	lockTime := td.sandbox.CurrentHeight()
+	vote1, vote2 := td.conflictingVotes(valKeys[0], td.sandbox.CurrentHeight()-1)
+	trx := tx.NewSlashTx(lockTime, td.RandAccAddress(), vote1, vote2, "inside committee")
+
+	err := exe1.Execute(trx, td.sandbox)
+	assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+	assert.NoError(t, exe2.Execute(trx, td.sandbox))
+}
+
+// TestSlashJoiningCommittee checks if a validator is slashed after evaluating sortition.
+// In non-strict mode it should be accepted.
+func TestSlashJoiningCommittee(t *testing.T) {
+	td := setup(t)
+	exe1 := NewSlashExecutor(true)
+	exe2 := NewSlashExecutor(false)
+
+	valKey := td.RandValKey()
+	val := td.sandbox.MakeNewValidator(valKey.PublicKey())
+	val.UpdateLastSortitionHeight(td.sandbox.CurrentHeight())
+	td.sandbox.UpdateValidator(val)
+	td.sandbox.JoinedToCommittee(val.Address())
+
+	lockTime := td.sandbox.CurrentHeight()
+	vote1, vote2 := td.conflictingVotes(valKey, td.sandbox.CurrentHeight()-1)
+	trx := tx.NewSlashTx(lockTime, td.RandAccAddress(), vote1, vote2, "joining committee")
+
+	err := exe1.Execute(trx, td.sandbox)
+	assert.Equal(t, errors.Code(err), errors.ErrInvalidHeight)
+	assert.NoError(t, exe2.Execute(trx, td.sandbox))
+}
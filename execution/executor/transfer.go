@@ -34,7 +34,7 @@ func (e *TransferExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 		}
 	}
 
-	if senderAcc.Balance() < pld.Amount+trx.Fee() {
+	if sb.SpendableBalance(senderAcc) < pld.Amount+trx.Fee() {
 		return ErrInsufficientFunds
 	}
 
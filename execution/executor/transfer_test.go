@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/amount"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/util/errors"
@@ -116,3 +117,35 @@ func TestTransferToSelf(t *testing.T) {
 
 	assert.Equal(t, td.sandbox.Account(senderAddr).Balance(), senderBalance-fee) // Fee should be deducted
 }
+
+func TestTransferLockedBalance(t *testing.T) {
+	td := setup(t)
+	exe := NewTransferExecutor(true)
+
+	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
+	senderBalance := senderAcc.Balance()
+	curHeight := td.sandbox.CurrentHeight()
+	senderAcc.AddVesting(account.NewVesting(curHeight+100, curHeight+200, senderBalance/2))
+	td.sandbox.UpdateAccount(senderAddr, senderAcc)
+
+	receiverAddr := td.RandAccAddress()
+	spendable := senderBalance - senderBalance/2
+
+	t.Run("Should fail, spending locked coins", func(t *testing.T) {
+		trx := tx.NewTransferTx(curHeight, senderAddr,
+			receiverAddr, spendable+1, 0, "locked coins")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx := tx.NewTransferTx(curHeight, senderAddr,
+			receiverAddr, spendable, 0, "unlocked coins")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+	})
+
+	td.checkTotalCoin(t, 0)
+}
@@ -0,0 +1,48 @@
+package executor
+
+import (
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+type VestingTransferExecutor struct {
+	strict bool
+}
+
+func NewVestingTransferExecutor(strict bool) *VestingTransferExecutor {
+	return &VestingTransferExecutor{strict: strict}
+}
+
+func (e *VestingTransferExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.VestingTransferPayload)
+
+	senderAcc := sb.Account(pld.From)
+	if senderAcc == nil {
+		return errors.Errorf(errors.ErrInvalidAddress,
+			"unable to retrieve sender account")
+	}
+	receiverAcc := sb.Account(pld.To)
+	if receiverAcc == nil {
+		receiverAcc = sb.MakeNewAccount(pld.To)
+	}
+
+	if sb.SpendableBalance(senderAcc) < pld.Amount+trx.Fee() {
+		return ErrInsufficientFunds
+	}
+
+	senderAcc.SubtractFromBalance(pld.Amount + trx.Fee())
+	receiverAcc.AddToBalance(pld.Amount)
+	// The vesting schedules of an account are independent, and their locked amounts are summed.
+	// Therefore, no one can prevent an account from receiving a schedule by sending another one,
+	// since each schedule only locks the amount that it transfers.
+	receiverAcc.RemoveFinishedVestings(sb.CurrentHeight())
+	receiverAcc.AddVesting(account.NewVesting(pld.StartHeight, pld.EndHeight, pld.Amount))
+
+	sb.UpdateAccount(pld.From, senderAcc)
+	sb.UpdateAccount(pld.To, receiverAcc)
+
+	return nil
+}
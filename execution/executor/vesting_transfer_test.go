@@ -0,0 +1,123 @@
+package executor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteVestingTransferTx(t *testing.T) {
+	td := setup(t)
+	exe := NewVestingTransferExecutor(true)
+
+	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
+	senderBalance := senderAcc.Balance()
+	receiverAddr := td.RandAccAddress()
+	amt, fee := td.randomAmountAndFee(0, senderBalance/2)
+	lockTime := td.sandbox.CurrentHeight()
+	startHeight := lockTime + 100
+	endHeight := lockTime + 200
+
+	t.Run("Should fail, Sender has no account", func(t *testing.T) {
+		trx := tx.NewVestingTransferTx(lockTime, td.RandAccAddress(),
+			receiverAddr, amt, startHeight, endHeight, fee, "non-existing account")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidAddress)
+	})
+
+	t.Run("Should fail, insufficient balance", func(t *testing.T) {
+		trx := tx.NewVestingTransferTx(lockTime, senderAddr,
+			receiverAddr, senderBalance+1, startHeight, endHeight, 0, "insufficient balance")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx := tx.NewVestingTransferTx(lockTime, senderAddr,
+			receiverAddr, amt, startHeight, endHeight, fee, "ok")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+	})
+
+	receiverAcc := td.sandbox.Account(receiverAddr)
+	assert.Equal(t, senderBalance-(amt+fee), td.sandbox.Account(senderAddr).Balance())
+	assert.Equal(t, amt, receiverAcc.Balance())
+	assert.Equal(t, []account.Vesting{*account.NewVesting(startHeight, endHeight, amt)}, receiverAcc.Vestings())
+	assert.Zero(t, td.sandbox.SpendableBalance(receiverAcc))
+
+	td.checkTotalCoin(t, fee)
+}
+
+func TestVestingTransferWithActiveVesting(t *testing.T) {
+	td := setup(t)
+	exe := NewVestingTransferExecutor(true)
+
+	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
+	attackerAddr, _ := td.sandbox.TestStore.RandomTestAcc()
+	for attackerAddr == senderAddr {
+		attackerAddr, _ = td.sandbox.TestStore.RandomTestAcc()
+	}
+	receiverAddr := td.RandAccAddress()
+	lockTime := td.sandbox.CurrentHeight()
+
+	// A schedule that never unlocks is sent to the receiver first.
+	dustTrx := tx.NewVestingTransferTx(lockTime, attackerAddr,
+		receiverAddr, 1, math.MaxUint32, math.MaxUint32, 0, "dust vesting")
+	assert.NoError(t, exe.Execute(dustTrx, td.sandbox))
+
+	amt, fee := td.randomAmountAndFee(0, senderAcc.Balance())
+	trx := tx.NewVestingTransferTx(lockTime, senderAddr,
+		receiverAddr, amt, lockTime, lockTime+10, fee, "vesting")
+	assert.NoError(t, exe.Execute(trx, td.sandbox))
+
+	receiverAcc := td.sandbox.Account(receiverAddr)
+	assert.Equal(t, amt+1, receiverAcc.Balance())
+	assert.Equal(t, []account.Vesting{
+		*account.NewVesting(math.MaxUint32, math.MaxUint32, 1),
+		*account.NewVesting(lockTime, lockTime+10, amt),
+	}, receiverAcc.Vestings())
+
+	// Only the dust amount remains locked after the schedule of the sender is finished.
+	assert.Equal(t, amt, receiverAcc.SpendableBalance(lockTime+10))
+
+	td.checkTotalCoin(t, fee)
+}
+
+func TestVestingTransferAfterVested(t *testing.T) {
+	td := setup(t)
+	exe := NewVestingTransferExecutor(true)
+
+	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
+	receiverAddr, receiverAcc := td.sandbox.TestStore.RandomTestAcc()
+	for receiverAddr == senderAddr {
+		receiverAddr, receiverAcc = td.sandbox.TestStore.RandomTestAcc()
+	}
+	receiverBalance := receiverAcc.Balance()
+	lockTime := td.sandbox.CurrentHeight()
+
+	// The previous schedule is fully vested at the current height.
+	receiverAcc.AddVesting(account.NewVesting(1, lockTime, receiverBalance))
+	td.sandbox.UpdateAccount(receiverAddr, receiverAcc)
+
+	amt, fee := td.randomAmountAndFee(0, senderAcc.Balance())
+	trx := tx.NewVestingTransferTx(lockTime, senderAddr,
+		receiverAddr, amt, lockTime, lockTime+10, fee, "new schedule")
+
+	err := exe.Execute(trx, td.sandbox)
+	assert.NoError(t, err)
+
+	receiverAcc = td.sandbox.Account(receiverAddr)
+	assert.Equal(t, receiverBalance+amt, receiverAcc.Balance())
+	assert.Equal(t, receiverBalance, td.sandbox.SpendableBalance(receiverAcc))
+	// The finished schedule is removed.
+	assert.Equal(t, []account.Vesting{*account.NewVesting(lockTime, lockTime+10, amt)}, receiverAcc.Vestings())
+
+	td.checkTotalCoin(t, fee)
+}
@@ -2,8 +2,10 @@ package executor
 
 import (
 	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/amount"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util/errors"
 )
 
@@ -24,17 +26,18 @@ func (e *WithdrawExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 			"unable to retrieve validator account")
 	}
 
+	// The stake isn't checked against the vesting schedules. The stake can only be bonded
+	// from the spendable balance of an account, so the locked coins can't be withdrawn
+	// through a validator, and the withdrawn amount is spendable by the receiver.
 	if val.Stake() < pld.Amount+trx.Fee() {
 		return ErrInsufficientFunds
 	}
-	if val.UnbondingHeight() == 0 {
-		return errors.Errorf(errors.ErrInvalidHeight,
-			"need to unbond first")
+	withdrawable, err := withdrawableStake(val, sb)
+	if err != nil {
+		return err
 	}
-	if sb.CurrentHeight() < val.UnbondingHeight()+sb.Params().UnbondInterval {
-		return errors.Errorf(errors.ErrInvalidHeight,
-			"hasn't passed unbonding period, expected: %v, got: %v",
-			val.UnbondingHeight()+sb.Params().UnbondInterval, sb.CurrentHeight())
+	if withdrawable < pld.Amount+trx.Fee() {
+		return ErrInsufficientFunds
 	}
 
 	acc := sb.Account(pld.To)
@@ -50,3 +53,32 @@ func (e *WithdrawExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 
 	return nil
 }
+
+// withdrawableStake returns the part of the validator's stake that has passed the unbonding interval.
+// Once the whole stake is unbonded and matured, it can be withdrawn entirely.
+// Otherwise, only the matured partial unbondings can be withdrawn.
+func withdrawableStake(val *validator.Validator, sb sandbox.Sandbox) (amount.Amount, error) {
+	interval := sb.Params().UnbondInterval
+	if val.UnbondingHeight() == 0 && len(val.UnbondingEntries()) == 0 {
+		return 0, errors.Errorf(errors.ErrInvalidHeight,
+			"need to unbond first")
+	}
+	if val.UnbondingHeight() > 0 && sb.CurrentHeight() >= val.UnbondingHeight()+interval {
+		return val.Stake(), nil
+	}
+
+	matured := val.MaturedUnbondingAmount(sb.CurrentHeight(), interval)
+	if matured == 0 {
+		// The partial unbondings are always older than the full unbonding.
+		expected := val.UnbondingHeight() + interval
+		if entries := val.UnbondingEntries(); len(entries) > 0 {
+			expected = entries[0].Height + interval
+		}
+
+		return 0, errors.Errorf(errors.ErrInvalidHeight,
+			"hasn't passed unbonding period, expected: %v, got: %v",
+			expected, sb.CurrentHeight())
+	}
+
+	return matured, nil
+}
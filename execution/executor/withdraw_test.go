@@ -3,6 +3,7 @@ package executor
 import (
 	"testing"
 
+	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/util/errors"
 	"github.com/stretchr/testify/assert"
@@ -86,3 +87,94 @@ func TestExecuteWithdrawTx(t *testing.T) {
 
 	td.checkTotalCoin(t, fee)
 }
+
+func TestWithdrawPartialUnbonding(t *testing.T) {
+	td := setup(t)
+	exe := NewWithdrawExecutor(true)
+
+	addr := td.RandAccAddress()
+	pub, _ := td.RandBLSKeyPair()
+	val := td.sandbox.MakeNewValidator(pub)
+	accAddr, acc := td.sandbox.TestStore.RandomTestAcc()
+	stake := acc.Balance()
+	val.AddToStake(stake)
+	acc.SubtractFromBalance(stake)
+	td.sandbox.UpdateAccount(accAddr, acc)
+
+	interval := td.sandbox.Params().UnbondInterval
+	td.sandbox.TestStore.AddTestBlock(interval + td.RandHeight())
+	curHeight := td.sandbox.CurrentHeight()
+	val.AddUnbondingEntry(stake/4, curHeight-interval)
+	val.AddUnbondingEntry(stake/4, curHeight-interval+1)
+	td.sandbox.UpdateValidator(val)
+
+	matured := stake / 4
+	fee := td.sandbox.Params().MinimumFee
+	lockTime := td.sandbox.CurrentHeight()
+
+	t.Run("Should fail, withdrawing more than matured unbondings", func(t *testing.T) {
+		trx := tx.NewWithdrawTx(lockTime, val.Address(), addr,
+			matured-fee+1, fee, "more than matured")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+	})
+
+	t.Run("Should pass, withdrawing matured unbondings", func(t *testing.T) {
+		trx := tx.NewWithdrawTx(lockTime, val.Address(), addr,
+			matured-fee, fee, "matured unbondings")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should fail, the next unbonding hasn't matured", func(t *testing.T) {
+		trx := tx.NewWithdrawTx(lockTime, val.Address(), addr,
+			1, fee, "not matured")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidHeight)
+	})
+
+	updatedVal := td.sandbox.Validator(val.Address())
+	assert.Equal(t, stake-matured, updatedVal.Stake())
+	assert.Equal(t, stake/4, updatedVal.UnbondingAmount())
+	assert.Equal(t, int64(stake-matured-stake/4), updatedVal.Power())
+	assert.Zero(t, updatedVal.UnbondingHeight())
+	assert.Equal(t, matured-fee, td.sandbox.Account(addr).Balance())
+
+	td.checkTotalCoin(t, fee)
+}
+
+func TestWithdrawToVestingAccount(t *testing.T) {
+	td := setup(t)
+	exe := NewWithdrawExecutor(true)
+
+	pub, _ := td.RandBLSKeyPair()
+	val := td.sandbox.MakeNewValidator(pub)
+	accAddr, acc := td.sandbox.TestStore.RandomTestAcc()
+	amt, fee := td.randomAmountAndFee(0, acc.Balance())
+	val.AddToStake(amt + fee)
+	val.UpdateUnbondingHeight(1)
+	// Passing the unbonding interval of the validator.
+	td.sandbox.TestStore.LastHeight += td.sandbox.TestParams.UnbondInterval
+	acc.SubtractFromBalance(amt + fee)
+	// The remaining balance of the receiver is locked by a vesting schedule.
+	curHeight := td.sandbox.CurrentHeight()
+	acc.AddVesting(account.NewVesting(curHeight+100, curHeight+200, acc.Balance()))
+	td.sandbox.UpdateAccount(accAddr, acc)
+	td.sandbox.UpdateValidator(val)
+	assert.Zero(t, td.sandbox.SpendableBalance(acc))
+
+	trx := tx.NewWithdrawTx(curHeight, val.Address(), accAddr,
+		amt, fee, "withdraw to vesting account")
+	err := exe.Execute(trx, td.sandbox)
+	assert.NoError(t, err)
+
+	// The withdrawn amount is not locked by the vesting schedule of the receiver.
+	receiverAcc := td.sandbox.Account(accAddr)
+	assert.Equal(t, amt, td.sandbox.SpendableBalance(receiverAcc))
+	assert.Equal(t, acc.Balance()+amt, receiverAcc.Balance())
+
+	td.checkTotalCoin(t, fee)
+}
@@ -0,0 +1,60 @@
+package execution
+
+import (
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/receipt"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+)
+
+// ExecuteWithReceipt executes the transaction and returns its receipt.
+// The receipt contains the changes made to the signer and the receivers of the transaction.
+func (exe *Execution) ExecuteWithReceipt(trx *tx.Tx, sb sandbox.Sandbox) (*receipt.Receipt, error) {
+	addrs := []crypto.Address{trx.Payload().Signer()}
+	for _, receiver := range payload.Receivers(trx.Payload()) {
+		if receiver != addrs[0] {
+			addrs = append(addrs, receiver)
+		}
+	}
+
+	before := make([]receipt.Change, 0, len(addrs))
+	for _, addr := range addrs {
+		before = append(before, snapshot(addr, sb))
+	}
+
+	if err := exe.Execute(trx, sb); err != nil {
+		return nil, err
+	}
+
+	rcpt := &receipt.Receipt{
+		Fee:     trx.Fee(),
+		Changes: make([]receipt.Change, 0, len(addrs)),
+	}
+	for i, addr := range addrs {
+		after := snapshot(addr, sb)
+		rcpt.Changes = append(rcpt.Changes, receipt.Change{
+			Address:      addr,
+			BalanceDelta: after.BalanceDelta - before[i].BalanceDelta,
+			StakeDelta:   after.StakeDelta - before[i].StakeDelta,
+			PowerDelta:   after.PowerDelta - before[i].PowerDelta,
+		})
+	}
+
+	return rcpt, nil
+}
+
+// snapshot returns the current balance of an account, or the current stake and power of a validator.
+func snapshot(addr crypto.Address, sb sandbox.Sandbox) receipt.Change {
+	change := receipt.Change{Address: addr}
+	if addr.IsValidatorAddress() {
+		if val := sb.Validator(addr); val != nil {
+			change.StakeDelta = val.Stake()
+			change.PowerDelta = val.Power()
+		}
+	} else if acc := sb.Account(addr); acc != nil {
+		change.BalanceDelta = acc.Balance()
+	}
+
+	return change
+}
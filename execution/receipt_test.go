@@ -0,0 +1,137 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/receipt"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteWithReceipt(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	sb := sandbox.MockingSandbox(ts)
+	exe := NewExecutor()
+
+	rndPubKey, rndPrvKey := ts.RandBLSKeyPair()
+	rndAccAddr := rndPubKey.AccountAddress()
+	rndValAddr := rndPubKey.ValidatorAddress()
+	rndAcc := sb.MakeNewAccount(rndAccAddr)
+	rndAcc.AddToBalance(100 * 1e9)
+	sb.UpdateAccount(rndAccAddr, rndAcc)
+	_ = sb.TestStore.AddTestBlock(8642)
+
+	t.Run("Transfer", func(t *testing.T) {
+		receiver := ts.RandAccAddress()
+		amt := amount.Amount(1e9)
+		fee := CalculateFee(amt, payload.TypeTransfer, sb.Params())
+		trx := tx.NewTransferTx(sb.CurrentHeight(), rndAccAddr, receiver, amt, fee, "transfer")
+		ts.HelperSignTransaction(rndPrvKey, trx)
+
+		rcpt, err := exe.ExecuteWithReceipt(trx, sb)
+		require.NoError(t, err)
+		assert.Equal(t, fee, rcpt.Fee)
+		assert.Equal(t, []receipt.Change{
+			{Address: rndAccAddr, BalanceDelta: -(amt + fee)},
+			{Address: receiver, BalanceDelta: amt},
+		}, rcpt.Changes)
+	})
+
+	t.Run("Batch transfer", func(t *testing.T) {
+		sb.TestParams.BlockVersion = param.BlockVersionV2
+
+		receiver1 := ts.RandAccAddress()
+		receiver2 := ts.RandAccAddress()
+		recipients := []payload.BatchTransferRecipient{
+			{To: receiver1, Amount: 1e9},
+			{To: receiver2, Amount: 2e9},
+		}
+		fee := CalculateFee(3e9, payload.TypeBatchTransfer, sb.Params())
+		trx := tx.NewBatchTransferTx(sb.CurrentHeight(), rndAccAddr, recipients, fee, "batch transfer")
+		ts.HelperSignTransaction(rndPrvKey, trx)
+
+		rcpt, err := exe.ExecuteWithReceipt(trx, sb)
+		require.NoError(t, err)
+		assert.Equal(t, fee, rcpt.Fee)
+		assert.Equal(t, []receipt.Change{
+			{Address: rndAccAddr, BalanceDelta: -(3e9 + fee)},
+			{Address: receiver1, BalanceDelta: 1e9},
+			{Address: receiver2, BalanceDelta: 2e9},
+		}, rcpt.Changes)
+	})
+
+	stake := amount.Amount(10 * 1e9)
+	t.Run("Bond", func(t *testing.T) {
+		fee := CalculateFee(stake, payload.TypeBond, sb.Params())
+		trx := tx.NewBondTx(sb.CurrentHeight(), rndAccAddr, rndValAddr, rndPubKey, stake, fee, "bond")
+		ts.HelperSignTransaction(rndPrvKey, trx)
+
+		rcpt, err := exe.ExecuteWithReceipt(trx, sb)
+		require.NoError(t, err)
+		assert.Equal(t, fee, rcpt.Fee)
+		assert.Equal(t, []receipt.Change{
+			{Address: rndAccAddr, BalanceDelta: -(stake + fee)},
+			{Address: rndValAddr, StakeDelta: stake, PowerDelta: int64(stake)},
+		}, rcpt.Changes)
+	})
+
+	t.Run("Unbond", func(t *testing.T) {
+		trx := tx.NewUnbondTx(sb.CurrentHeight(), rndValAddr, "unbond")
+		ts.HelperSignTransaction(rndPrvKey, trx)
+
+		rcpt, err := exe.ExecuteWithReceipt(trx, sb)
+		require.NoError(t, err)
+		assert.Zero(t, rcpt.Fee)
+		assert.Equal(t, []receipt.Change{
+			{Address: rndValAddr, PowerDelta: -int64(stake)},
+		}, rcpt.Changes)
+	})
+
+	t.Run("Withdraw", func(t *testing.T) {
+		_ = sb.TestStore.AddTestBlock(sb.CurrentHeight() + sb.Params().UnbondInterval)
+
+		amt := stake / 2
+		fee := CalculateFee(amt, payload.TypeWithdraw, sb.Params())
+		trx := tx.NewWithdrawTx(sb.CurrentHeight(), rndValAddr, rndAccAddr, amt, fee, "withdraw")
+		ts.HelperSignTransaction(rndPrvKey, trx)
+
+		rcpt, err := exe.ExecuteWithReceipt(trx, sb)
+		require.NoError(t, err)
+		assert.Equal(t, fee, rcpt.Fee)
+		assert.Equal(t, []receipt.Change{
+			{Address: rndValAddr, StakeDelta: -(amt + fee)},
+			{Address: rndAccAddr, BalanceDelta: amt},
+		}, rcpt.Changes)
+	})
+
+	t.Run("Subsidy", func(t *testing.T) {
+		receiver := ts.RandAccAddress()
+		amt := amount.Amount(1e9)
+		trx := tx.NewSubsidyTx(sb.CurrentHeight(), receiver, amt, "subsidy")
+
+		rcpt, err := exe.ExecuteWithReceipt(trx, sb)
+		require.NoError(t, err)
+		assert.Zero(t, rcpt.Fee)
+		assert.Equal(t, []receipt.Change{
+			{Address: crypto.TreasuryAddress, BalanceDelta: -amt},
+			{Address: receiver, BalanceDelta: amt},
+		}, rcpt.Changes)
+	})
+
+	t.Run("Failed execution has no receipt", func(t *testing.T) {
+		trx := tx.NewUnbondTx(sb.CurrentHeight(), rndValAddr, "unbond again")
+		ts.HelperSignTransaction(rndPrvKey, trx)
+
+		rcpt, err := exe.ExecuteWithReceipt(trx, sb)
+		assert.Error(t, err)
+		assert.Nil(t, rcpt)
+	})
+}
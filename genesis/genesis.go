@@ -43,8 +43,16 @@ func (n ChainType) String() string {
 }
 
 type genAccount struct {
-	Address string        `cbor:"1,keyasint" json:"address"`
-	Balance amount.Amount `cbor:"2,keyasint" json:"balance"`
+	Address  string        `cbor:"1,keyasint" json:"address"`
+	Balance  amount.Amount `cbor:"2,keyasint" json:"balance"`
+	Vestings []genVesting  `cbor:"3,keyasint,omitempty" json:"vestings,omitempty"`
+}
+
+// genVesting defines a vesting schedule that locks part of the genesis account balance.
+type genVesting struct {
+	StartHeight uint32        `cbor:"1,keyasint" json:"start_height"`
+	EndHeight   uint32        `cbor:"2,keyasint" json:"end_height"`
+	Amount      amount.Amount `cbor:"3,keyasint" json:"amount"`
 }
 
 type genValidator struct {
@@ -57,10 +65,11 @@ type Genesis struct {
 }
 
 type genesisData struct {
-	GenesisTime time.Time      `cbor:"1,keyasint" json:"genesis_time"`
-	Params      *param.Params  `cbor:"2,keyasint" json:"params"`
-	Accounts    []genAccount   `cbor:"3,keyasint" json:"accounts"`
-	Validators  []genValidator `cbor:"4,keyasint" json:"validators"`
+	GenesisTime time.Time       `cbor:"1,keyasint" json:"genesis_time"`
+	Params      *param.Params   `cbor:"2,keyasint" json:"params"`
+	Accounts    []genAccount    `cbor:"3,keyasint" json:"accounts"`
+	Validators  []genValidator  `cbor:"4,keyasint" json:"validators"`
+	Upgrades    []param.Upgrade `cbor:"5,keyasint,omitempty" json:"upgrades,omitempty"`
 }
 
 func (gen *Genesis) Hash() hash.Hash {
@@ -80,6 +89,36 @@ func (gen *Genesis) Params() *param.Params {
 	return gen.data.Params
 }
 
+// Upgrades returns the scheduled parameter upgrades, sorted by height.
+func (gen *Genesis) Upgrades() []param.Upgrade {
+	return gen.data.Upgrades
+}
+
+// ParamsAt returns the parameters in effect for the block at the given height,
+// after applying all the upgrades scheduled up to that height.
+func (gen *Genesis) ParamsAt(height uint32) *param.Params {
+	params := gen.data.Params
+	for i := range gen.data.Upgrades {
+		upgrade := &gen.data.Upgrades[i]
+		if upgrade.Height > height {
+			break
+		}
+		params = upgrade.Apply(params)
+	}
+
+	return params
+}
+
+// ParamsHistory returns the genesis parameters, followed by the parameters after each upgrade.
+func (gen *Genesis) ParamsHistory() []*param.Params {
+	history := []*param.Params{gen.data.Params}
+	for i := range gen.data.Upgrades {
+		history = append(history, gen.data.Upgrades[i].Apply(history[i]))
+	}
+
+	return history
+}
+
 func (gen *Genesis) Accounts() map[crypto.Address]*account.Account {
 	accs := make(map[crypto.Address]*account.Account)
 	for i, genAcc := range gen.data.Accounts {
@@ -89,6 +128,10 @@ func (gen *Genesis) Accounts() map[crypto.Address]*account.Account {
 		}
 		acc := account.NewAccount(int32(i))
 		acc.AddToBalance(genAcc.Balance)
+		for _, vesting := range genAcc.Vestings {
+			acc.AddVesting(account.NewVesting(
+				vesting.StartHeight, vesting.EndHeight, vesting.Amount))
+		}
 		accs[addr] = acc
 	}
 
@@ -115,10 +158,19 @@ func (gen *Genesis) UnmarshalJSON(bs []byte) error {
 }
 
 func makeGenesisAccount(addr crypto.Address, acc *account.Account) genAccount {
-	return genAccount{
+	genAcc := genAccount{
 		Address: addr.String(),
 		Balance: acc.Balance(),
 	}
+	for _, vesting := range acc.Vestings() {
+		genAcc.Vestings = append(genAcc.Vestings, genVesting{
+			StartHeight: vesting.StartHeight,
+			EndHeight:   vesting.EndHeight,
+			Amount:      vesting.Amount,
+		})
+	}
+
+	return genAcc
 }
 
 func makeGenesisValidator(val *validator.Validator) genValidator {
@@ -128,7 +180,7 @@ func makeGenesisValidator(val *validator.Validator) genValidator {
 }
 
 func MakeGenesis(genesisTime time.Time, accounts map[crypto.Address]*account.Account,
-	validators []*validator.Validator, params *param.Params,
+	validators []*validator.Validator, params *param.Params, upgrades ...param.Upgrade,
 ) *Genesis {
 	genAccs := make([]genAccount, len(accounts))
 	for addr, acc := range accounts {
@@ -148,6 +200,7 @@ func MakeGenesis(genesisTime time.Time, accounts map[crypto.Address]*account.Acc
 			Accounts:    genAccs,
 			Validators:  genVals,
 			Params:      params,
+			Upgrades:    upgrades,
 		},
 	}
 }
@@ -162,10 +215,51 @@ func LoadFromFile(file string) (*Genesis, error) {
 	if err := json.Unmarshal(dat, &gen); err != nil {
 		return nil, err
 	}
+	if err := gen.checkUpgrades(); err != nil {
+		return nil, err
+	}
+	if err := gen.checkVestings(); err != nil {
+		return nil, err
+	}
 
 	return &gen, nil
 }
 
+// checkUpgrades ensures that the upgrades are sorted by height,
+// and no upgrade is scheduled for the genesis state at height zero.
+func (gen *Genesis) checkUpgrades() error {
+	lastHeight := uint32(0)
+	for _, upgrade := range gen.data.Upgrades {
+		if upgrade.Height <= lastHeight {
+			return fmt.Errorf("invalid upgrade height: %v", upgrade.Height)
+		}
+		lastHeight = upgrade.Height
+	}
+
+	return nil
+}
+
+// checkVestings ensures that the vesting schedules of the genesis accounts are valid,
+// and the total vesting amount doesn't exceed the account balance.
+func (gen *Genesis) checkVestings() error {
+	for _, genAcc := range gen.data.Accounts {
+		total := amount.Amount(0)
+		for _, genVesting := range genAcc.Vestings {
+			vesting := account.NewVesting(
+				genVesting.StartHeight, genVesting.EndHeight, genVesting.Amount)
+			if err := vesting.BasicCheck(); err != nil {
+				return fmt.Errorf("invalid vesting for %s: %w", genAcc.Address, err)
+			}
+			total += vesting.Amount
+		}
+		if total > genAcc.Balance {
+			return fmt.Errorf("vesting amount exceeds the balance of %s", genAcc.Address)
+		}
+	}
+
+	return nil
+}
+
 // SaveToFile saves the genesis into a JSON file.
 func (gen *Genesis) SaveToFile(file string) error {
 	j, err := gen.MarshalJSON()
@@ -104,3 +104,138 @@ func TestCheckGenesisAccountAndValidator(t *testing.T) {
 		assert.Equal(t, val.Hash(), vals[i].Hash())
 	}
 }
+
+func TestUpgrades(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	acc, addr := ts.GenerateTestAccount(0)
+	val, _ := ts.GenerateTestValidator(0)
+	accs := map[crypto.Address]*account.Account{addr: acc}
+	vals := []*validator.Validator{val}
+	params := param.DefaultParams()
+
+	committeeSize := 21
+	unbondInterval := uint32(8640)
+	upgrade1 := param.Upgrade{
+		Height:    100,
+		Overrides: param.Overrides{CommitteeSize: &committeeSize},
+	}
+	upgrade2 := param.Upgrade{
+		Height:       200,
+		BlockVersion: 2,
+		Overrides:    param.Overrides{UnbondInterval: &unbondInterval},
+	}
+	gen := genesis.MakeGenesis(util.Now(), accs, vals, params, upgrade1, upgrade2)
+
+	t.Run("Upgrades change the hash", func(t *testing.T) {
+		gen0 := genesis.MakeGenesis(gen.GenesisTime(), accs, vals, params)
+		assert.NotEqual(t, gen0.Hash(), gen.Hash())
+		assert.Empty(t, gen0.Upgrades())
+	})
+
+	t.Run("Parameters at height", func(t *testing.T) {
+		assert.Equal(t, params, gen.ParamsAt(0))
+		assert.Equal(t, params, gen.ParamsAt(99))
+
+		params100 := gen.ParamsAt(100)
+		assert.Equal(t, committeeSize, params100.CommitteeSize)
+		assert.Equal(t, params.UnbondInterval, params100.UnbondInterval)
+		assert.Equal(t, params.BlockVersion, params100.BlockVersion)
+		assert.Equal(t, params100, gen.ParamsAt(199))
+
+		params200 := gen.ParamsAt(200)
+		assert.Equal(t, committeeSize, params200.CommitteeSize)
+		assert.Equal(t, unbondInterval, params200.UnbondInterval)
+		assert.Equal(t, uint8(2), params200.BlockVersion)
+
+		assert.Equal(t, []*param.Params{params, params100, params200}, gen.ParamsHistory())
+
+		// The genesis parameters should not be changed.
+		assert.Equal(t, param.DefaultParams(), gen.Params())
+	})
+
+	t.Run("Saving and loading", func(t *testing.T) {
+		f := util.TempFilePath()
+		assert.NoError(t, gen.SaveToFile(f))
+		loaded, err := genesis.LoadFromFile(f)
+		require.NoError(t, err)
+		assert.Equal(t, gen.Hash(), loaded.Hash())
+		assert.Equal(t, gen.Upgrades(), loaded.Upgrades())
+	})
+
+	t.Run("Unsorted upgrades", func(t *testing.T) {
+		unsorted := genesis.MakeGenesis(util.Now(), accs, vals, params, upgrade2, upgrade1)
+		f := util.TempFilePath()
+		assert.NoError(t, unsorted.SaveToFile(f))
+		_, err := genesis.LoadFromFile(f)
+		assert.Error(t, err)
+	})
+}
+
+func TestVesting(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	acc, addr := ts.GenerateTestAccount(0)
+	acc.AddToBalance(2000)
+	val, _ := ts.GenerateTestValidator(0)
+	vals := []*validator.Validator{val}
+	params := param.DefaultParams()
+
+	gen0 := genesis.MakeGenesis(util.Now(), map[crypto.Address]*account.Account{addr: acc}, vals, params)
+
+	vestingAcc := acc.Clone()
+	vestingAcc.AddVesting(account.NewVesting(100, 200, 1000))
+	vestingAcc.AddVesting(account.NewVesting(300, 300, 1000))
+	gen := genesis.MakeGenesis(gen0.GenesisTime(), map[crypto.Address]*account.Account{addr: vestingAcc}, vals, params)
+
+	t.Run("Vesting changes the hash", func(t *testing.T) {
+		assert.NotEqual(t, gen0.Hash(), gen.Hash())
+	})
+
+	t.Run("Genesis accounts have the vesting schedule", func(t *testing.T) {
+		assert.Empty(t, gen0.Accounts()[addr].Vestings())
+		assert.Equal(t, []account.Vesting{
+			*account.NewVesting(100, 200, 1000),
+			*account.NewVesting(300, 300, 1000),
+		}, gen.Accounts()[addr].Vestings())
+	})
+
+	t.Run("Saving and loading", func(t *testing.T) {
+		f := util.TempFilePath()
+		assert.NoError(t, gen.SaveToFile(f))
+		loaded, err := genesis.LoadFromFile(f)
+		require.NoError(t, err)
+		assert.Equal(t, gen.Hash(), loaded.Hash())
+		assert.Equal(t, gen.Accounts(), loaded.Accounts())
+	})
+
+	t.Run("Invalid vesting", func(t *testing.T) {
+		invalids := []*account.Vesting{
+			account.NewVesting(200, 100, 1000),
+			account.NewVesting(100, 200, 0),
+			account.NewVesting(100, 200, acc.Balance()+1),
+		}
+		for _, vesting := range invalids {
+			invalidAcc := acc.Clone()
+			invalidAcc.AddVesting(vesting)
+			invalid := genesis.MakeGenesis(util.Now(),
+				map[crypto.Address]*account.Account{addr: invalidAcc}, vals, params)
+			f := util.TempFilePath()
+			assert.NoError(t, invalid.SaveToFile(f))
+			_, err := genesis.LoadFromFile(f)
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("Total vesting exceeds the balance", func(t *testing.T) {
+		invalidAcc := acc.Clone()
+		invalidAcc.AddVesting(account.NewVesting(100, 200, acc.Balance()))
+		invalidAcc.AddVesting(account.NewVesting(100, 200, 1))
+		invalid := genesis.MakeGenesis(util.Now(),
+			map[crypto.Address]*account.Account{addr: invalidAcc}, vals, params)
+		f := util.TempFilePath()
+		assert.NoError(t, invalid.SaveToFile(f))
+		_, err := genesis.LoadFromFile(f)
+		assert.Error(t, err)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/param"
 	"github.com/pactus-project/pactus/types/validator"
@@ -33,7 +34,7 @@ func TestRunningNode(t *testing.T) {
 	conf := config.DefaultConfigMainnet()
 	conf.GRPC.Enable = false
 	conf.HTTP.Enable = false
-	conf.Store.Path = util.TempDirPath()
+	conf.Store.Engine = store.EngineMemory
 	conf.Network.EnableRelay = false
 	conf.Network.NetworkKey = util.TempFilePath()
 
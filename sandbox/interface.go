@@ -16,13 +16,16 @@ type Sandbox interface {
 	Account(crypto.Address) *account.Account
 	MakeNewAccount(crypto.Address) *account.Account
 	UpdateAccount(crypto.Address, *account.Account)
+	SpendableBalance(*account.Account) amount.Amount
 
 	CommitTransaction(trx *tx.Tx)
 	AnyRecentTransaction(txID tx.ID) bool
 
 	Validator(crypto.Address) *validator.Validator
+	ValidatorByPreviousKey(crypto.Address) *validator.Validator
 	MakeNewValidator(*bls.PublicKey) *validator.Validator
 	UpdateValidator(*validator.Validator)
+	RotateValidatorKey(crypto.Address, *validator.Validator)
 	JoinedToCommittee(crypto.Address)
 	IsJoinedCommittee(crypto.Address) bool
 	UpdatePowerDelta(delta int64)
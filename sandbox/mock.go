@@ -89,6 +89,12 @@ func (m *MockSandbox) Validator(addr crypto.Address) *validator.Validator {
 	return val
 }
 
+func (m *MockSandbox) ValidatorByPreviousKey(addr crypto.Address) *validator.Validator {
+	val, _ := m.TestStore.ValidatorByPreviousKey(addr)
+
+	return val
+}
+
 func (m *MockSandbox) JoinedToCommittee(addr crypto.Address) {
 	m.TestJoinedValidators[addr] = true
 }
@@ -107,10 +113,19 @@ func (m *MockSandbox) UpdateValidator(val *validator.Validator) {
 	m.TestStore.UpdateValidator(val)
 }
 
+func (m *MockSandbox) RotateValidatorKey(prevAddr crypto.Address, val *validator.Validator) {
+	delete(m.TestStore.Validators, prevAddr)
+	m.TestStore.UpdateValidator(val)
+}
+
 func (m *MockSandbox) CurrentHeight() uint32 {
 	return m.TestStore.LastHeight + 1
 }
 
+func (m *MockSandbox) SpendableBalance(acc *account.Account) amount.Amount {
+	return acc.SpendableBalance(m.CurrentHeight())
+}
+
 func (m *MockSandbox) Params() *param.Params {
 	return m.TestParams
 }
@@ -21,10 +21,11 @@ var _ Sandbox = &sandbox{}
 type sandbox struct {
 	lk sync.RWMutex
 
-	store           store.Reader
+	store           store.SandboxReader
 	committee       committee.Reader
 	accounts        map[crypto.Address]*sandboxAccount
 	validators      map[crypto.Address]*sandboxValidator
+	rotatedAddrs    map[crypto.Address]bool
 	committedTrxs   map[tx.ID]*tx.Tx
 	params          *param.Params
 	height          uint32
@@ -46,7 +47,7 @@ type sandboxAccount struct {
 	updated bool
 }
 
-func NewSandbox(height uint32, str store.Reader, params *param.Params,
+func NewSandbox(height uint32, str store.SandboxReader, params *param.Params,
 	cmt committee.Reader, totalPower int64,
 ) Sandbox {
 	sb := &sandbox{
@@ -59,6 +60,7 @@ func NewSandbox(height uint32, str store.Reader, params *param.Params,
 
 	sb.accounts = make(map[crypto.Address]*sandboxAccount)
 	sb.validators = make(map[crypto.Address]*sandboxValidator)
+	sb.rotatedAddrs = make(map[crypto.Address]bool)
 	sb.committedTrxs = make(map[tx.ID]*tx.Tx)
 	sb.totalAccounts = sb.store.TotalAccounts()
 	sb.totalValidators = sb.store.TotalValidators()
@@ -156,6 +158,11 @@ func (sb *sandbox) Validator(addr crypto.Address) *validator.Validator {
 		return s.validator.Clone()
 	}
 
+	// The validator has rotated its key, and it is not known by this address anymore.
+	if sb.rotatedAddrs[addr] {
+		return nil
+	}
+
 	val, err := sb.store.Validator(addr)
 	if err != nil {
 		return nil
@@ -167,6 +174,41 @@ func (sb *sandbox) Validator(addr crypto.Address) *validator.Validator {
 	return val.Clone()
 }
 
+// ValidatorByPreviousKey returns the validator that has rotated out the key with the given address.
+func (sb *sandbox) ValidatorByPreviousKey(addr crypto.Address) *validator.Validator {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	val := sb.validatorByPreviousKey(addr)
+	if val == nil {
+		return nil
+	}
+
+	return val.Clone()
+}
+
+func (sb *sandbox) validatorByPreviousKey(addr crypto.Address) *validator.Validator {
+	for _, s := range sb.validators {
+		if _, ok := s.validator.PreviousKey(addr); ok {
+			return s.validator
+		}
+	}
+
+	val, err := sb.store.ValidatorByPreviousKey(addr)
+	if err != nil {
+		return nil
+	}
+	// The validator is already in the sandbox, but it doesn't have the key anymore.
+	if _, ok := sb.validators[val.Address()]; ok || sb.rotatedAddrs[val.Address()] {
+		return nil
+	}
+	sb.validators[val.Address()] = &sandboxValidator{
+		validator: val,
+	}
+
+	return val
+}
+
 func (sb *sandbox) JoinedToCommittee(addr crypto.Address) {
 	sb.lk.Lock()
 	defer sb.lk.Unlock()
@@ -199,6 +241,10 @@ func (sb *sandbox) MakeNewValidator(pub *bls.PublicKey) *validator.Validator {
 	if sb.store.HasValidator(addr) {
 		sb.shouldPanicForDuplicatedAddress()
 	}
+	// The rotated keys are reserved by their validators.
+	if sb.validatorByPreviousKey(addr) != nil {
+		sb.shouldPanicForDuplicatedAddress()
+	}
 
 	val := validator.NewValidator(pub, sb.totalValidators)
 	sb.validators[addr] = &sandboxValidator{
@@ -227,6 +273,37 @@ func (sb *sandbox) UpdateValidator(val *validator.Validator) {
 	s.updated = true
 }
 
+// RotateValidatorKey moves the validator from its previous address to the address of its new key.
+// This function takes ownership of the validator pointer.
+func (sb *sandbox) RotateValidatorKey(prevAddr crypto.Address, val *validator.Validator) {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	s, ok := sb.validators[prevAddr]
+	if !ok {
+		sb.shouldPanicForUnknownAddress()
+	}
+
+	addr := val.Address()
+	if _, ok := sb.validators[addr]; ok {
+		sb.shouldPanicForDuplicatedAddress()
+	}
+	if sb.store.HasValidator(addr) {
+		sb.shouldPanicForDuplicatedAddress()
+	}
+	if sb.validatorByPreviousKey(addr) != nil {
+		sb.shouldPanicForDuplicatedAddress()
+	}
+
+	delete(sb.validators, prevAddr)
+	sb.rotatedAddrs[prevAddr] = true
+	sb.validators[addr] = &sandboxValidator{
+		validator: val,
+		updated:   true,
+		joined:    s.joined,
+	}
+}
+
 func (sb *sandbox) Params() *param.Params {
 	return sb.params
 }
@@ -238,6 +315,12 @@ func (sb *sandbox) CurrentHeight() uint32 {
 	return sb.height + 1
 }
 
+// SpendableBalance returns the balance of the account that is not locked
+// by its vesting schedules at the current height.
+func (sb *sandbox) SpendableBalance(acc *account.Account) amount.Amount {
+	return acc.SpendableBalance(sb.CurrentHeight())
+}
+
 func (sb *sandbox) IterateAccounts(
 	consumer func(crypto.Address, *account.Account, bool),
 ) {
@@ -13,6 +13,7 @@ import (
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type testData struct {
@@ -221,6 +222,87 @@ func TestValidatorChange(t *testing.T) {
 	})
 }
 
+func TestRotateValidatorKey(t *testing.T) {
+	td := setup(t)
+
+	val, _ := td.GenerateTestValidator(td.RandInt32(10000))
+	prevAddr := val.Address()
+	td.store.UpdateValidator(val)
+
+	total := td.sandbox.totalValidators
+	rotatedVal := td.sandbox.Validator(prevAddr)
+	newPub, _ := td.RandBLSKeyPair()
+	rotatedVal.RotatePublicKey(newPub, td.sandbox.CurrentHeight())
+	td.sandbox.RotateValidatorKey(prevAddr, rotatedVal)
+
+	t.Run("Should move the validator to the new address", func(t *testing.T) {
+		assert.Nil(t, td.sandbox.Validator(prevAddr))
+		assert.Equal(t, rotatedVal.Hash(), td.sandbox.Validator(newPub.ValidatorAddress()).Hash())
+		assert.Equal(t, total, td.sandbox.totalValidators)
+
+		td.sandbox.IterateValidators(func(val *validator.Validator, updated bool, _ bool) {
+			assert.True(t, updated)
+			assert.Equal(t, newPub.ValidatorAddress(), val.Address())
+		})
+	})
+
+	t.Run("Should find the validator by its previous key", func(t *testing.T) {
+		prevVal := td.sandbox.ValidatorByPreviousKey(prevAddr)
+		require.NotNil(t, prevVal)
+		assert.Equal(t, rotatedVal.Hash(), prevVal.Hash())
+		assert.Nil(t, td.sandbox.ValidatorByPreviousKey(newPub.ValidatorAddress()))
+	})
+
+	t.Run("Bond the previous key, Should panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("The code did not panic")
+			}
+		}()
+		td.sandbox.MakeNewValidator(val.PublicKey())
+	})
+
+	t.Run("Rotate to a key of an existing validator, Should panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("The code did not panic")
+			}
+		}()
+		rotatedVal := td.sandbox.Validator(newPub.ValidatorAddress())
+		rotatedVal.RotatePublicKey(td.valKeys[3].PublicKey(), td.sandbox.CurrentHeight())
+		td.sandbox.RotateValidatorKey(newPub.ValidatorAddress(), rotatedVal)
+	})
+
+	t.Run("Rotate back to the previous key, Should panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("The code did not panic")
+			}
+		}()
+		rotatedVal := td.sandbox.Validator(newPub.ValidatorAddress())
+		rotatedVal.RotatePublicKey(val.PublicKey(), td.sandbox.CurrentHeight())
+		td.sandbox.RotateValidatorKey(newPub.ValidatorAddress(), rotatedVal)
+	})
+}
+
+func TestValidatorByPreviousKeyFromStore(t *testing.T) {
+	td := setup(t)
+
+	val, _ := td.GenerateTestValidator(td.RandInt32(10000))
+	prevAddr := val.Address()
+	val.RotatePublicKey(td.RandValKey().PublicKey(), td.RandHeight())
+	td.store.UpdateValidator(val)
+
+	prevVal := td.sandbox.ValidatorByPreviousKey(prevAddr)
+	require.NotNil(t, prevVal)
+	assert.Equal(t, val.Hash(), prevVal.Hash())
+
+	// The validator is cached in the sandbox, so it can be updated.
+	prevVal.AddToStake(1)
+	td.sandbox.UpdateValidator(prevVal)
+	assert.Equal(t, prevVal.Hash(), td.sandbox.ValidatorByPreviousKey(prevAddr).Hash())
+}
+
 func TestTotalAccountCounter(t *testing.T) {
 	td := setup(t)
 
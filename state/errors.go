@@ -1,12 +1,30 @@
 package state
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/vote"
 )
 
+// ErrHalted is returned when the state is halted due to a detected fork.
+var ErrHalted = errors.New("state is halted due to a detected fork")
+
+// ForkDetectedError is returned when a certified block doesn't extend the last block.
+type ForkDetectedError struct {
+	Height         uint32
+	LastBlockHash  hash.Hash
+	RemotePrevHash hash.Hash
+}
+
+func (e ForkDetectedError) Error() string {
+	return fmt.Sprintf("a possible fork is detected at height %d, our hash: %s, their hash: %s",
+		e.Height, e.LastBlockHash, e.RemotePrevHash)
+}
+
 // InvalidVoteForCertificateError is returned when an attempt to update
 // the last certificate with an invalid vote is made.
 type InvalidVoteForCertificateError struct {
@@ -27,3 +45,34 @@ func (e InvalidCertificateError) Error() string {
 	return fmt.Sprintf("invalid certificate for block %d",
 		e.Cert.Height())
 }
+
+// VerifyError is returned when the verification of the stored blockchain fails.
+// It holds the first height that doesn't pass the verification.
+type VerifyError struct {
+	Height uint32
+	Reason string
+}
+
+func (e VerifyError) Error() string {
+	return fmt.Sprintf("verification failed at height %d: %s",
+		e.Height, e.Reason)
+}
+
+// TxDivergence describes a transaction that doesn't have the same outcome when replayed.
+type TxDivergence struct {
+	ID     tx.ID
+	Reason string
+}
+
+// ReplayDivergenceError is returned when the replayed state diverges from the stored blockchain.
+// It holds the first divergent height and the offending transactions, if they are known.
+type ReplayDivergenceError struct {
+	Height       uint32
+	Reason       string
+	Transactions []TxDivergence
+}
+
+func (e ReplayDivergenceError) Error() string {
+	return fmt.Sprintf("replay diverged at height %d: %s",
+		e.Height, e.Reason)
+}
@@ -5,38 +5,44 @@ import (
 	"github.com/pactus-project/pactus/execution"
 	"github.com/pactus-project/pactus/sandbox"
 	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/util/errors"
 )
 
-func (st *state) executeBlock(b *block.Block, sb sandbox.Sandbox) error {
+// executeBlock executes the transactions of the block in the sandbox
+// and returns their receipts, in the same order as the transactions.
+func (st *state) executeBlock(b *block.Block, sb sandbox.Sandbox) ([]*receipt.Receipt, error) {
 	exe := execution.NewExecutor()
 
+	receipts := make([]*receipt.Receipt, 0, len(b.Transactions()))
+
 	var subsidyTrx *tx.Tx
 	for i, trx := range b.Transactions() {
 		// The first transaction should be subsidy transaction
 		isSubsidyTx := (i == 0)
 		if isSubsidyTx {
 			if !trx.IsSubsidyTx() {
-				return errors.Errorf(errors.ErrInvalidTx,
+				return nil, errors.Errorf(errors.ErrInvalidTx,
 					"first transaction should be a subsidy transaction")
 			}
 			subsidyTrx = trx
 		} else if trx.IsSubsidyTx() {
-			return errors.Errorf(errors.ErrInvalidTx,
+			return nil, errors.Errorf(errors.ErrInvalidTx,
 				"duplicated subsidy transaction")
 		}
 
-		err := exe.Execute(trx, sb)
+		rcpt, err := exe.ExecuteWithReceipt(trx, sb)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		receipts = append(receipts, rcpt)
 	}
 
 	accumulatedFee := sb.AccumulatedFee()
 	subsidyAmt := st.params.BlockReward + sb.AccumulatedFee()
 	if subsidyTrx.Payload().Value() != subsidyAmt {
-		return errors.Errorf(errors.ErrInvalidTx,
+		return nil, errors.Errorf(errors.ErrInvalidTx,
 			"invalid subsidy amount, expected %v, got %v", subsidyAmt, subsidyTrx.Payload().Value())
 	}
 
@@ -45,5 +51,5 @@ func (st *state) executeBlock(b *block.Block, sb sandbox.Sandbox) error {
 	acc.AddToBalance(accumulatedFee)
 	sb.UpdateAccount(crypto.TreasuryAddress, acc)
 
-	return nil
+	return receipts, nil
 }
@@ -76,7 +76,8 @@ func TestExecuteBlock(t *testing.T) {
 			td.state.lastInfo.SortitionSeed(), proposerAddr)
 		sb := td.state.concreteSandbox()
 
-		assert.Error(t, td.state.executeBlock(invBlock, sb))
+		_, err := td.state.executeBlock(invBlock, sb)
+		assert.Error(t, err)
 	})
 
 	t.Run("Has invalid tx", func(t *testing.T) {
@@ -88,7 +89,8 @@ func TestExecuteBlock(t *testing.T) {
 			td.state.lastInfo.SortitionSeed(), proposerAddr)
 		sb := td.state.concreteSandbox()
 
-		assert.Error(t, td.state.executeBlock(invBlock, sb))
+		_, err := td.state.executeBlock(invBlock, sb)
+		assert.Error(t, err)
 	})
 
 	t.Run("Subsidy is not first tx", func(t *testing.T) {
@@ -100,7 +102,8 @@ func TestExecuteBlock(t *testing.T) {
 			td.state.lastInfo.SortitionSeed(), proposerAddr)
 		sb := td.state.concreteSandbox()
 
-		assert.Error(t, td.state.executeBlock(invBlock, sb))
+		_, err := td.state.executeBlock(invBlock, sb)
+		assert.Error(t, err)
 	})
 
 	t.Run("Has no subsidy", func(t *testing.T) {
@@ -111,7 +114,8 @@ func TestExecuteBlock(t *testing.T) {
 			td.state.lastInfo.SortitionSeed(), proposerAddr)
 		sb := td.state.concreteSandbox()
 
-		assert.Error(t, td.state.executeBlock(invBlock, sb))
+		_, err := td.state.executeBlock(invBlock, sb)
+		assert.Error(t, err)
 	})
 
 	t.Run("Two subsidy transactions", func(t *testing.T) {
@@ -123,7 +127,8 @@ func TestExecuteBlock(t *testing.T) {
 			td.state.lastInfo.SortitionSeed(), proposerAddr)
 		sb := td.state.concreteSandbox()
 
-		assert.Error(t, td.state.executeBlock(invBlock, sb))
+		_, err := td.state.executeBlock(invBlock, sb)
+		assert.Error(t, err)
 	})
 
 	t.Run("OK", func(t *testing.T) {
@@ -134,7 +139,8 @@ func TestExecuteBlock(t *testing.T) {
 			td.state.stateRoot(), td.state.lastInfo.Certificate(),
 			td.state.lastInfo.SortitionSeed(), proposerAddr)
 		sb := td.state.concreteSandbox()
-		assert.NoError(t, td.state.executeBlock(invBlock, sb))
+		_, err := td.state.executeBlock(invBlock, sb)
+		assert.NoError(t, err)
 
 		// Check if fee is claimed
 		treasury := sb.Account(crypto.TreasuryAddress)
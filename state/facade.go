@@ -13,6 +13,7 @@ import (
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
 	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
@@ -26,6 +27,7 @@ type Facade interface {
 	LastBlockTime() time.Time
 	LastCertificate() *certificate.Certificate
 	UpdateLastCertificate(v *vote.Vote) error
+	IsHalted() bool
 	ProposeBlock(valKey *bls.ValidatorKey, rewardAddr crypto.Address) (*block.Block, error)
 	ValidateBlock(blk *block.Block, round int16) error
 	CommitBlock(blk *block.Block, cert *certificate.Certificate) error
@@ -43,15 +45,32 @@ type Facade interface {
 	AddPendingTxAndBroadcast(trx *tx.Tx) error
 	CommittedBlock(height uint32) *store.CommittedBlock
 	CommittedTx(id tx.ID) *store.CommittedTx
+	TxReceipt(id tx.ID) *receipt.Receipt
+	SimulateTransaction(trx *tx.Tx) (*receipt.Receipt, error)
+	AddressTransactions(addr crypto.Address, offset, limit int) ([]*store.CommittedTx, error)
+	ValidatorRewards(addr crypto.Address, offset, limit int) (store.RewardSummary, []store.ValidatorReward)
+	IsPruned() bool
+	PruningHeight() uint32
 	BlockHash(height uint32) hash.Hash
 	BlockHeight(h hash.Hash) uint32
 	AccountByAddress(addr crypto.Address) *account.Account
 	ValidatorByAddress(addr crypto.Address) *validator.Validator
+	AccountAtHeight(addr crypto.Address, height uint32) (*account.Account, error)
+	AccountProof(addr crypto.Address) (*account.Account, *StateProof, error)
+	ValidatorAtHeight(addr crypto.Address, height uint32) (*validator.Validator, error)
+	ValidatorProof(addr crypto.Address) (*validator.Validator, *StateProof, error)
 	ValidatorByNumber(number int32) *validator.Validator
 	ValidatorAddresses() []crypto.Address
 	Params() *param.Params
 	Close() error
 	CalculateFee(amt amount.Amount, payloadType payload.Type) amount.Amount
+	CalculateBatchTransferFee(amt amount.Amount, recipients int) amount.Amount
 	PublicKey(addr crypto.Address) (crypto.PublicKey, error)
 	AvailabilityScore(valNum int32) float64
+	ValidatorAvailability(valNum int32, from, to uint32) (store.Availability, error)
+	AvailabilityRange() (from, to uint32, ok bool)
+	SupplyInfo() SupplyInfo
+	// ReadSnapshot returns the read snapshot of the last committed block.
+	// The caller should release the snapshot when it is done.
+	ReadSnapshot() ReadSnapshot
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/execution"
 	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/sandbox"
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/txpool"
 	"github.com/pactus-project/pactus/types/account"
@@ -18,11 +19,13 @@ import (
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
 	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
 	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/persistentmerkle"
 	"github.com/pactus-project/pactus/util/testsuite"
 )
 
@@ -39,6 +42,7 @@ type MockState struct {
 	TestCommittee committee.Committee
 	TestValKeys   []*bls.ValidatorKey
 	TestParams    *param.Params
+	TestHalted    bool
 }
 
 func MockingState(ts *testsuite.TestSuite) *MockState {
@@ -132,6 +136,13 @@ func (m *MockState) CommitteeValidators() []*validator.Validator {
 	return m.TestCommittee.Validators()
 }
 
+func (m *MockState) IsHalted() bool {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	return m.TestHalted
+}
+
 func (m *MockState) IsInCommittee(addr crypto.Address) bool {
 	return m.TestCommittee.Contains(addr)
 }
@@ -189,6 +200,54 @@ func (m *MockState) CommittedTx(id tx.ID) *store.CommittedTx {
 	return trx
 }
 
+func (m *MockState) TxReceipt(id tx.ID) *receipt.Receipt {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	rcpt, _ := m.TestStore.Receipt(id)
+
+	return rcpt
+}
+
+func (m *MockState) SimulateTransaction(trx *tx.Tx) (*receipt.Receipt, error) {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	sb := sandbox.NewSandbox(m.TestStore.LastHeight,
+		m.TestStore, m.TestParams, m.TestCommittee, m.TestCommittee.TotalPower())
+
+	return execution.NewChecker().ExecuteWithReceipt(trx, sb)
+}
+
+func (m *MockState) AddressTransactions(addr crypto.Address, offset, limit int) ([]*store.CommittedTx, error) {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	return m.TestStore.AddressTransactions(addr, offset, limit)
+}
+
+func (m *MockState) ValidatorRewards(addr crypto.Address, offset, limit int,
+) (store.RewardSummary, []store.ValidatorReward) {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	return m.TestStore.ValidatorRewards(addr, offset, limit)
+}
+
+func (m *MockState) IsPruned() bool {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	return m.TestStore.IsPruned()
+}
+
+func (m *MockState) PruningHeight() uint32 {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	return m.TestStore.PruningHeight()
+}
+
 func (m *MockState) BlockHash(height uint32) hash.Hash {
 	m.lk.RLock()
 	defer m.lk.RUnlock()
@@ -225,6 +284,46 @@ func (m *MockState) ValidatorByAddress(addr crypto.Address) *validator.Validator
 	return v
 }
 
+func (m *MockState) AccountAtHeight(addr crypto.Address, height uint32) (*account.Account, error) {
+	return m.TestStore.AccountAtHeight(addr, height)
+}
+
+func (m *MockState) ValidatorAtHeight(addr crypto.Address, height uint32) (*validator.Validator, error) {
+	return m.TestStore.ValidatorAtHeight(addr, height)
+}
+
+// AccountProof returns a proof for a state that only has this account,
+// since the mock state doesn't keep the merkle trees.
+func (m *MockState) AccountProof(addr crypto.Address) (*account.Account, *StateProof, error) {
+	acc, err := m.TestStore.Account(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	proof := &persistentmerkle.Proof{Index: 0, Siblings: []hash.Hash{hash.UndefHash}}
+
+	return acc, &StateProof{
+		Proof:     proof,
+		StateRoot: proof.Root(acc.Hash()),
+		Height:    m.TestStore.LastHeight + 1,
+	}, nil
+}
+
+// ValidatorProof returns a proof for a state that only has this validator,
+// since the mock state doesn't keep the merkle trees.
+func (m *MockState) ValidatorProof(addr crypto.Address) (*validator.Validator, *StateProof, error) {
+	val, err := m.TestStore.Validator(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	proof := &persistentmerkle.Proof{Index: 1, Siblings: []hash.Hash{hash.UndefHash}}
+
+	return val, &StateProof{
+		Proof:     proof,
+		StateRoot: proof.Root(val.Hash()),
+		Height:    m.TestStore.LastHeight + 1,
+	}, nil
+}
+
 func (m *MockState) ValidatorByNumber(n int32) *validator.Validator {
 	v, _ := m.TestStore.ValidatorByNumber(n)
 
@@ -259,6 +358,10 @@ func (m *MockState) CalculateFee(amt amount.Amount, payloadType payload.Type) am
 	return execution.CalculateFee(amt, payloadType, m.TestParams)
 }
 
+func (m *MockState) CalculateBatchTransferFee(amt amount.Amount, recipients int) amount.Amount {
+	return execution.CalculateBatchTransferFee(amt, recipients, m.TestParams)
+}
+
 func (m *MockState) PublicKey(addr crypto.Address) (crypto.PublicKey, error) {
 	return m.TestStore.PublicKey(addr)
 }
@@ -266,3 +369,59 @@ func (m *MockState) PublicKey(addr crypto.Address) (crypto.PublicKey, error) {
 func (m *MockState) AvailabilityScore(_ int32) float64 {
 	return 0.987
 }
+
+func (m *MockState) ValidatorAvailability(valNum int32, from, to uint32) (store.Availability, error) {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	return m.TestStore.Availability(valNum, from, to)
+}
+
+func (m *MockState) AvailabilityRange() (uint32, uint32, bool) {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	return m.TestStore.AvailabilityRange()
+}
+
+// SupplyInfo computes the supply from the accounts and validators of the test store.
+func (m *MockState) SupplyInfo() SupplyInfo {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	info := SupplyInfo{
+		Height: m.TestStore.LastHeight,
+	}
+	m.TestStore.IterateAccounts(func(addr crypto.Address, acc *account.Account) bool {
+		info.TotalSupply += acc.Balance()
+		if addr == crypto.TreasuryAddress {
+			info.TreasuryBalance = acc.Balance()
+		}
+
+		return false
+	})
+	m.TestStore.IterateValidators(func(val *validator.Validator) bool {
+		info.TotalSupply += val.Stake()
+		info.TotalStaked += bondedStake(val)
+		info.UnbondingAmount += unbondingStake(val)
+
+		return false
+	})
+	info.CirculatingSupply = info.TotalSupply - info.TreasuryBalance
+
+	counters := m.TestStore.SupplyCounters()
+	info.setCounters(counters.Fees, counters.Rewards, counters.FromHeight)
+
+	return info
+}
+
+// ReadSnapshot returns the mock state itself, since the tests don't commit blocks concurrently.
+func (m *MockState) ReadSnapshot() ReadSnapshot {
+	return mockReadSnapshot{m}
+}
+
+type mockReadSnapshot struct {
+	*MockState
+}
+
+func (mockReadSnapshot) Release() {}
@@ -0,0 +1,245 @@
+package state
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/pactus-project/pactus/committee"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/execution"
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/receipt"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// ReadSnapshot is a consistent, read-only view of the state at the last committed block.
+// Reading from it doesn't take the state lock, so it doesn't contend with committing new blocks.
+// Each snapshot that is returned by the state should be released exactly once.
+type ReadSnapshot interface {
+	LastBlockHeight() uint32
+	LastBlockHash() hash.Hash
+	LastBlockTime() time.Time
+	LastCertificate() *certificate.Certificate
+	CommitteeValidators() []*validator.Validator
+	CommitteePower() int64
+	TotalPower() int64
+	TotalAccounts() int32
+	TotalValidators() int32
+	CommittedBlock(height uint32) *store.CommittedBlock
+	CommittedTx(id tx.ID) *store.CommittedTx
+	TxReceipt(id tx.ID) *receipt.Receipt
+	BlockHash(height uint32) hash.Hash
+	BlockHeight(h hash.Hash) uint32
+	AccountByAddress(addr crypto.Address) *account.Account
+	ValidatorByAddress(addr crypto.Address) *validator.Validator
+	ValidatorByNumber(number int32) *validator.Validator
+	PublicKey(addr crypto.Address) (crypto.PublicKey, error)
+	AvailabilityScore(valNum int32) float64
+	SimulateTransaction(trx *tx.Tx) (*receipt.Receipt, error)
+	Release()
+}
+
+// readSnapshot holds an immutable copy of the last info alongside a snapshot of the store.
+// It is shared between the readers, and the store snapshot is released
+// once the state has published a newer one and all the readers have released it.
+type readSnapshot struct {
+	refs atomic.Int32
+
+	store               store.ReadSnapshot
+	lastBlockHeight     uint32
+	lastBlockHash       hash.Hash
+	lastBlockTime       time.Time
+	lastCert            *certificate.Certificate
+	committee           committee.Reader
+	committeeValidators []*validator.Validator
+	committeePower      int64
+	totalPower          int64
+	availabilityFrom    uint32
+	params              *param.Params
+}
+
+// publishReadSnapshot takes a new read snapshot and replaces the previous one.
+// It should be called after writing the changes into the store.
+func (st *state) publishReadSnapshot() error {
+	// The committee is copied, since the state updates it in place.
+	cmt, err := committee.NewCommittee(st.committee.Validators(),
+		st.params.CommitteeSize, st.committee.Proposer(0).Address())
+	if err != nil {
+		return err
+	}
+
+	storeSnap, err := st.store.NewReadSnapshot()
+	if err != nil {
+		return err
+	}
+
+	snap := &readSnapshot{
+		store:               storeSnap,
+		lastBlockHeight:     st.lastInfo.BlockHeight(),
+		lastBlockHash:       st.lastInfo.BlockHash(),
+		lastBlockTime:       st.lastInfo.BlockTime(),
+		lastCert:            st.lastInfo.Certificate(),
+		committee:           cmt,
+		committeeValidators: cmt.Validators(),
+		committeePower:      cmt.TotalPower(),
+		totalPower:          st.totalPower,
+		availabilityFrom:    st.availabilityFrom,
+		params:              st.params,
+	}
+	// The state holds a reference until the snapshot is replaced.
+	snap.refs.Store(1)
+
+	st.snapshotLk.Lock()
+	prevSnap := st.snapshot
+	st.snapshot = snap
+	st.snapshotLk.Unlock()
+
+	if prevSnap != nil {
+		prevSnap.Release()
+	}
+
+	return nil
+}
+
+func (st *state) ReadSnapshot() ReadSnapshot {
+	return st.acquireReadSnapshot()
+}
+
+// acquireReadSnapshot returns the last published read snapshot, holding a reference to it.
+func (st *state) acquireReadSnapshot() *readSnapshot {
+	st.snapshotLk.Lock()
+	defer st.snapshotLk.Unlock()
+
+	st.snapshot.refs.Add(1)
+
+	return st.snapshot
+}
+
+func (rs *readSnapshot) LastBlockHeight() uint32 {
+	return rs.lastBlockHeight
+}
+
+func (rs *readSnapshot) LastBlockHash() hash.Hash {
+	return rs.lastBlockHash
+}
+
+func (rs *readSnapshot) LastBlockTime() time.Time {
+	return rs.lastBlockTime
+}
+
+func (rs *readSnapshot) LastCertificate() *certificate.Certificate {
+	return rs.lastCert
+}
+
+func (rs *readSnapshot) CommitteeValidators() []*validator.Validator {
+	return rs.committeeValidators
+}
+
+func (rs *readSnapshot) CommitteePower() int64 {
+	return rs.committeePower
+}
+
+func (rs *readSnapshot) TotalPower() int64 {
+	return rs.totalPower
+}
+
+func (rs *readSnapshot) TotalAccounts() int32 {
+	return rs.store.TotalAccounts()
+}
+
+func (rs *readSnapshot) TotalValidators() int32 {
+	return rs.store.TotalValidators()
+}
+
+func (rs *readSnapshot) CommittedBlock(height uint32) *store.CommittedBlock {
+	b, err := rs.store.Block(height)
+	if err != nil {
+		logger.Trace("error on retrieving block", "error", err)
+
+		return nil
+	}
+
+	return b
+}
+
+func (rs *readSnapshot) CommittedTx(id tx.ID) *store.CommittedTx {
+	transaction, err := rs.store.Transaction(id)
+	if err != nil {
+		logger.Trace("searching transaction in local store failed", "id", id, "error", err)
+	}
+
+	return transaction
+}
+
+func (rs *readSnapshot) TxReceipt(id tx.ID) *receipt.Receipt {
+	rcpt, err := rs.store.Receipt(id)
+	if err != nil {
+		logger.Trace("searching receipt in local store failed", "id", id, "error", err)
+	}
+
+	return rcpt
+}
+
+func (rs *readSnapshot) BlockHash(height uint32) hash.Hash {
+	return rs.store.BlockHash(height)
+}
+
+func (rs *readSnapshot) BlockHeight(h hash.Hash) uint32 {
+	return rs.store.BlockHeight(h)
+}
+
+func (rs *readSnapshot) AccountByAddress(addr crypto.Address) *account.Account {
+	acc, err := rs.store.Account(addr)
+	if err != nil {
+		logger.Trace("error on retrieving account", "error", err)
+	}
+
+	return acc
+}
+
+func (rs *readSnapshot) ValidatorByAddress(addr crypto.Address) *validator.Validator {
+	val, err := rs.store.Validator(addr)
+	if err != nil {
+		logger.Trace("error on retrieving validator", "error", err)
+	}
+
+	return val
+}
+
+func (rs *readSnapshot) ValidatorByNumber(number int32) *validator.Validator {
+	val, err := rs.store.ValidatorByNumber(number)
+	if err != nil {
+		logger.Trace("error on retrieving validator", "error", err)
+	}
+
+	return val
+}
+
+func (rs *readSnapshot) PublicKey(addr crypto.Address) (crypto.PublicKey, error) {
+	return rs.store.PublicKey(addr)
+}
+
+func (rs *readSnapshot) AvailabilityScore(valNum int32) float64 {
+	return availabilityScore(rs.store, valNum, rs.availabilityFrom)
+}
+
+// SimulateTransaction executes the transaction on a sandbox on top of the snapshot.
+// The transaction is checked in non-strict mode, as it would be in the next block.
+func (rs *readSnapshot) SimulateTransaction(trx *tx.Tx) (*receipt.Receipt, error) {
+	sb := sandbox.NewSandbox(rs.lastBlockHeight, rs.store, rs.params, rs.committee, rs.totalPower)
+
+	return execution.NewChecker().ExecuteWithReceipt(trx, sb)
+}
+
+func (rs *readSnapshot) Release() {
+	if rs.refs.Add(-1) == 0 {
+		rs.store.Release()
+	}
+}
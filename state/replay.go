@@ -0,0 +1,218 @@
+package state
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/execution"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/txpool"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+)
+
+// Replay re-executes all the blocks in the given store from the genesis on a fresh in-memory state.
+// After executing each block, the replayed state root is compared with the state root
+// that the next block committed to. For the last block, it is compared with the state in the store.
+// The receipts of the replayed transactions are compared with the stored receipts as well.
+// The onBlock callback, if provided, is called after replaying each block.
+// If the replayed state diverges, a ReplayDivergenceError is returned that holds the first divergent height.
+func Replay(genDoc *genesis.Genesis, str store.Reader, onBlock func(height uint32)) error {
+	if str.PruningHeight() > 0 {
+		return fmt.Errorf("unable to replay a pruned store, blocks are pruned up to %d",
+			str.PruningHeight())
+	}
+
+	lastCert := str.LastCertificate()
+	if lastCert == nil {
+		return fmt.Errorf("store is empty")
+	}
+	lastHeight := lastCert.Height()
+
+	st, memStore, err := newReplayState(genDoc)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = memStore.Close() }()
+
+	blk, err := readReplayBlock(str, 1)
+	if err != nil {
+		return err
+	}
+	if blk.Header().StateRoot() != st.stateRoot() {
+		return ReplayDivergenceError{
+			Height: 0,
+			Reason: fmt.Sprintf("genesis state root mismatch, expected %s, got %s",
+				blk.Header().StateRoot(), st.stateRoot()),
+		}
+	}
+
+	for height := uint32(1); height <= lastHeight; height++ {
+		// The certificate of each block is stored in the next block,
+		// and so is the state root after executing the block.
+		var nextBlk *block.Block
+		var cert *certificate.Certificate
+		var expectedRoot hash.Hash
+		if height < lastHeight {
+			nextBlk, err = readReplayBlock(str, height+1)
+			if err != nil {
+				return err
+			}
+			cert = nextBlk.PrevCertificate()
+			expectedRoot = nextBlk.Header().StateRoot()
+		} else {
+			cert = lastCert
+			expectedRoot, err = calcStoreStateRoot(str, lastHeight)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := st.replayBlock(str, height, blk, cert, expectedRoot); err != nil {
+			return err
+		}
+
+		if onBlock != nil {
+			onBlock(height)
+		}
+		blk = nextBlk
+	}
+
+	return nil
+}
+
+// newReplayState creates a fresh state on an in-memory store, to replay the blocks from the genesis.
+// The caller should close the returned store.
+func newReplayState(genDoc *genesis.Genesis) (*state, store.Store, error) {
+	memConf := store.DefaultConfig()
+	memConf.Engine = store.EngineMemory
+	memConf.TxCacheSize = 0
+	memConf.SortitionCacheSize = 0
+	for _, params := range genDoc.ParamsHistory() {
+		memConf.TxCacheSize = max(memConf.TxCacheSize, params.TransactionToLiveInterval)
+		memConf.SortitionCacheSize = max(memConf.SortitionCacheSize, params.SortitionInterval)
+	}
+	// There is no need to keep all the replayed blocks in memory.
+	memConf.RetentionBlocks = max(memConf.TxCacheSize, memConf.SortitionCacheSize) + 1
+
+	memStore, err := store.NewStore(memConf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txPool := txpool.NewTxPool(txpool.DefaultConfig(), nil)
+	facade, err := LoadOrNewState(genDoc, nil, memStore, txPool, nil)
+	if err != nil {
+		_ = memStore.Close()
+
+		return nil, nil, err
+	}
+
+	return facade.(*state), memStore, nil
+}
+
+// readReplayBlock reads and decodes the block at the given height.
+func readReplayBlock(str store.Reader, height uint32) (*block.Block, error) {
+	cb, err := str.Block(height)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read block %d: %w", height, err)
+	}
+
+	blk, err := cb.ToBlock()
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode block %d: %w", height, err)
+	}
+
+	return blk, nil
+}
+
+// replayBlock executes the block on the replayed state and compares the outcome with the stored chain.
+func (st *state) replayBlock(str store.Reader, height uint32, blk *block.Block,
+	cert *certificate.Certificate, expectedRoot hash.Hash,
+) error {
+	if cert == nil || cert.Height() != height {
+		return fmt.Errorf("no certificate for block %d", height)
+	}
+
+	// Committing a block that is not linked to the last block is treated as a fork.
+	if blk.Header().PrevBlockHash() != st.LastBlockHash() {
+		return fmt.Errorf("block %d is not linked to the previous block", height)
+	}
+
+	if err := st.CommitBlock(blk, cert); err != nil {
+		return ReplayDivergenceError{
+			Height:       height,
+			Reason:       err.Error(),
+			Transactions: st.failedTransactions(blk),
+		}
+	}
+
+	diffs := st.receiptDivergences(str, blk)
+	if st.stateRoot() != expectedRoot {
+		return ReplayDivergenceError{
+			Height: height,
+			Reason: fmt.Sprintf("state root mismatch, expected %s, got %s",
+				expectedRoot, st.stateRoot()),
+			Transactions: diffs,
+		}
+	}
+
+	if len(diffs) > 0 {
+		return ReplayDivergenceError{
+			Height:       height,
+			Reason:       "receipt mismatch",
+			Transactions: diffs,
+		}
+	}
+
+	return nil
+}
+
+// failedTransactions re-executes the transactions of a rejected block
+// on a sandbox and returns the first one that fails.
+func (st *state) failedTransactions(blk *block.Block) []TxDivergence {
+	sb := st.concreteSandbox()
+	exe := execution.NewExecutor()
+	for _, trx := range blk.Transactions() {
+		if err := exe.Execute(trx, sb); err != nil {
+			return []TxDivergence{{ID: trx.ID(), Reason: err.Error()}}
+		}
+	}
+
+	return nil
+}
+
+// receiptDivergences compares the receipts of the replayed transactions with the stored receipts.
+func (st *state) receiptDivergences(str store.Reader, blk *block.Block) []TxDivergence {
+	diffs := []TxDivergence{}
+	for _, trx := range blk.Transactions() {
+		replayed, err := st.store.Receipt(trx.ID())
+		if err != nil {
+			diffs = append(diffs, TxDivergence{
+				ID:     trx.ID(),
+				Reason: fmt.Sprintf("no replayed receipt: %s", err),
+			})
+
+			continue
+		}
+
+		stored, err := str.Receipt(trx.ID())
+		if err != nil {
+			// The receipt is not stored, e.g. the store is pruned or created by an older version.
+			continue
+		}
+
+		replayedData, _ := replayed.Bytes()
+		storedData, _ := stored.Bytes()
+		if !bytes.Equal(replayedData, storedData) {
+			diffs = append(diffs, TxDivergence{
+				ID:     trx.ID(),
+				Reason: "receipt mismatch",
+			})
+		}
+	}
+
+	return diffs
+}
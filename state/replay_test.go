@@ -0,0 +1,81 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/receipt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplay(t *testing.T) {
+	t.Run("Valid chain", func(t *testing.T) {
+		td := setup(t)
+
+		replayed := uint32(0)
+		err := Replay(td.state.genDoc, td.state.store, func(height uint32) {
+			replayed = height
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, td.state.LastBlockHeight(), replayed)
+	})
+
+	t.Run("Pruned store", func(t *testing.T) {
+		td := setup(t)
+
+		mockStore := td.state.store.(*store.MockStore)
+		mockStore.PrunedHeight = 2
+
+		err := Replay(td.state.genDoc, mockStore, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid state root", func(t *testing.T) {
+		td := setup(t)
+
+		mockStore := td.state.store.(*store.MockStore)
+		blk := mockStore.Blocks[7]
+		header := blk.Header()
+		mockStore.Blocks[7] = block.MakeBlock(header.Version(), header.Time(), blk.Transactions(),
+			header.PrevBlockHash(), td.RandHash(), blk.PrevCertificate(),
+			header.SortitionSeed(), header.ProposerAddress())
+
+		// The state root of block 7 is the state after executing block 6.
+		err := Replay(td.state.genDoc, mockStore, nil)
+		var divergenceErr ReplayDivergenceError
+		require.ErrorAs(t, err, &divergenceErr)
+		assert.Equal(t, uint32(6), divergenceErr.Height)
+	})
+
+	t.Run("Modified receipt", func(t *testing.T) {
+		td := setup(t)
+
+		mockStore := td.state.store.(*store.MockStore)
+		trx := mockStore.Blocks[4].Transactions()[0]
+		mockStore.Receipts[trx.ID()] = &receipt.Receipt{Fee: td.RandAmount()}
+
+		err := Replay(td.state.genDoc, mockStore, nil)
+		var divergenceErr ReplayDivergenceError
+		require.ErrorAs(t, err, &divergenceErr)
+		assert.Equal(t, uint32(4), divergenceErr.Height)
+		require.Len(t, divergenceErr.Transactions, 1)
+		assert.Equal(t, trx.ID(), divergenceErr.Transactions[0].ID)
+	})
+
+	t.Run("Modified account", func(t *testing.T) {
+		td := setup(t)
+
+		mockStore := td.state.store.(*store.MockStore)
+		acc, _ := mockStore.Account(crypto.TreasuryAddress)
+		acc.AddToBalance(1)
+		mockStore.UpdateAccount(crypto.TreasuryAddress, acc)
+
+		err := Replay(td.state.genDoc, mockStore, nil)
+		var divergenceErr ReplayDivergenceError
+		require.ErrorAs(t, err, &divergenceErr)
+		assert.Equal(t, td.state.LastBlockHeight(), divergenceErr.Height)
+	})
+}
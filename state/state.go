@@ -1,7 +1,10 @@
 package state
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -14,7 +17,6 @@ import (
 	"github.com/pactus-project/pactus/sandbox"
 	"github.com/pactus-project/pactus/sortition"
 	"github.com/pactus-project/pactus/state/lastinfo"
-	"github.com/pactus-project/pactus/state/score"
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/txpool"
 	"github.com/pactus-project/pactus/types/account"
@@ -22,6 +24,7 @@ import (
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
 	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
@@ -36,6 +39,11 @@ import (
 
 var maxTransactionsPerBlock = 1000
 
+// availabilityScoreWindow is the number of recent certificates
+// that the availability score is calculated over.
+// The certificates of the blocks that are older than about a month are ignored.
+const availabilityScoreWindow = uint32(60000)
+
 type state struct {
 	lk sync.RWMutex
 
@@ -46,12 +54,21 @@ type state struct {
 	txPool          txpool.TxPool
 	committee       committee.Committee
 	totalPower      int64
+	treasuryBalance amount.Amount
+	totalStaked     amount.Amount
+	unbondingAmount amount.Amount
 	lastInfo        *lastinfo.LastInfo
 	accountMerkle   *persistentmerkle.Tree
 	validatorMerkle *persistentmerkle.Tree
-	scoreMgr        *score.Manager
 	logger          *logger.SubLogger
 	eventCh         chan event.Event
+	halted          bool
+	// availabilityFrom is the first height of the availability score window.
+	// It is updated on committing a block, since finding it needs reading the blocks.
+	availabilityFrom uint32
+
+	snapshotLk sync.Mutex
+	snapshot   *readSnapshot
 }
 
 func LoadOrNewState(
@@ -89,37 +106,28 @@ func LoadOrNewState(
 		}
 	}
 
+	ev, err := str.ForkEvidence()
+	if err != nil {
+		return nil, err
+	}
+	if ev != nil {
+		st.logger.Warn("state is halted due to a detected fork, choose a branch to resolve it",
+			"height", ev.Height)
+		st.halted = true
+	}
+
+	st.updateParams()
+
 	st.totalPower = st.retrieveTotalPower()
+	st.retrieveSupply()
 
 	st.loadMerkels()
 
 	txPool.SetNewSandboxAndRecheck(st.concreteSandbox())
 
-	// Restoring score manager
-	st.logger.Info("calculating the availability scores...")
-	scoreWindow := uint32(60000)
-	startHeight := uint32(2)
-	endHeight := st.lastInfo.BlockHeight()
-	if endHeight > scoreWindow {
-		startHeight = endHeight - scoreWindow
-	}
-
-	scoreMgr := score.NewScoreManager(scoreWindow)
-	for h := startHeight; h <= endHeight; h++ {
-		cb, err := st.store.Block(h)
-		if err != nil {
-			return nil, err
-		}
-		blk, err := cb.ToBlock()
-		if err != nil {
-			return nil, err
-		}
-		scoreMgr.SetCertificate(blk.PrevCertificate())
-	}
-	st.scoreMgr = scoreMgr
-
-	for _, num := range st.committee.Committers() {
-		st.logger.Debug("availability score", "val", num, "score", st.scoreMgr.AvailabilityScore(num))
+	st.updateAvailabilityWindow()
+	if err := st.publishReadSnapshot(); err != nil {
+		return nil, err
 	}
 
 	st.logger.Debug("last info", "committers", st.committee.Committers(), "state_root", st.stateRoot())
@@ -132,28 +140,47 @@ func (st *state) concreteSandbox() sandbox.Sandbox {
 		st.store, st.params, st.committee, st.totalPower)
 }
 
+// updateParams switches the parameters to the ones in effect for the next block,
+// if an upgrade is scheduled for it in the genesis document.
+func (st *state) updateParams() {
+	params := st.genDoc.ParamsAt(st.lastInfo.BlockHeight() + 1)
+	if *params == *st.params {
+		return
+	}
+
+	st.logger.Info("parameters are upgraded",
+		"height", st.lastInfo.BlockHeight()+1, "block_version", params.BlockVersion)
+	st.params = params
+	st.committee.SetSize(params.CommitteeSize)
+}
+
 func (st *state) tryLoadLastInfo() error {
 	// Make sure the genesis doc is the same as before.
 	//
 	// This check is not strictly necessary, since the genesis state is already committed.
 	// However, it is good to perform this check to ensure that the genesis document has not been modified.
-	genStateRoot := st.calculateGenesisStateRootFromGenesisDoc()
-	committedBlockOne, err := st.store.Block(1)
-	if err != nil {
-		return err
-	}
+	// The first block is not available in pruned nodes.
+	if st.store.PruningHeight() == 0 {
+		genStateRoot := st.calculateGenesisStateRootFromGenesisDoc()
+		committedBlockOne, err := st.store.Block(1)
+		if err != nil {
+			return err
+		}
 
-	blockOne, err := committedBlockOne.ToBlock()
-	if err != nil {
-		return err
-	}
+		blockOne, err := committedBlockOne.ToBlock()
+		if err != nil {
+			return err
+		}
 
-	if genStateRoot != blockOne.Header().StateRoot() {
-		return fmt.Errorf("invalid genesis doc")
+		if genStateRoot != blockOne.Header().StateRoot() {
+			return fmt.Errorf("invalid genesis doc")
+		}
 	}
 
+	// The last committee is restored by the parameters that the last block is committed with.
 	logger.Debug("try to restore the last state")
-	committeeInstance, err := st.lastInfo.RestoreLastInfo(st.store, st.params.CommitteeSize)
+	lastParams := st.genDoc.ParamsAt(st.store.LastCertificate().Height())
+	committeeInstance, err := st.lastInfo.RestoreLastInfo(st.store, lastParams.CommitteeSize)
 	if err != nil {
 		return err
 	}
@@ -215,6 +242,10 @@ func (st *state) loadMerkels() {
 
 		return false
 	})
+
+	// Calculating the roots caches the hashes of all the nodes,
+	// so the proofs can be built under the read lock.
+	_ = st.stateRoot()
 }
 
 func (st *state) retrieveTotalPower() int64 {
@@ -349,6 +380,10 @@ func (st *state) ProposeBlock(valKey *bls.ValidatorKey, rewardAddr crypto.Addres
 	st.lk.Lock()
 	defer st.lk.Unlock()
 
+	if st.halted {
+		return nil, ErrHalted
+	}
+
 	// Create new sandbox and execute transactions
 	sb := st.concreteSandbox()
 	exe := execution.NewExecutor()
@@ -401,6 +436,10 @@ func (st *state) ValidateBlock(blk *block.Block, round int16) error {
 	st.lk.Lock()
 	defer st.lk.Unlock()
 
+	if st.halted {
+		return ErrHalted
+	}
+
 	if err := st.validateBlock(blk, round); err != nil {
 		return err
 	}
@@ -411,14 +450,19 @@ func (st *state) ValidateBlock(blk *block.Block, round int16) error {
 	}
 
 	sb := st.concreteSandbox()
+	_, err := st.executeBlock(blk, sb)
 
-	return st.executeBlock(blk, sb)
+	return err
 }
 
 func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) error {
 	st.lk.Lock()
 	defer st.lk.Unlock()
 
+	if st.halted {
+		return ErrHalted
+	}
+
 	height := cert.Height()
 	if height != st.lastInfo.BlockHeight()+1 {
 		st.logger.Debug("block is committed before", "height", height)
@@ -437,13 +481,9 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) er
 	// On the other hand, Sync module receives new blocks from the network and
 	// tries to commit them.
 	// We should never have a fork in our blockchain.
-	// But if it happens, here we can catch it.
+	// But if it happens, here we can catch it and halt the state.
 	if blk.Header().PrevBlockHash() != st.lastInfo.BlockHash() {
-		st.logger.Panic("a possible fork is detected",
-			"our hash", st.lastInfo.BlockHash(),
-			"block hash", blk.Header().PrevBlockHash())
-
-		return errors.Error(errors.ErrInvalidBlock)
+		return st.haltOnFork(blk, cert)
 	}
 
 	err = st.validateBlock(blk, cert.Round())
@@ -454,7 +494,8 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) er
 	// -----------------------------------
 	// Execute block
 	sb := st.concreteSandbox()
-	if err := st.executeBlock(blk, sb); err != nil {
+	receipts, err := st.executeBlock(blk, sb)
+	if err != nil {
 		return err
 	}
 
@@ -470,6 +511,9 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) er
 	st.commitSandbox(sb, cert.Round())
 
 	st.store.SaveBlock(blk, cert)
+	for i, trx := range blk.Transactions() {
+		st.store.SaveReceipt(trx.ID(), receipts[i])
+	}
 
 	// Remove transactions from pool
 	for _, trx := range blk.Transactions() {
@@ -480,6 +524,15 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) er
 		st.logger.Panic("unable to update state", "error", err)
 	}
 
+	// The parameters are updated before publishing the read snapshot,
+	// so the simulated transactions are checked against the parameters of the next block.
+	st.updateParams()
+
+	st.updateAvailabilityWindow()
+	if err := st.publishReadSnapshot(); err != nil {
+		st.logger.Panic("unable to publish the read snapshot", "error", err)
+	}
+
 	st.logger.Info("new block committed", "block", blk, "round", cert.Round())
 
 	st.evaluateSortition()
@@ -488,20 +541,6 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) er
 	// At this point we can assign a new sandbox to tx pool
 	st.txPool.SetNewSandboxAndRecheck(st.concreteSandbox())
 
-	// -----------------------------------
-	// Updating score manager
-	if blk.Header().Time().After(time.Now().AddDate(0, -1, -1)) {
-		prevCert := blk.PrevCertificate()
-		if prevCert != nil {
-			st.scoreMgr.SetCertificate(prevCert)
-
-			// TODO: Remove me after gRPC done
-			for _, num := range st.committee.Committers() {
-				st.logger.Debug("availability score", "val", num, "score", st.scoreMgr.AvailabilityScore(num))
-			}
-		}
-	}
-
 	// -----------------------------------
 	// Publishing the events to the zmq
 	st.publishEvents(height, blk)
@@ -509,6 +548,61 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) er
 	return nil
 }
 
+// haltOnFork halts the state when a certified block doesn't extend our last block.
+// Both competing blocks and their certificates are saved as the fork evidence,
+// so the operator can choose a branch on restart.
+func (st *state) haltOnFork(blk *block.Block, cert *certificate.Certificate) error {
+	st.logger.Error("a possible fork is detected, halting the state",
+		"our hash", st.lastInfo.BlockHash(),
+		"block hash", blk.Header().PrevBlockHash())
+
+	st.halted = true
+
+	ev := &store.ForkEvidence{
+		Height:            st.lastInfo.BlockHeight(),
+		LocalBlockHash:    st.lastInfo.BlockHash().String(),
+		LocalCertificate:  certificateToHex(st.lastInfo.Certificate()),
+		RemotePrevHash:    blk.Header().PrevBlockHash().String(),
+		RemoteCertificate: certificateToHex(cert),
+	}
+	if cb, err := st.store.Block(ev.Height); err == nil {
+		ev.LocalBlock = hex.EncodeToString(cb.Data)
+	}
+	if data, err := blk.Bytes(); err == nil {
+		ev.RemoteBlock = hex.EncodeToString(data)
+	}
+
+	if err := st.store.SaveForkEvidence(ev); err != nil {
+		st.logger.Error("unable to save the fork evidence", "error", err)
+	}
+
+	return ForkDetectedError{
+		Height:         ev.Height,
+		LastBlockHash:  st.lastInfo.BlockHash(),
+		RemotePrevHash: blk.Header().PrevBlockHash(),
+	}
+}
+
+func certificateToHex(cert *certificate.Certificate) string {
+	if cert == nil {
+		return ""
+	}
+	w := bytes.NewBuffer(make([]byte, 0, cert.SerializeSize()))
+	if err := cert.Encode(w); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(w.Bytes())
+}
+
+// IsHalted returns true if the state is halted due to a detected fork.
+func (st *state) IsHalted() bool {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	return st.halted
+}
+
 func (st *state) evaluateSortition() bool {
 	evaluated := false
 	for _, key := range st.valKeys {
@@ -573,6 +667,20 @@ func (st *state) commitSandbox(sb sandbox.Sandbox, round int16) {
 		if updated {
 			st.store.UpdateAccount(addr, acc)
 			st.accountMerkle.SetHash(int(acc.Number()), acc.Hash())
+
+			if addr == crypto.TreasuryAddress {
+				st.treasuryBalance = acc.Balance()
+			}
+		}
+	})
+
+	// A validator that rotates its key changes its address,
+	// so its previous state is looked up by number, before the store is updated.
+	sb.IterateValidators(func(val *validator.Validator, updated bool, _ bool) {
+		if updated {
+			prevVal, _ := st.store.ValidatorByNumber(val.Number())
+			st.totalStaked += bondedStake(val) - bondedStake(prevVal)
+			st.unbondingAmount += unbondingStake(val) - unbondingStake(prevVal)
 		}
 	})
 
@@ -584,6 +692,10 @@ func (st *state) commitSandbox(sb sandbox.Sandbox, round int16) {
 	})
 
 	st.totalPower += sb.PowerDelta()
+
+	// Calculating the roots caches the hashes of all the nodes,
+	// so the proofs can be built under the read lock.
+	_ = st.stateRoot()
 }
 
 func (st *state) validateBlockTime(t time.Time) error {
@@ -640,11 +752,17 @@ func (st *state) CommitteeValidators() []*validator.Validator {
 }
 
 func (st *state) TotalAccounts() int32 {
-	return st.store.TotalAccounts()
+	snap := st.acquireReadSnapshot()
+	defer snap.Release()
+
+	return snap.TotalAccounts()
 }
 
 func (st *state) TotalValidators() int32 {
-	return st.store.TotalValidators()
+	snap := st.acquireReadSnapshot()
+	defer snap.Release()
+
+	return snap.TotalValidators()
 }
 
 func (st *state) IsInCommittee(addr crypto.Address) bool {
@@ -673,40 +791,72 @@ func (st *state) IsValidator(addr crypto.Address) bool {
 }
 
 func (st *state) CommittedBlock(height uint32) *store.CommittedBlock {
-	b, err := st.store.Block(height)
-	if err != nil {
-		st.logger.Trace("error on retrieving block", "error", err)
+	snap := st.acquireReadSnapshot()
+	defer snap.Release()
 
-		return nil
-	}
-
-	return b
+	return snap.CommittedBlock(height)
 }
 
 func (st *state) CommittedTx(id tx.ID) *store.CommittedTx {
-	transaction, err := st.store.Transaction(id)
-	if err != nil {
-		st.logger.Trace("searching transaction in local store failed", "id", id, "error", err)
-	}
+	snap := st.acquireReadSnapshot()
+	defer snap.Release()
 
-	return transaction
+	return snap.CommittedTx(id)
+}
+
+// TxReceipt returns the receipt of a committed transaction, or nil if it is not found.
+func (st *state) TxReceipt(id tx.ID) *receipt.Receipt {
+	snap := st.acquireReadSnapshot()
+	defer snap.Release()
+
+	return snap.TxReceipt(id)
+}
+
+// SimulateTransaction executes the transaction on a throwaway sandbox and returns its receipt.
+// The transaction is checked in non-strict mode, and the state is left untouched.
+func (st *state) SimulateTransaction(trx *tx.Tx) (*receipt.Receipt, error) {
+	snap := st.acquireReadSnapshot()
+	defer snap.Release()
+
+	return snap.SimulateTransaction(trx)
+}
+
+func (st *state) AddressTransactions(addr crypto.Address, offset, limit int) ([]*store.CommittedTx, error) {
+	return st.store.AddressTransactions(addr, offset, limit)
+}
+
+func (st *state) ValidatorRewards(addr crypto.Address, offset, limit int,
+) (store.RewardSummary, []store.ValidatorReward) {
+	return st.store.ValidatorRewards(addr, offset, limit)
+}
+
+func (st *state) IsPruned() bool {
+	return st.store.IsPruned()
+}
+
+func (st *state) PruningHeight() uint32 {
+	return st.store.PruningHeight()
 }
 
 func (st *state) BlockHash(height uint32) hash.Hash {
-	return st.store.BlockHash(height)
+	snap := st.acquireReadSnapshot()
+	defer snap.Release()
+
+	return snap.BlockHash(height)
 }
 
 func (st *state) BlockHeight(h hash.Hash) uint32 {
-	return st.store.BlockHeight(h)
+	snap := st.acquireReadSnapshot()
+	defer snap.Release()
+
+	return snap.BlockHeight(h)
 }
 
 func (st *state) AccountByAddress(addr crypto.Address) *account.Account {
-	acc, err := st.store.Account(addr)
-	if err != nil {
-		st.logger.Trace("error on retrieving account", "error", err)
-	}
+	snap := st.acquireReadSnapshot()
+	defer snap.Release()
 
-	return acc
+	return snap.AccountByAddress(addr)
 }
 
 func (st *state) ValidatorAddresses() []crypto.Address {
@@ -714,22 +864,91 @@ func (st *state) ValidatorAddresses() []crypto.Address {
 }
 
 func (st *state) ValidatorByAddress(addr crypto.Address) *validator.Validator {
+	snap := st.acquireReadSnapshot()
+	defer snap.Release()
+
+	return snap.ValidatorByAddress(addr)
+}
+
+// AccountAtHeight returns the account data at the given height.
+// It is only available when the store is in archival mode.
+func (st *state) AccountAtHeight(addr crypto.Address, height uint32) (*account.Account, error) {
+	return st.store.AccountAtHeight(addr, height)
+}
+
+// ValidatorAtHeight returns the validator data at the given height.
+// It is only available when the store is in archival mode.
+func (st *state) ValidatorAtHeight(addr crypto.Address, height uint32) (*validator.Validator, error) {
+	return st.store.ValidatorAtHeight(addr, height)
+}
+
+// StateProof is the proof of inclusion of an account or a validator in the state root.
+// The state root is the one that the node has calculated after committing its last block,
+// and it is committed in the header of the block at the given height.
+// Therefore, the proof can be checked against a certified header once that block is committed.
+type StateProof struct {
+	*persistentmerkle.Proof
+
+	StateRoot hash.Hash
+	Height    uint32
+}
+
+// AccountProof returns the account alongside the proof of its inclusion in the current state root.
+func (st *state) AccountProof(addr crypto.Address) (*account.Account, *StateProof, error) {
+	// The hashes of the merkle nodes are cached on committing, so reading the trees doesn't modify them.
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	acc, err := st.store.Account(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	proof := st.accountMerkle.Proof(int(acc.Number()))
+	if proof == nil {
+		return nil, nil, errors.Errorf(errors.ErrGeneric, "no merkle leaf for account %s", addr)
+	}
+
+	// The state root is the hash of the account root (left) and the validator root (right).
+	proof.Siblings = append(proof.Siblings, st.validatorMerkle.Root())
+
+	return acc, st.stateProof(proof), nil
+}
+
+// ValidatorProof returns the validator alongside the proof of its inclusion in the current state root.
+func (st *state) ValidatorProof(addr crypto.Address) (*validator.Validator, *StateProof, error) {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
 	val, err := st.store.Validator(addr)
 	if err != nil {
-		st.logger.Trace("error on retrieving validator", "error", err)
+		return nil, nil, err
 	}
+	proof := st.validatorMerkle.Proof(int(val.Number()))
+	if proof == nil {
+		return nil, nil, errors.Errorf(errors.ErrGeneric, "no merkle leaf for validator %s", addr)
+	}
+
+	proof.Index |= 1 << len(proof.Siblings)
+	proof.Siblings = append(proof.Siblings, st.accountMerkle.Root())
+
+	return val, st.stateProof(proof), nil
+}
 
-	return val
+// stateProof attaches the current state root, and the height of the block that commits to it, to the proof.
+func (st *state) stateProof(proof *persistentmerkle.Proof) *StateProof {
+	return &StateProof{
+		Proof:     proof,
+		StateRoot: st.stateRoot(),
+		Height:    st.lastInfo.BlockHeight() + 1,
+	}
 }
 
 // ValidatorByNumber returns validator data based on validator number.
 func (st *state) ValidatorByNumber(n int32) *validator.Validator {
-	val, err := st.store.ValidatorByNumber(n)
-	if err != nil {
-		st.logger.Trace("error on retrieving validator", "error", err)
-	}
+	snap := st.acquireReadSnapshot()
+	defer snap.Release()
 
-	return val
+	return snap.ValidatorByNumber(n)
 }
 
 func (st *state) PendingTx(id tx.ID) *tx.Tx {
@@ -745,6 +964,9 @@ func (st *state) AddPendingTxAndBroadcast(trx *tx.Tx) error {
 }
 
 func (st *state) Params() *param.Params {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
 	return st.params
 }
 
@@ -762,8 +984,8 @@ func (st *state) publishEvents(height uint32, blk *block.Block) {
 		accChangeEvent := event.CreateAccountChangeEvent(transaction.Payload().Signer(), height)
 		st.eventCh <- accChangeEvent
 
-		if transaction.Payload().Receiver() != nil {
-			accChangeEvent := event.CreateAccountChangeEvent(*transaction.Payload().Receiver(), height)
+		for _, receiver := range payload.Receivers(transaction.Payload()) {
+			accChangeEvent := event.CreateAccountChangeEvent(receiver, height)
 			st.eventCh <- accChangeEvent
 		}
 
@@ -773,19 +995,124 @@ func (st *state) publishEvents(height uint32, blk *block.Block) {
 }
 
 func (st *state) CalculateFee(amt amount.Amount, payloadType payload.Type) amount.Amount {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
 	return execution.CalculateFee(amt, payloadType, st.params)
 }
 
-func (st *state) PublicKey(addr crypto.Address) (crypto.PublicKey, error) {
+func (st *state) CalculateBatchTransferFee(amt amount.Amount, recipients int) amount.Amount {
 	st.lk.RLock()
 	defer st.lk.RUnlock()
 
-	return st.store.PublicKey(addr)
+	return execution.CalculateBatchTransferFee(amt, recipients, st.params)
+}
+
+func (st *state) PublicKey(addr crypto.Address) (crypto.PublicKey, error) {
+	snap := st.acquireReadSnapshot()
+	defer snap.Release()
+
+	return snap.PublicKey(addr)
 }
 
+// AvailabilityScore returns the availability score of the validator
+// in the certificates of the recent blocks.
 func (st *state) AvailabilityScore(valNum int32) float64 {
 	st.lk.RLock()
 	defer st.lk.RUnlock()
 
-	return st.scoreMgr.AvailabilityScore(valNum)
+	return availabilityScore(st.store, valNum, st.availabilityFrom)
+}
+
+// availabilityReader reads the participation counters of the validators.
+type availabilityReader interface {
+	Availability(valNum int32, from, to uint32) (store.Availability, error)
+	AvailabilityRange() (from, to uint32, ok bool)
+	Block(height uint32) (*store.CommittedBlock, error)
+}
+
+// updateAvailabilityWindow finds the first height of the availability score window.
+// The window ends at the last certificate, and it starts at most availabilityScoreWindow certificates before,
+// skipping the certificates of the blocks that are older than a month before the last block.
+func (st *state) updateAvailabilityWindow() {
+	from, to, ok := st.store.AvailabilityRange()
+	if !ok {
+		return
+	}
+	if to >= availabilityScoreWindow && to-availabilityScoreWindow+1 > from {
+		from = to - availabilityScoreWindow + 1
+	}
+	// The window only moves forward, so the search starts from the previous boundary.
+	from = util.Max(from, st.availabilityFrom)
+	if from > to {
+		return
+	}
+
+	st.availabilityFrom = firstRecentCertificate(st.store, from, to, st.lastInfo.BlockTime().AddDate(0, -1, 0))
+}
+
+// availabilityScore calculates the score of the validator
+// over the certificates from the given height to the last one.
+func availabilityScore(reader availabilityReader, valNum int32, from uint32) float64 {
+	_, to, ok := reader.AvailabilityRange()
+	if !ok || from > to {
+		return 1.0
+	}
+
+	avail, err := reader.Availability(valNum, from, to)
+	if err != nil {
+		logger.Warn("unable to get the availability", "val", valNum, "error", err)
+
+		return 1.0
+	}
+
+	return avail.Score()
+}
+
+// firstRecentCertificate returns the height of the first certificate in the given range
+// that is included in a block after the given time, or to+1 if there is no such certificate.
+// The certificates of the pruned blocks are treated as old ones.
+func firstRecentCertificate(reader availabilityReader, from, to uint32, after time.Time) uint32 {
+	isRecent := func(height uint32) bool {
+		// The certificate of a block is included in the next block.
+		cb, err := reader.Block(height + 1)
+		if err != nil {
+			return false
+		}
+		header := new(block.Header)
+		if err := header.Decode(bytes.NewReader(cb.Data)); err != nil {
+			return false
+		}
+
+		return header.Time().After(after)
+	}
+
+	// The blocks are mostly recent, so the first certificate is checked before searching.
+	if isRecent(from) {
+		return from
+	}
+
+	index := sort.Search(int(to-from+1), func(i int) bool {
+		return isRecent(from + uint32(i))
+	})
+
+	return from + uint32(index)
+}
+
+// ValidatorAvailability returns the participation counters of the validator
+// in the certificates from the given height to the given height, inclusive.
+func (st *state) ValidatorAvailability(valNum int32, from, to uint32) (store.Availability, error) {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	return st.store.Availability(valNum, from, to)
+}
+
+// AvailabilityRange returns the heights of the first and the last certificates
+// that the participation of the validators is recorded for.
+func (st *state) AvailabilityRange() (uint32, uint32, bool) {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	return st.store.AvailabilityRange()
 }
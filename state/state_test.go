@@ -1,16 +1,20 @@
 package state
 
 import (
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/execution/executor"
 	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/state/lastinfo"
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/txpool"
 	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/amount"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
 	"github.com/pactus-project/pactus/types/param"
@@ -19,6 +23,7 @@ import (
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/persistentmerkle"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -199,7 +204,8 @@ func TestCommitSandbox(t *testing.T) {
 		sb.UpdateAccount(addr, newAcc)
 		td.state.commitSandbox(sb, 0)
 
-		stateAcc := td.state.AccountByAddress(addr)
+		// The changes are not written yet, so they are read from the store.
+		stateAcc, _ := td.state.store.Account(addr)
 		assert.Equal(t, newAcc, stateAcc)
 	})
 
@@ -213,8 +219,9 @@ func TestCommitSandbox(t *testing.T) {
 		sb.UpdateValidator(newVal)
 		td.state.commitSandbox(sb, 0)
 
-		stateValByNumber := td.state.ValidatorByAddress(pub.ValidatorAddress())
-		stateValByAddr := td.state.ValidatorByAddress(pub.ValidatorAddress())
+		// The changes are not written yet, so they are read from the store.
+		stateValByNumber, _ := td.state.store.ValidatorByNumber(newVal.Number())
+		stateValByAddr, _ := td.state.store.Validator(pub.ValidatorAddress())
 		assert.Equal(t, newVal, stateValByNumber)
 		assert.Equal(t, newVal, stateValByAddr)
 	})
@@ -231,7 +238,8 @@ func TestCommitSandbox(t *testing.T) {
 		sb.UpdateAccount(addr, acc)
 		td.state.commitSandbox(sb, 0)
 
-		stateAcc := td.state.AccountByAddress(addr)
+		// The changes are not written yet, so they are read from the store.
+		stateAcc, _ := td.state.store.Account(addr)
 		assert.Equal(t, bal-amt, stateAcc.Balance())
 	})
 
@@ -247,7 +255,8 @@ func TestCommitSandbox(t *testing.T) {
 		sb.UpdateValidator(val)
 		td.state.commitSandbox(sb, 0)
 
-		stateVal := td.state.ValidatorByAddress(addr)
+		// The changes are not written yet, so they are read from the store.
+		stateVal, _ := td.state.store.Validator(addr)
 		assert.Equal(t, stake+amt, stateVal.Stake(), val.Stake())
 	})
 
@@ -368,13 +377,7 @@ func TestForkDetection(t *testing.T) {
 	})
 
 	t.Run("Two blocks with different previous block hashes", func(t *testing.T) {
-		defer func() {
-			if r := recover(); r == nil {
-				t.Errorf("The code did not panic")
-			}
-		}()
-
-		blk0, _ := td.makeBlockAndCertificate(t, 0)
+		blk0, cert0 := td.makeBlockAndCertificate(t, 0)
 		blkFork := block.MakeBlock(
 			blk0.Header().Version(),
 			blk0.Header().Time(),
@@ -386,7 +389,40 @@ func TestForkDetection(t *testing.T) {
 			blk0.Header().ProposerAddress())
 		certFork := td.makeCertificateAndSign(t, blkFork.Hash(), 0)
 
-		_ = td.state.CommitBlock(blkFork, certFork)
+		lastHeight := td.state.LastBlockHeight()
+		lastHash := td.state.LastBlockHash()
+		err := td.state.CommitBlock(blkFork, certFork)
+		assert.ErrorIs(t, err, ForkDetectedError{
+			Height:         lastHeight,
+			LastBlockHash:  lastHash,
+			RemotePrevHash: blkFork.Header().PrevBlockHash(),
+		})
+		assert.True(t, td.state.IsHalted())
+
+		ev, err := td.state.store.ForkEvidence()
+		require.NoError(t, err)
+		require.NotNil(t, ev)
+		assert.Equal(t, lastHeight, ev.Height)
+		assert.Equal(t, lastHash.String(), ev.LocalBlockHash)
+		assert.Equal(t, blkFork.Header().PrevBlockHash().String(), ev.RemotePrevHash)
+		assert.NotEmpty(t, ev.LocalBlock)
+		assert.NotEmpty(t, ev.LocalCertificate)
+		assert.NotEmpty(t, ev.RemoteBlock)
+		assert.NotEmpty(t, ev.RemoteCertificate)
+
+		// The halted state doesn't accept any block
+		assert.ErrorIs(t, td.state.CommitBlock(blk0, cert0), ErrHalted)
+		assert.ErrorIs(t, td.state.ValidateBlock(blk0, 0), ErrHalted)
+		_, err = td.state.ProposeBlock(td.genValKeys[0], td.RandAccAddress())
+		assert.ErrorIs(t, err, ErrHalted)
+		assert.Equal(t, lastHeight, td.state.LastBlockHeight())
+	})
+
+	t.Run("Loading the state with the fork evidence", func(t *testing.T) {
+		st, err := LoadOrNewState(td.state.genDoc, td.state.valKeys, td.state.store,
+			td.commonTxPool, nil)
+		require.NoError(t, err)
+		assert.True(t, st.IsHalted())
 	})
 }
 
@@ -652,3 +688,428 @@ func TestCommittedBlock(t *testing.T) {
 		assert.Equal(t, td.state.LastBlockHash(), lastBlk.Hash())
 	})
 }
+
+func TestTxReceipt(t *testing.T) {
+	td := setup(t)
+
+	blk, cert := td.makeBlockAndCertificate(t, 0)
+	assert.NoError(t, td.state.CommitBlock(blk, cert))
+
+	subsidyTrx := blk.Transactions()[0]
+	rcpt := td.state.TxReceipt(subsidyTrx.ID())
+	require.NotNil(t, rcpt)
+	assert.Zero(t, rcpt.Fee)
+	assert.Equal(t, -subsidyTrx.Payload().Value(), rcpt.Change(crypto.TreasuryAddress).BalanceDelta)
+	assert.Equal(t, subsidyTrx.Payload().Value(), rcpt.Change(*subsidyTrx.Payload().Receiver()).BalanceDelta)
+
+	assert.Nil(t, td.state.TxReceipt(td.RandHash()))
+}
+
+func TestAvailabilityScore(t *testing.T) {
+	td := setup(t)
+
+	// The last validator is absent in all the certificates.
+	// The certificates of blocks 1 to 9 are recorded, since block 10 is the last block.
+	from, to, ok := td.state.AvailabilityRange()
+	require.True(t, ok)
+	assert.Equal(t, uint32(1), from)
+	assert.Equal(t, uint32(9), to)
+
+	assert.Equal(t, 1.0, td.state.AvailabilityScore(0))
+	assert.Equal(t, 0.0, td.state.AvailabilityScore(3))
+
+	avail, err := td.state.ValidatorAvailability(3, 5, 9)
+	require.NoError(t, err)
+	assert.Equal(t, store.Availability{InCommittee: 5, Absent: 5}, avail)
+
+	_, err = td.state.ValidatorAvailability(3, 5, 10)
+	assert.Error(t, err)
+
+	t.Run("Reload the state", func(t *testing.T) {
+		st, err := LoadOrNewState(td.state.genDoc, td.state.valKeys,
+			td.state.store, td.commonTxPool, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 1.0, st.AvailabilityScore(0))
+		assert.Equal(t, 0.0, st.AvailabilityScore(3))
+	})
+}
+
+func TestAvailabilityScoreOldBlocks(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	// The blocks 2 to 5 are older than a month, and the blocks 6 to 10 are recent.
+	mockStore := store.MockingStore(ts)
+	oldTime := util.Now().AddDate(0, -1, -2)
+	for height := uint32(1); height <= 10; height++ {
+		tme := util.Now()
+		if height <= 5 {
+			tme = oldTime
+		}
+		blk, cert := ts.GenerateTestBlockWithTime(height, tme)
+		mockStore.SaveBlock(blk, cert)
+	}
+
+	from, to, ok := mockStore.AvailabilityRange()
+	require.True(t, ok)
+	after := util.Now().AddDate(0, -1, -1)
+	assert.Equal(t, uint32(5), firstRecentCertificate(mockStore, from, to, after))
+	assert.Equal(t, uint32(5), firstRecentCertificate(mockStore, 5, to, after))
+	assert.Equal(t, uint32(4), firstRecentCertificate(mockStore, from, 3, after))
+
+	// The window is bounded by the time of the last block.
+	st := &state{store: mockStore, lastInfo: lastinfo.NewLastInfo()}
+	st.lastInfo.UpdateBlockTime(util.Now())
+	st.updateAvailabilityWindow()
+	assert.Equal(t, uint32(5), st.availabilityFrom)
+
+	valNum := mockStore.Blocks[10].PrevCertificate().Committers()[0]
+	expected, err := mockStore.Availability(valNum, 5, to)
+	require.NoError(t, err)
+	assert.Equal(t, expected.Score(), st.AvailabilityScore(valNum))
+
+	t.Run("The window moves forward with the last block time", func(t *testing.T) {
+		st.lastInfo.UpdateBlockTime(util.Now().AddDate(0, 1, 0))
+		st.updateAvailabilityWindow()
+		assert.Equal(t, to+1, st.availabilityFrom)
+		assert.Equal(t, 1.0, st.AvailabilityScore(valNum))
+	})
+
+	t.Run("Pruned blocks", func(t *testing.T) {
+		delete(mockStore.Blocks, 7)
+		assert.Equal(t, uint32(7), firstRecentCertificate(mockStore, 6, to, after))
+	})
+}
+
+func TestParamsUpgrade(t *testing.T) {
+	td := setup(t)
+
+	// Scheduling an upgrade for block 12, while the last block is 10.
+	newReward := td.state.params.BlockReward * 2
+	newFeeFraction := 0.0002
+	upgrade := param.Upgrade{
+		Height:       12,
+		BlockVersion: 2,
+		Overrides: param.Overrides{
+			BlockReward: &newReward,
+			FeeFraction: &newFeeFraction,
+		},
+	}
+	genDoc := td.state.genDoc
+	td.state.genDoc = genesis.MakeGenesis(genDoc.GenesisTime(), genDoc.Accounts(),
+		genDoc.Validators(), genDoc.Params(), upgrade)
+
+	assert.Equal(t, genDoc.Params(), td.state.Params())
+
+	blk, cert := td.makeBlockAndCertificate(t, 0)
+	assert.Equal(t, uint8(1), blk.Header().Version())
+	assert.NoError(t, td.state.CommitBlock(blk, cert))
+
+	upgradedParams := upgrade.Apply(genDoc.Params())
+	assert.Equal(t, upgradedParams, td.state.Params())
+
+	blk, cert = td.makeBlockAndCertificate(t, 0)
+	assert.Equal(t, uint8(2), blk.Header().Version())
+	assert.Equal(t, newReward, blk.Transactions()[0].Payload().Value())
+	assert.NoError(t, td.state.CommitBlock(blk, cert))
+
+	t.Run("Reload the state", func(t *testing.T) {
+		st, err := LoadOrNewState(td.state.genDoc, td.state.valKeys,
+			td.state.store, td.commonTxPool, nil)
+		require.NoError(t, err)
+		assert.Equal(t, upgradedParams, st.Params())
+	})
+}
+
+func TestSimulateTransaction(t *testing.T) {
+	td := setup(t)
+
+	sender := td.genAccKey.PublicKeyNative().AccountAddress()
+	receiver := td.RandAccAddress()
+	balance := td.state.AccountByAddress(sender).Balance()
+	lockTime := td.state.LastBlockHeight() + 1
+
+	t.Run("Successful execution", func(t *testing.T) {
+		amt := td.RandAmount()
+		fee := td.state.CalculateFee(amt, payload.TypeTransfer)
+		trx := tx.NewTransferTx(lockTime, sender, receiver, amt, fee, "")
+
+		rcpt, err := td.state.SimulateTransaction(trx)
+		require.NoError(t, err)
+		assert.Equal(t, fee, rcpt.Fee)
+		assert.Equal(t, -(amt + fee), rcpt.Change(sender).BalanceDelta)
+		assert.Equal(t, amt, rcpt.Change(receiver).BalanceDelta)
+
+		// The state should not be changed.
+		assert.Equal(t, balance, td.state.AccountByAddress(sender).Balance())
+		assert.Nil(t, td.state.AccountByAddress(receiver))
+	})
+
+	t.Run("Failed execution", func(t *testing.T) {
+		amt := balance + 1
+		fee := td.state.CalculateFee(amt, payload.TypeTransfer)
+		trx := tx.NewTransferTx(lockTime, sender, receiver, amt, fee, "")
+
+		_, err := td.state.SimulateTransaction(trx)
+		assert.ErrorIs(t, err, executor.ErrInsufficientFunds)
+	})
+
+	t.Run("Simulating doesn't wait for the state lock", func(t *testing.T) {
+		amt := td.RandAmount()
+		fee := td.state.CalculateFee(amt, payload.TypeTransfer)
+		trx := tx.NewTransferTx(lockTime, sender, receiver, amt, fee, "")
+
+		td.state.lk.Lock()
+		defer td.state.lk.Unlock()
+
+		done := make(chan struct{})
+		go func() {
+			_, err := td.state.SimulateTransaction(trx)
+			assert.NoError(t, err)
+			assert.NotNil(t, td.state.AccountByAddress(sender))
+			assert.NotNil(t, td.state.ValidatorByAddress(td.genValKeys[0].Address()))
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "reading the state is blocked by the state lock")
+		}
+	})
+}
+
+func TestStateProof(t *testing.T) {
+	td := setup(t)
+
+	accAddr := td.genAccKey.PublicKeyNative().AccountAddress()
+	acc, accProof, err := td.state.AccountProof(accAddr)
+	require.NoError(t, err)
+
+	valAddr := td.genValKeys[2].Address()
+	val, valProof, err := td.state.ValidatorProof(valAddr)
+	require.NoError(t, err)
+
+	// The current state root is committed in the header of the next block.
+	blk, _ := td.makeBlockAndCertificate(t, 0)
+	stateRoot := blk.Header().StateRoot()
+	assert.Equal(t, stateRoot, accProof.StateRoot)
+	assert.Equal(t, stateRoot, valProof.StateRoot)
+	assert.Equal(t, td.state.LastBlockHeight()+1, accProof.Height)
+	assert.Equal(t, td.state.LastBlockHeight()+1, valProof.Height)
+
+	assert.True(t, persistentmerkle.VerifyProof(stateRoot, acc.Hash(), accProof.Proof))
+	assert.True(t, persistentmerkle.VerifyProof(stateRoot, val.Hash(), valProof.Proof))
+	assert.False(t, persistentmerkle.VerifyProof(stateRoot, val.Hash(), accProof.Proof))
+	assert.False(t, persistentmerkle.VerifyProof(stateRoot, acc.Hash(), valProof.Proof))
+
+	_, _, err = td.state.AccountProof(td.RandAccAddress())
+	assert.Error(t, err)
+
+	_, _, err = td.state.ValidatorProof(td.RandValAddress())
+	assert.Error(t, err)
+}
+
+func TestStateProofConcurrently(t *testing.T) {
+	td := setup(t)
+
+	accAddr := td.genAccKey.PublicKeyNative().AccountAddress()
+	valAddr := td.genValKeys[0].Address()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, _, err := td.state.AccountProof(accAddr)
+			assert.NoError(t, err)
+			_, _, err = td.state.ValidatorProof(valAddr)
+			assert.NoError(t, err)
+		}()
+	}
+	td.commitBlocks(t, 2)
+	wg.Wait()
+}
+
+func TestSupplyInfo(t *testing.T) {
+	td := setup(t)
+
+	checkSupply := func(t *testing.T) SupplyInfo {
+		t.Helper()
+
+		info := td.state.SupplyInfo()
+
+		total := amount.Amount(0)
+		td.state.store.IterateAccounts(func(_ crypto.Address, acc *account.Account) bool {
+			total += acc.Balance()
+
+			return false
+		})
+		staked := amount.Amount(0)
+		unbonding := amount.Amount(0)
+		td.state.store.IterateValidators(func(val *validator.Validator) bool {
+			total += val.Stake()
+			if val.UnbondingHeight() > 0 {
+				unbonding += val.Stake()
+			} else {
+				staked += val.Stake() - val.UnbondingAmount()
+				unbonding += val.UnbondingAmount()
+			}
+
+			return false
+		})
+		treasury, err := td.state.store.Account(crypto.TreasuryAddress)
+		require.NoError(t, err)
+
+		assert.Equal(t, td.state.LastBlockHeight(), info.Height)
+		assert.Equal(t, total, info.TotalSupply)
+		assert.Equal(t, treasury.Balance(), info.TreasuryBalance)
+		assert.Equal(t, total-treasury.Balance(), info.CirculatingSupply)
+		assert.Equal(t, staked, info.TotalStaked)
+		assert.Equal(t, unbonding, info.UnbondingAmount)
+		assert.Equal(t, uint32(1), info.CountedFromHeight)
+		assert.True(t, info.CountersComplete)
+		assert.Equal(t, td.state.params.BlockReward*amount.Amount(info.Height), info.TotalRewards)
+
+		return info
+	}
+
+	info := checkSupply(t)
+	assert.Zero(t, info.TotalFees)
+	assert.Zero(t, info.UnbondingAmount)
+
+	// Activating the partial unbond payload from the block after the bond transaction.
+	genDoc := td.state.genDoc
+	td.state.genDoc = genesis.MakeGenesis(genDoc.GenesisTime(), genDoc.Accounts(),
+		genDoc.Validators(), genDoc.Params(), param.Upgrade{
+			Height:       td.state.LastBlockHeight() + 2,
+			BlockVersion: param.BlockVersionV2,
+		})
+
+	pub, prv := td.RandBLSKeyPair()
+	stake := amount.Amount(3e9)
+	bondTrx := tx.NewBondTx(td.state.LastBlockHeight(), td.genAccKey.PublicKeyNative().AccountAddress(),
+		pub.ValidatorAddress(), pub, stake, td.state.CalculateFee(stake, payload.TypeBond), "")
+	td.HelperSignTransaction(td.genAccKey, bondTrx)
+	require.NoError(t, td.state.AddPendingTx(bondTrx))
+	td.commitBlocks(t, 1)
+
+	info = checkSupply(t)
+	assert.Equal(t, bondTrx.Fee(), info.TotalFees)
+	assert.Zero(t, info.UnbondingAmount)
+
+	partialUnbondTrx := tx.NewPartialUnbondTx(td.state.LastBlockHeight(), pub.ValidatorAddress(), 1e9, "")
+	td.HelperSignTransaction(prv, partialUnbondTrx)
+	require.NoError(t, td.state.AddPendingTx(partialUnbondTrx))
+	td.commitBlocks(t, 1)
+
+	info = checkSupply(t)
+	assert.Equal(t, amount.Amount(1e9), info.UnbondingAmount)
+
+	unbondTrx := tx.NewUnbondTx(td.state.LastBlockHeight(), pub.ValidatorAddress(), "")
+	td.HelperSignTransaction(prv, unbondTrx)
+	require.NoError(t, td.state.AddPendingTx(unbondTrx))
+	td.commitBlocks(t, 1)
+
+	info = checkSupply(t)
+	assert.Equal(t, stake, info.UnbondingAmount)
+
+	// The supply is retrieved the same after loading the state.
+	newState, err := LoadOrNewState(td.state.genDoc, td.state.valKeys,
+		td.state.store, td.commonTxPool, nil)
+	require.NoError(t, err)
+	assert.Equal(t, info, newState.SupplyInfo())
+}
+
+func TestSupplyInfoIncompleteCounters(t *testing.T) {
+	info := SupplyInfo{}
+	info.setCounters(1e9, 2e9, 1)
+	assert.True(t, info.CountersComplete)
+	assert.Equal(t, amount.Amount(1e9), info.TotalFees)
+	assert.Equal(t, amount.Amount(2e9), info.TotalRewards)
+
+	// The fees and rewards of the pruned blocks are unknown.
+	info = SupplyInfo{}
+	info.setCounters(1e9, 2e9, 10)
+	assert.False(t, info.CountersComplete)
+	assert.Equal(t, uint32(10), info.CountedFromHeight)
+	assert.Zero(t, info.TotalFees)
+	assert.Zero(t, info.TotalRewards)
+}
+
+func TestValidatorRewards(t *testing.T) {
+	td := setup(t)
+
+	proposed := uint32(0)
+	for _, valKey := range td.genValKeys {
+		summary, rewards := td.state.ValidatorRewards(valKey.Address(), 0, 100)
+		assert.Len(t, rewards, int(summary.ProposedBlocks))
+		assert.Equal(t, td.state.params.BlockReward*amount.Amount(summary.ProposedBlocks), summary.Rewards)
+
+		for _, reward := range rewards {
+			cb := td.state.CommittedBlock(reward.Height)
+			blk, err := cb.ToBlock()
+			require.NoError(t, err)
+			assert.Equal(t, valKey.Address(), blk.Header().ProposerAddress())
+			assert.Equal(t, *blk.Transactions()[0].Payload().Receiver(), reward.RewardAddress)
+		}
+		proposed += summary.ProposedBlocks
+	}
+	assert.Equal(t, td.state.LastBlockHeight(), proposed)
+}
+
+func TestReadSnapshot(t *testing.T) {
+	td := setup(t)
+
+	snap := td.state.ReadSnapshot()
+	defer snap.Release()
+
+	lastHeight := td.state.LastBlockHeight()
+	lastHash := td.state.LastBlockHash()
+	lastCert := td.state.LastCertificate()
+	committeeVals := td.state.CommitteeValidators()
+	totalAccounts := td.state.TotalAccounts()
+
+	td.commitBlocks(t, 2)
+
+	t.Run("Snapshot doesn't change after committing blocks", func(t *testing.T) {
+		assert.Equal(t, lastHeight, snap.LastBlockHeight())
+		assert.Equal(t, lastHash, snap.LastBlockHash())
+		assert.Equal(t, lastCert, snap.LastCertificate())
+		assert.Equal(t, committeeVals, snap.CommitteeValidators())
+		assert.Equal(t, totalAccounts, snap.TotalAccounts())
+		assert.Equal(t, lastHash, snap.BlockHash(lastHeight))
+		assert.Equal(t, lastHeight, snap.BlockHeight(lastHash))
+		assert.NotNil(t, snap.CommittedBlock(lastHeight))
+		assert.Nil(t, snap.CommittedBlock(lastHeight+1))
+		assert.Equal(t, hash.UndefHash, snap.BlockHash(lastHeight+1))
+	})
+
+	t.Run("New snapshot has the last committed block", func(t *testing.T) {
+		newSnap := td.state.ReadSnapshot()
+		defer newSnap.Release()
+
+		assert.Equal(t, td.state.LastBlockHeight(), newSnap.LastBlockHeight())
+		assert.Equal(t, td.state.LastBlockHash(), newSnap.LastBlockHash())
+		assert.Equal(t, td.state.TotalPower(), newSnap.TotalPower())
+		assert.Equal(t, td.state.CommitteePower(), newSnap.CommitteePower())
+		assert.Equal(t, td.state.TotalAccounts(), newSnap.TotalAccounts())
+
+		cb := newSnap.CommittedBlock(newSnap.LastBlockHeight())
+		require.NotNil(t, cb)
+		blk, err := cb.ToBlock()
+		require.NoError(t, err)
+
+		subsidyTx := blk.Transactions()[0]
+		assert.NotNil(t, newSnap.CommittedTx(subsidyTx.ID()))
+		assert.Nil(t, snap.CommittedTx(subsidyTx.ID()))
+		assert.NotNil(t, newSnap.TxReceipt(subsidyTx.ID()))
+		assert.Equal(t, td.state.AccountByAddress(*subsidyTx.Payload().Receiver()),
+			newSnap.AccountByAddress(*subsidyTx.Payload().Receiver()))
+		assert.Nil(t, snap.AccountByAddress(*subsidyTx.Payload().Receiver()))
+
+		val := td.state.ValidatorByNumber(0)
+		assert.Equal(t, val, newSnap.ValidatorByNumber(0))
+		assert.Equal(t, val, newSnap.ValidatorByAddress(val.Address()))
+	})
+}
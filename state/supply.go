@@ -0,0 +1,109 @@
+package state
+
+import (
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/validator"
+)
+
+// SupplyInfo holds the information about the supply of coins at the last block.
+type SupplyInfo struct {
+	// Height is the height of the last block.
+	Height uint32
+	// TotalSupply is the total number of coins, including the treasury.
+	TotalSupply amount.Amount
+	// CirculatingSupply is the total supply, excluding the treasury.
+	CirculatingSupply amount.Amount
+	// TreasuryBalance is the balance of the treasury account.
+	TreasuryBalance amount.Amount
+	// TotalStaked is the stake of the bonded validators, excluding the partial unbondings.
+	TotalStaked amount.Amount
+	// UnbondingAmount is the stake of the unbonded validators and the partial unbondings
+	// that is not withdrawn yet.
+	UnbondingAmount amount.Amount
+	// TotalFees is the sum of the transaction fees since CountedFromHeight.
+	// It is zero if CountersComplete is false.
+	TotalFees amount.Amount
+	// TotalRewards is the sum of the block rewards since CountedFromHeight.
+	// It is zero if CountersComplete is false.
+	TotalRewards amount.Amount
+	// CountedFromHeight is the height of the first block that its fees and reward are counted.
+	// It is zero before committing any block, and greater than one
+	// when the node is started from a pruned store or a snapshot.
+	CountedFromHeight uint32
+	// CountersComplete indicates whether the fees and the rewards are counted from the genesis block.
+	// The counters of a node that is started from a pruned store or a snapshot are incomplete,
+	// and they are not reported.
+	CountersComplete bool
+}
+
+// setCounters sets the fees and the rewards only if they are counted from the genesis block.
+func (info *SupplyInfo) setCounters(fees, rewards amount.Amount, fromHeight uint32) {
+	info.CountedFromHeight = fromHeight
+	info.CountersComplete = fromHeight <= 1
+	if info.CountersComplete {
+		info.TotalFees = fees
+		info.TotalRewards = rewards
+	}
+}
+
+// unbondingStake returns the stake of the validator if it is unbonded,
+// otherwise the amount of its partial unbondings.
+func unbondingStake(val *validator.Validator) amount.Amount {
+	if val == nil {
+		return 0
+	}
+	if val.UnbondingHeight() == 0 {
+		return val.UnbondingAmount()
+	}
+
+	return val.Stake()
+}
+
+// bondedStake returns the stake of the validator that is not unbonded or partially unbonded.
+func bondedStake(val *validator.Validator) amount.Amount {
+	if val == nil || val.UnbondingHeight() != 0 {
+		return 0
+	}
+
+	return val.BondedStake()
+}
+
+// retrieveSupply calculates the treasury balance, the staked amount and the unbonding amount
+// from the store.
+// These values are updated incrementally after committing each block.
+func (st *state) retrieveSupply() {
+	st.totalStaked = 0
+	st.unbondingAmount = 0
+	st.store.IterateValidators(func(val *validator.Validator) bool {
+		st.totalStaked += bondedStake(val)
+		st.unbondingAmount += unbondingStake(val)
+
+		return false
+	})
+
+	st.treasuryBalance = 0
+	if acc, err := st.store.Account(crypto.TreasuryAddress); err == nil {
+		st.treasuryBalance = acc.Balance()
+	}
+}
+
+func (st *state) SupplyInfo() SupplyInfo {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	totalSupply := st.genDoc.TotalSupply()
+	counters := st.store.SupplyCounters()
+
+	info := SupplyInfo{
+		Height:            st.lastInfo.BlockHeight(),
+		TotalSupply:       totalSupply,
+		CirculatingSupply: totalSupply - st.treasuryBalance,
+		TreasuryBalance:   st.treasuryBalance,
+		TotalStaked:       st.totalStaked,
+		UnbondingAmount:   st.unbondingAmount,
+	}
+	info.setCounters(counters.Fees, counters.Rewards, counters.FromHeight)
+
+	return info
+}
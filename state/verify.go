@@ -0,0 +1,349 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/simplemerkle"
+)
+
+// Verify walks through the blocks in the given store and checks the integrity of the chain,
+// without executing the blocks. For each block, the link to the previous block,
+// the certificate, and the encoding and indexes of the block and its transactions are checked.
+// The certificates are checked against the public keys of the committers at that height.
+// The powers of the committers in the past are not stored, so only the signatures are checked.
+// At the end, the account and validator roots are calculated from the stored state,
+// and they are checked against the state root of the last block, using its undo record.
+// The pruned blocks are skipped.
+// The onBlock callback, if provided, is called after verifying each block.
+// If the chain is corrupted, a VerifyError is returned that holds the first bad height.
+func Verify(genDoc *genesis.Genesis, str store.Reader, onBlock func(height uint32)) error {
+	lastCert := str.LastCertificate()
+	if lastCert == nil {
+		return fmt.Errorf("store is empty")
+	}
+	lastHeight := lastCert.Height()
+	firstHeight := str.PruningHeight() + 1
+
+	// The previous keys of the validators are removed after the unbonding interval.
+	unbondInterval := genDoc.Params().UnbondInterval
+	for _, params := range genDoc.ParamsHistory() {
+		unbondInterval = min(unbondInterval, params.UnbondInterval)
+	}
+
+	// The first block after the pruned blocks can't be linked to its previous block.
+	prevHash := hash.UndefHash
+	blk, err := readVerifyBlock(str, firstHeight)
+	if err != nil {
+		return err
+	}
+	for height := firstHeight; height <= lastHeight; height++ {
+		if height == 1 || height > firstHeight {
+			if blk.Header().PrevBlockHash() != prevHash {
+				return VerifyError{
+					Height: height,
+					Reason: fmt.Sprintf("previous block hash mismatch, expected %s, got %s",
+						prevHash, blk.Header().PrevBlockHash()),
+				}
+			}
+		}
+
+		// The certificate of each block is stored in the next block.
+		var nextBlk *block.Block
+		var cert *certificate.Certificate
+		if height < lastHeight {
+			nextBlk, err = readVerifyBlock(str, height+1)
+			if err != nil {
+				return err
+			}
+			cert = nextBlk.PrevCertificate()
+			if cert == nil {
+				return VerifyError{Height: height, Reason: "no certificate"}
+			}
+		} else {
+			cert = lastCert
+		}
+
+		if err := verifyCertificate(str, height, blk, cert, unbondInterval); err != nil {
+			return err
+		}
+
+		if onBlock != nil {
+			onBlock(height)
+		}
+		prevHash = blk.Hash()
+		if nextBlk == nil {
+			break
+		}
+		blk = nextBlk
+	}
+
+	return verifyStoreState(str, lastHeight, blk)
+}
+
+// readVerifyBlock reads the block at the given height and checks
+// if the block and its transactions are properly indexed and encoded.
+func readVerifyBlock(str store.Reader, height uint32) (*block.Block, error) {
+	cb, err := str.Block(height)
+	if err != nil {
+		return nil, VerifyError{Height: height, Reason: fmt.Sprintf("unable to read block: %s", err)}
+	}
+
+	blk, err := cb.ToBlock()
+	if err != nil {
+		return nil, VerifyError{Height: height, Reason: fmt.Sprintf("unable to decode block: %s", err)}
+	}
+
+	if err := blk.BasicCheck(); err != nil {
+		return nil, VerifyError{Height: height, Reason: fmt.Sprintf("invalid block: %s", err)}
+	}
+
+	if blk.Hash() != cb.BlockHash {
+		return nil, VerifyError{Height: height, Reason: "block hash mismatch"}
+	}
+
+	if str.BlockHeight(blk.Hash()) != height {
+		return nil, VerifyError{Height: height, Reason: "block height index mismatch"}
+	}
+
+	for _, trx := range blk.Transactions() {
+		ctx, err := str.Transaction(trx.ID())
+		if err != nil {
+			return nil, VerifyError{
+				Height: height,
+				Reason: fmt.Sprintf("unable to read transaction %s: %s", trx.ID(), err),
+			}
+		}
+
+		if ctx.Height != height {
+			return nil, VerifyError{
+				Height: height,
+				Reason: fmt.Sprintf("transaction %s is indexed at height %d", trx.ID(), ctx.Height),
+			}
+		}
+
+		storedTrx, err := ctx.ToTx()
+		if err != nil {
+			return nil, VerifyError{
+				Height: height,
+				Reason: fmt.Sprintf("unable to decode transaction %s: %s", trx.ID(), err),
+			}
+		}
+
+		if storedTrx.ID() != trx.ID() {
+			return nil, VerifyError{
+				Height: height,
+				Reason: fmt.Sprintf("transaction %s is not encoded properly", trx.ID()),
+			}
+		}
+	}
+
+	return blk, nil
+}
+
+// verifyCertificate checks the signature of the certificate of the block
+// with the public keys that the committers had at the height of the block.
+func verifyCertificate(str store.Reader, height uint32, blk *block.Block,
+	cert *certificate.Certificate, unbondInterval uint32,
+) error {
+	if cert.Height() != height {
+		return VerifyError{
+			Height: height,
+			Reason: fmt.Sprintf("certificate height mismatch, got %d", cert.Height()),
+		}
+	}
+
+	if err := cert.BasicCheck(); err != nil {
+		return VerifyError{Height: height, Reason: fmt.Sprintf("invalid certificate: %s", err)}
+	}
+
+	pubs := make([]*bls.PublicKey, 0, len(cert.Committers()))
+	for _, num := range cert.Committers() {
+		val, err := str.ValidatorByNumber(num)
+		if err != nil {
+			return VerifyError{Height: height, Reason: fmt.Sprintf("unknown committer: %d", num)}
+		}
+		if util.Contains(cert.Absentees(), num) {
+			continue
+		}
+
+		pub, ok := publicKeyAtHeight(val, height, unbondInterval)
+		if !ok {
+			// The key of the committer at this height is removed, so the signature can't be checked.
+			return nil
+		}
+		pubs = append(pubs, pub)
+	}
+
+	signBytes := certificate.BlockCertificateSignBytes(blk.Hash(), height, cert.Round())
+	if err := bls.VerifyAggregated(cert.Signature(), pubs, signBytes); err != nil {
+		return VerifyError{Height: height, Reason: fmt.Sprintf("invalid certificate signature: %s", err)}
+	}
+
+	return nil
+}
+
+// publicKeyAtHeight returns the public key of the validator at the given height.
+// A rotated key is effective until the height of the rotation.
+// The keys that are rotated out before the unbonding interval of a later rotation are removed,
+// so the key at the heights before it is not known.
+func publicKeyAtHeight(val *validator.Validator, height, unbondInterval uint32) (*bls.PublicKey, bool) {
+	prevKeys := val.PreviousKeys()
+	if len(prevKeys) > 0 && height+unbondInterval < prevKeys[len(prevKeys)-1].Height {
+		return nil, false
+	}
+
+	for _, key := range prevKeys {
+		if height < key.Height {
+			return key.PublicKey, true
+		}
+	}
+
+	return val.PublicKey(), true
+}
+
+// verifyStoreState calculates the state root before the last block from the stored accounts and validators,
+// by reverting the changes of the last block, and compares it with the state root of the last block.
+// The stores that have no undo record for the last block, like the imported ones, are only checked
+// for the numbers of the accounts and validators.
+func verifyStoreState(str store.Reader, lastHeight uint32, lastBlk *block.Block) error {
+	accLeaves, valLeaves, err := storeStateLeaves(str, lastHeight)
+	if err != nil {
+		return err
+	}
+
+	prevAccs, prevVals, err := str.PreviousState(lastHeight)
+	if err != nil {
+		return nil
+	}
+
+	outOfRange := func(kind string, num int32) error {
+		return VerifyError{
+			Height: lastHeight,
+			Reason: fmt.Sprintf("%s number is out of range: %d", kind, num),
+		}
+	}
+
+	// The accounts that are created by the last block have the last numbers.
+	createdAccs := 0
+	for _, acc := range prevAccs {
+		if acc == nil {
+			createdAccs++
+
+			continue
+		}
+		if acc.Number() < 0 || int(acc.Number()) >= len(accLeaves) {
+			return outOfRange("account", acc.Number())
+		}
+		accLeaves[acc.Number()] = acc.Hash()
+	}
+
+	// A validator that has rotated its key is restored at its previous address.
+	restoredVals := make(map[int32]bool)
+	for _, val := range prevVals {
+		if val == nil {
+			continue
+		}
+		if val.Number() < 0 || int(val.Number()) >= len(valLeaves) {
+			return outOfRange("validator", val.Number())
+		}
+		valLeaves[val.Number()] = val.Hash()
+		restoredVals[val.Number()] = true
+	}
+	createdVals := 0
+	for addr, val := range prevVals {
+		if val != nil {
+			continue
+		}
+		curVal, err := str.Validator(addr)
+		if err != nil {
+			return VerifyError{Height: lastHeight, Reason: fmt.Sprintf("unknown validator: %s", addr)}
+		}
+		if !restoredVals[curVal.Number()] {
+			createdVals++
+		}
+	}
+
+	if createdAccs > len(accLeaves) || createdVals > len(valLeaves) {
+		return VerifyError{Height: lastHeight, Reason: "invalid undo record"}
+	}
+	accRoot := simplemerkle.NewTreeFromHashes(accLeaves[:len(accLeaves)-createdAccs]).Root()
+	valRoot := simplemerkle.NewTreeFromHashes(valLeaves[:len(valLeaves)-createdVals]).Root()
+	stateRoot := *simplemerkle.HashMerkleBranches(&accRoot, &valRoot)
+
+	if stateRoot != lastBlk.Header().StateRoot() {
+		return VerifyError{
+			Height: lastHeight,
+			Reason: fmt.Sprintf("stored state root mismatch, expected %s, got %s",
+				lastBlk.Header().StateRoot(), stateRoot),
+		}
+	}
+
+	return nil
+}
+
+// storeStateLeaves returns the hashes of the accounts and validators in the store, ordered by their numbers.
+func storeStateLeaves(str store.Reader, lastHeight uint32) ([]hash.Hash, []hash.Hash, error) {
+	// The store is locked during the iteration, so the totals are read beforehand.
+	accLeaves := make([]hash.Hash, str.TotalAccounts())
+	valLeaves := make([]hash.Hash, str.TotalValidators())
+
+	var err error
+	str.IterateAccounts(func(_ crypto.Address, acc *account.Account) bool {
+		if acc.Number() < 0 || int(acc.Number()) >= len(accLeaves) {
+			err = VerifyError{
+				Height: lastHeight,
+				Reason: fmt.Sprintf("account number is out of range: %d", acc.Number()),
+			}
+
+			return true
+		}
+		accLeaves[acc.Number()] = acc.Hash()
+
+		return false
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	str.IterateValidators(func(val *validator.Validator) bool {
+		if val.Number() < 0 || int(val.Number()) >= len(valLeaves) {
+			err = VerifyError{
+				Height: lastHeight,
+				Reason: fmt.Sprintf("validator number is out of range: %d", val.Number()),
+			}
+
+			return true
+		}
+		valLeaves[val.Number()] = val.Hash()
+
+		return false
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accLeaves, valLeaves, nil
+}
+
+// calcStoreStateRoot calculates the state root from the accounts and validators in the store.
+func calcStoreStateRoot(str store.Reader, lastHeight uint32) (hash.Hash, error) {
+	accLeaves, valLeaves, err := storeStateLeaves(str, lastHeight)
+	if err != nil {
+		return hash.UndefHash, err
+	}
+
+	accRoot := simplemerkle.NewTreeFromHashes(accLeaves).Root()
+	valRoot := simplemerkle.NewTreeFromHashes(valLeaves).Root()
+
+	return *simplemerkle.HashMerkleBranches(&accRoot, &valRoot), nil
+}
@@ -0,0 +1,101 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	t.Run("Valid chain", func(t *testing.T) {
+		td := setup(t)
+
+		verified := uint32(0)
+		err := Verify(td.state.genDoc, td.state.store, func(height uint32) {
+			verified = height
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, td.state.LastBlockHeight(), verified)
+	})
+
+	t.Run("Pruned store", func(t *testing.T) {
+		td := setup(t)
+
+		mockStore := td.state.store.(*store.MockStore)
+		mockStore.PrunedHeight = 2
+		delete(mockStore.Blocks, 1)
+		delete(mockStore.Blocks, 2)
+
+		firstVerified := uint32(0)
+		err := Verify(td.state.genDoc, mockStore, func(height uint32) {
+			if firstVerified == 0 {
+				firstVerified = height
+			}
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(3), firstVerified)
+	})
+
+	t.Run("Invalid previous block hash", func(t *testing.T) {
+		td := setup(t)
+
+		mockStore := td.state.store.(*store.MockStore)
+		blk := mockStore.Blocks[5]
+		header := blk.Header()
+		mockStore.Blocks[5] = block.MakeBlock(header.Version(), header.Time(), blk.Transactions(),
+			td.RandHash(), header.StateRoot(), blk.PrevCertificate(),
+			header.SortitionSeed(), header.ProposerAddress())
+
+		err := Verify(td.state.genDoc, mockStore, nil)
+		var verifyErr VerifyError
+		assert.ErrorAs(t, err, &verifyErr)
+		assert.Equal(t, uint32(5), verifyErr.Height)
+	})
+
+	t.Run("Modified block", func(t *testing.T) {
+		td := setup(t)
+
+		mockStore := td.state.store.(*store.MockStore)
+		blk := mockStore.Blocks[7]
+		header := blk.Header()
+		mockStore.Blocks[7] = block.MakeBlock(header.Version(), header.Time(), blk.Transactions(),
+			header.PrevBlockHash(), td.RandHash(), blk.PrevCertificate(),
+			header.SortitionSeed(), header.ProposerAddress())
+
+		err := Verify(td.state.genDoc, mockStore, nil)
+		var verifyErr VerifyError
+		assert.ErrorAs(t, err, &verifyErr)
+		assert.Equal(t, uint32(7), verifyErr.Height)
+	})
+
+	t.Run("Modified account", func(t *testing.T) {
+		td := setup(t)
+
+		// The accounts that are changed by the last block are checked by reverting them,
+		// so an account that is not changed by the last block is modified.
+		mockStore := td.state.store.(*store.MockStore)
+		prevAccs, _, err := mockStore.PreviousState(td.state.LastBlockHeight())
+		require.NoError(t, err)
+		var addr crypto.Address
+		for accAddr := range mockStore.Accounts {
+			if _, changed := prevAccs[accAddr]; !changed {
+				addr = accAddr
+
+				break
+			}
+		}
+		require.NotZero(t, addr)
+		acc, _ := mockStore.Account(addr)
+		acc.AddToBalance(1)
+		mockStore.Accounts[addr] = acc
+
+		err = Verify(td.state.genDoc, mockStore, nil)
+		var verifyErr VerifyError
+		assert.ErrorAs(t, err, &verifyErr)
+		assert.Equal(t, td.state.LastBlockHeight(), verifyErr.Height)
+	})
+}
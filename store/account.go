@@ -3,29 +3,27 @@ package store
 import (
 	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/store/kv"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/util/logger"
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 type accountStore struct {
-	db       *leveldb.DB
+	db       kv.DB
 	accCache *lru.Cache[crypto.Address, *account.Account]
 	total    int32
 }
 
 func accountKey(addr crypto.Address) []byte { return append(accountPrefix, addr.Bytes()...) }
 
-func newAccountStore(db *leveldb.DB, cacheSize int) *accountStore {
+func newAccountStore(db kv.DB, cacheSize int) *accountStore {
 	total := int32(0)
 	addrLruCache, err := lru.New[crypto.Address, *account.Account](cacheSize)
 	if err != nil {
 		logger.Panic("unable to create new instance of lru cache", "error", err)
 	}
 
-	r := util.BytesPrefix(accountPrefix)
-	iter := db.NewIterator(r, nil)
+	iter := db.NewIterator(accountPrefix)
 	for iter.Next() {
 		total++
 	}
@@ -69,8 +67,7 @@ func (as *accountStore) account(addr crypto.Address) (*account.Account, error) {
 }
 
 func (as *accountStore) iterateAccounts(consumer func(crypto.Address, *account.Account) (stop bool)) {
-	r := util.BytesPrefix(accountPrefix)
-	iter := as.db.NewIterator(r, nil)
+	iter := as.db.NewIterator(accountPrefix)
 	for iter.Next() {
 		key := iter.Key()
 		value := iter.Value()
@@ -94,7 +91,7 @@ func (as *accountStore) iterateAccounts(consumer func(crypto.Address, *account.A
 // This function takes ownership of the account pointer.
 // It is important that the caller should not modify the account data and
 // keep it immutable.
-func (as *accountStore) updateAccount(batch *leveldb.Batch, addr crypto.Address, acc *account.Account) {
+func (as *accountStore) updateAccount(batch kv.Batch, addr crypto.Address, acc *account.Account) {
 	data, err := acc.Bytes()
 	if err != nil {
 		logger.Panic("unable to encode account", "error", err)
@@ -106,3 +103,12 @@ func (as *accountStore) updateAccount(batch *leveldb.Batch, addr crypto.Address,
 
 	batch.Put(accountKey(addr), data)
 }
+
+func (as *accountStore) deleteAccount(batch kv.Batch, addr crypto.Address) {
+	if as.hasAccount(addr) {
+		as.total--
+	}
+	as.accCache.Remove(addr)
+
+	batch.Delete(accountKey(addr))
+}
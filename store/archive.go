@@ -0,0 +1,114 @@
+package store
+
+import (
+	"encoding/binary"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/store/kv"
+	"github.com/pactus-project/pactus/util"
+)
+
+// versionKey is: [prefix: 1 byte]+[address: 21 bytes]+[height: 4 bytes].
+// Height is encoded in big-endian order, so that the versions of an address
+// are sorted by height.
+func versionKey(prefix []byte, addr crypto.Address, height uint32) []byte {
+	key := make([]byte, 0, 1+crypto.AddressSize+4)
+	key = append(key, prefix...)
+	key = append(key, addr.Bytes()...)
+	key = binary.BigEndian.AppendUint32(key, height)
+
+	return key
+}
+
+// archiveStore keeps the versions of the accounts and validators in archival mode.
+// A new version is written for each account or validator that is updated in a block.
+// The version at a given height is the last version at or before that height.
+type archiveStore struct {
+	db         kv.DB
+	accounts   map[crypto.Address][]byte
+	validators map[crypto.Address][]byte
+}
+
+func newArchiveStore(db kv.DB) *archiveStore {
+	as := &archiveStore{
+		db: db,
+	}
+	as.reset()
+
+	return as
+}
+
+func (as *archiveStore) reset() {
+	as.accounts = make(map[crypto.Address][]byte)
+	as.validators = make(map[crypto.Address][]byte)
+}
+
+func (as *archiveStore) hasPending() bool {
+	return len(as.accounts) > 0 || len(as.validators) > 0
+}
+
+func (as *archiveStore) recordAccount(addr crypto.Address, data []byte) {
+	as.accounts[addr] = data
+}
+
+func (as *archiveStore) recordValidator(addr crypto.Address, data []byte) {
+	as.validators[addr] = data
+}
+
+// saveVersions writes the recorded accounts and validators as their versions at the given height.
+func (as *archiveStore) saveVersions(batch kv.Batch, height uint32) {
+	for addr, data := range as.accounts {
+		batch.Put(versionKey(accountVersionPrefix, addr, height), data)
+	}
+	for addr, data := range as.validators {
+		batch.Put(versionKey(validatorVersionPrefix, addr, height), data)
+	}
+	as.reset()
+}
+
+func (as *archiveStore) deleteVersions(batch kv.Batch, height uint32, rec *undoRecord) {
+	for _, entry := range rec.accounts {
+		batch.Delete(versionKey(accountVersionPrefix, entry.addr, height))
+	}
+	for _, entry := range rec.validators {
+		batch.Delete(versionKey(validatorVersionPrefix, entry.addr, height))
+	}
+}
+
+func (as *archiveStore) account(addr crypto.Address, height uint32) ([]byte, error) {
+	return as.version(accountVersionPrefix, addr, height)
+}
+
+func (as *archiveStore) validator(addr crypto.Address, height uint32) ([]byte, error) {
+	return as.version(validatorVersionPrefix, addr, height)
+}
+
+func (as *archiveStore) version(prefix []byte, addr crypto.Address, height uint32) ([]byte, error) {
+	iter := as.db.NewIterator(append(append([]byte{}, prefix...), addr.Bytes()...))
+	defer iter.Release()
+
+	// Find the last version at or before the height.
+	var found bool
+	if iter.Seek(versionKey(prefix, addr, height+1)) {
+		found = iter.Prev()
+	} else {
+		found = iter.Last()
+	}
+
+	// An empty version means the address was removed at that height, e.g. by rotating the validator's key.
+	if !found || len(iter.Value()) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return append([]byte{}, iter.Value()...), nil
+}
+
+// startHeight returns the height that the archived history starts from.
+func (as *archiveStore) startHeight() (uint32, bool) {
+	data, err := tryGet(as.db, archiveHeightKey)
+	if err != nil {
+		return 0, false
+	}
+
+	return util.SliceToUint32(data), true
+}
@@ -0,0 +1,152 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	conf := testConfig()
+	conf.Archival = true
+
+	str, err := NewStore(conf)
+	require.NoError(t, err)
+	s := str.(*store)
+
+	// Genesis state
+	acc0, addr0 := ts.GenerateTestAccount(0)
+	s.UpdateAccount(addr0, acc0)
+	val0, _ := ts.GenerateTestValidator(0)
+	s.UpdateValidator(val0)
+	require.NoError(t, s.WriteBatch())
+
+	rotatedVal := val0.Clone()
+	balances := map[uint32]amount.Amount{0: acc0.Balance()}
+	for height := uint32(1); height <= 6; height++ {
+		// The store takes the ownership of the updated account.
+		acc0 = acc0.Clone()
+		acc0.AddToBalance(1)
+		s.UpdateAccount(addr0, acc0)
+		balances[height] = acc0.Balance()
+
+		if height == 4 {
+			val0 = val0.Clone()
+			val0.AddToStake(1)
+			s.UpdateValidator(val0)
+		}
+		if height == 5 {
+			rotatedVal = val0.Clone()
+			newPub, _ := ts.RandBLSKeyPair()
+			rotatedVal.RotatePublicKey(newPub, height)
+			s.UpdateValidator(rotatedVal)
+		}
+
+		blk, cert := ts.GenerateTestBlock(height)
+		s.SaveBlock(blk, cert)
+		require.NoError(t, s.WriteBatch())
+	}
+
+	t.Run("Account at height", func(t *testing.T) {
+		for height, balance := range balances {
+			acc, err := s.AccountAtHeight(addr0, height)
+			require.NoError(t, err)
+			assert.Equal(t, balance, acc.Balance(), "height %d", height)
+		}
+
+		_, err := s.AccountAtHeight(ts.RandAccAddress(), 3)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		_, err = s.AccountAtHeight(addr0, 7)
+		assert.ErrorIs(t, err, ArchivedHeightError{Height: 7, StartHeight: 0, LastHeight: 6})
+	})
+
+	t.Run("Validator at height", func(t *testing.T) {
+		val, err := s.ValidatorAtHeight(val0.Address(), 3)
+		require.NoError(t, err)
+		assert.Equal(t, val0.Stake()-1, val.Stake())
+
+		val, err = s.ValidatorAtHeight(val0.Address(), 4)
+		require.NoError(t, err)
+		assert.Equal(t, val0.Stake(), val.Stake())
+	})
+
+	t.Run("Rotated validator at height", func(t *testing.T) {
+		val, err := s.ValidatorAtHeight(val0.Address(), 4)
+		require.NoError(t, err)
+		assert.Equal(t, val0.Hash(), val.Hash())
+
+		_, err = s.ValidatorAtHeight(val0.Address(), 5)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		_, err = s.ValidatorAtHeight(rotatedVal.Address(), 4)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		val, err = s.ValidatorAtHeight(rotatedVal.Address(), 6)
+		require.NoError(t, err)
+		assert.Equal(t, rotatedVal.Hash(), val.Hash())
+	})
+
+	t.Run("Rollback removes the versions", func(t *testing.T) {
+		require.NoError(t, s.Close())
+		_, err := Rollback(conf, 3)
+		require.NoError(t, err)
+
+		str, err := NewStore(conf)
+		require.NoError(t, err)
+		s = str.(*store)
+
+		acc, err := s.Account(addr0)
+		require.NoError(t, err)
+		assert.Equal(t, balances[3], acc.Balance())
+
+		acc, err = s.AccountAtHeight(addr0, 3)
+		require.NoError(t, err)
+		assert.Equal(t, balances[3], acc.Balance())
+
+		val, err := s.ValidatorAtHeight(val0.Address(), 3)
+		require.NoError(t, err)
+		assert.Equal(t, val0.Stake()-1, val.Stake())
+
+		_, err = s.AccountAtHeight(addr0, 4)
+		assert.ErrorIs(t, err, ArchivedHeightError{Height: 4, StartHeight: 0, LastHeight: 3})
+	})
+
+	t.Run("Disable and enable archival mode", func(t *testing.T) {
+		require.NoError(t, s.Close())
+
+		conf.Archival = false
+		str, err := NewStore(conf)
+		require.NoError(t, err)
+		s = str.(*store)
+
+		_, err = s.AccountAtHeight(addr0, 3)
+		assert.ErrorIs(t, err, ErrNotArchival)
+
+		blk, cert := ts.GenerateTestBlock(4)
+		s.SaveBlock(blk, cert)
+		require.NoError(t, s.WriteBatch())
+		require.NoError(t, s.Close())
+
+		// The history restarts from the current height.
+		conf.Archival = true
+		str, err = NewStore(conf)
+		require.NoError(t, err)
+		s = str.(*store)
+
+		_, err = s.AccountAtHeight(addr0, 3)
+		assert.ErrorIs(t, err, ArchivedHeightError{Height: 3, StartHeight: 4, LastHeight: 4})
+
+		acc, err := s.AccountAtHeight(addr0, 4)
+		require.NoError(t, err)
+		assert.Equal(t, balances[3], acc.Balance())
+		require.NoError(t, s.Close())
+
+		_, err = Rollback(conf, 3)
+		assert.ErrorIs(t, err, RollbackError{Reason: "archived state is not available before height 4"})
+	})
+}
@@ -8,12 +8,12 @@ import (
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/sortition"
+	"github.com/pactus-project/pactus/store/kv"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/encoding"
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/util/pairslice"
-	"github.com/syndtr/goleveldb/leveldb"
 )
 
 func blockKey(height uint32) []byte { return append(blockPrefix, util.Uint32ToSlice(height)...) }
@@ -26,13 +26,13 @@ func blockHashKey(h hash.Hash) []byte {
 }
 
 type blockStore struct {
-	db                 *leveldb.DB
+	db                 kv.DB
 	pubKeyCache        *lru.Cache[crypto.Address, *bls.PublicKey]
 	sortitionSeedCache *pairslice.PairSlice[uint32, *sortition.VerifiableSeed]
 	sortitionCacheSize uint32
 }
 
-func newBlockStore(db *leveldb.DB, sortitionCacheSize uint32, publicKeyCacheSize int) *blockStore {
+func newBlockStore(db kv.DB, sortitionCacheSize uint32, publicKeyCacheSize int) *blockStore {
 	pubKeyCache, err := lru.New[crypto.Address, *bls.PublicKey](publicKeyCacheSize)
 	if err != nil {
 		return nil
@@ -46,12 +46,7 @@ func newBlockStore(db *leveldb.DB, sortitionCacheSize uint32, publicKeyCacheSize
 	}
 }
 
-func (bs *blockStore) saveBlock(batch *leveldb.Batch, height uint32, blk *block.Block) []blockRegion {
-	if height > 1 {
-		if !bs.hasBlock(height - 1) {
-			logger.Panic("previous block not found", "height", height)
-		}
-	}
+func (bs *blockStore) saveBlock(batch kv.Batch, height uint32, blk *block.Block) []blockRegion {
 	if bs.hasBlock(height) {
 		logger.Panic("duplicated block", "height", height)
 	}
@@ -82,8 +77,9 @@ func (bs *blockStore) saveBlock(batch *leveldb.Batch, height uint32, blk *block.
 		regs[i].height = height
 		regs[i].offset = uint32(offset)
 
+		// Multisig public keys are not indexed, so they are never stripped.
 		pubKey := trx.PublicKey()
-		if pubKey != nil {
+		if _, ok := pubKey.(*bls.PublicKey); ok {
 			if !bs.hasPublicKey(trx.Payload().Signer()) {
 				publicKeyKey := publicKeyKey(trx.Payload().Signer())
 				batch.Put(publicKeyKey, pubKey.Bytes())
@@ -113,6 +109,23 @@ func (bs *blockStore) saveBlock(batch *leveldb.Batch, height uint32, blk *block.
 	return regs
 }
 
+func (bs *blockStore) deleteBlock(batch kv.Batch, height uint32, blockHash hash.Hash) {
+	batch.Delete(blockKey(height))
+	batch.Delete(blockHashKey(blockHash))
+}
+
+// deletePublicKeys removes the public keys that are registered by the given block.
+// The registered public keys are not stripped from the stored transactions.
+func (bs *blockStore) deletePublicKeys(batch kv.Batch, blk *block.Block) {
+	for _, trx := range blk.Transactions() {
+		if _, ok := trx.PublicKey().(*bls.PublicKey); ok {
+			signer := trx.Payload().Signer()
+			bs.pubKeyCache.Remove(signer)
+			batch.Delete(publicKeyKey(signer))
+		}
+	}
+}
+
 func (bs *blockStore) block(height uint32) ([]byte, error) {
 	data, err := tryGet(bs.db, blockKey(height))
 	if err != nil {
@@ -1,24 +1,42 @@
 package store
 
 import (
+	"fmt"
 	"path/filepath"
 
 	"github.com/pactus-project/pactus/util"
 )
 
+const (
+	EngineLevelDB = "leveldb"
+	EnginePebble  = "pebble"
+	EngineMemory  = "memory"
+)
+
 type Config struct {
-	Path string `toml:"path"`
+	Path   string `toml:"path"`
+	Engine string `toml:"engine"`
+
+	// RetentionBlocks is the number of recent blocks to keep.
+	// Older blocks are pruned. Zero means keeping all the blocks.
+	RetentionBlocks uint32 `toml:"retention_blocks"`
+
+	// Archival keeps the history of the accounts and validators,
+	// so their state can be queried at any height.
+	Archival bool `toml:"archival"`
 
 	// Private configs
 	TxCacheSize        uint32 `toml:"-"`
 	SortitionCacheSize uint32 `toml:"-"`
 	AccountCacheSize   int    `toml:"-"`
 	PublicKeyCacheSize int    `toml:"-"`
+	ReadOnly           bool   `toml:"-"`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
 		Path:               "data",
+		Engine:             EngineLevelDB,
 		TxCacheSize:        1024,
 		SortitionCacheSize: 1024,
 		AccountCacheSize:   1024,
@@ -26,6 +44,11 @@ func DefaultConfig() *Config {
 	}
 }
 
+// IsPruned returns true if the node is configured to prune the old blocks.
+func (conf *Config) IsPruned() bool {
+	return conf.RetentionBlocks > 0
+}
+
 func (conf *Config) DataPath() string {
 	return util.MakeAbs(conf.Path)
 }
@@ -42,6 +65,12 @@ func (conf *Config) BasicCheck() error {
 		}
 	}
 
+	if conf.Engine != EngineLevelDB && conf.Engine != EnginePebble && conf.Engine != EngineMemory {
+		return ConfigError{
+			Reason: fmt.Sprintf("unknown storage engine: %s", conf.Engine),
+		}
+	}
+
 	if conf.TxCacheSize == 0 ||
 		conf.SortitionCacheSize == 0 ||
 		conf.AccountCacheSize == 0 ||
@@ -51,5 +80,21 @@ func (conf *Config) BasicCheck() error {
 		}
 	}
 
+	// The recent blocks are needed to restore the caches on startup.
+	if conf.IsPruned() &&
+		(conf.RetentionBlocks <= conf.TxCacheSize ||
+			conf.RetentionBlocks <= conf.SortitionCacheSize) {
+		return ConfigError{
+			Reason: fmt.Sprintf("retention blocks should be greater than %d",
+				max(conf.TxCacheSize, conf.SortitionCacheSize)),
+		}
+	}
+
+	if conf.IsPruned() && conf.Archival {
+		return ConfigError{
+			Reason: "archival mode is not compatible with pruning",
+		}
+	}
+
 	return nil
 }
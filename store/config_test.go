@@ -11,14 +11,39 @@ import (
 func TestDefaultConfigCheck(t *testing.T) {
 	conf := DefaultConfig()
 
-	conf.TxCacheSize = 0
+	conf.Engine = "foo"
 	err := conf.BasicCheck()
+	assert.ErrorIs(t, ConfigError{"unknown storage engine: foo"}, err)
+
+	conf.Engine = EnginePebble
+	assert.NoError(t, conf.BasicCheck())
+
+	conf.Engine = EngineMemory
+	conf.TxCacheSize = 0
+	err = conf.BasicCheck()
 	assert.ErrorIs(t, ConfigError{"cache size set to zero"}, err)
 
 	conf.TxCacheSize = 1
 	err = conf.BasicCheck()
 	assert.NoError(t, err)
 
+	conf.RetentionBlocks = 1024
+	err = conf.BasicCheck()
+	assert.ErrorIs(t, ConfigError{"retention blocks should be greater than 1024"}, err)
+
+	conf.RetentionBlocks = 1025
+	err = conf.BasicCheck()
+	assert.NoError(t, err)
+	assert.True(t, conf.IsPruned())
+
+	conf.Archival = true
+	err = conf.BasicCheck()
+	assert.ErrorIs(t, ConfigError{"archival mode is not compatible with pruning"}, err)
+
+	conf.RetentionBlocks = 0
+	err = conf.BasicCheck()
+	assert.NoError(t, err)
+
 	conf.Path = util.TempDirPath()
 	assert.NoError(t, conf.BasicCheck())
 
@@ -25,3 +25,66 @@ func (e PublicKeyNotFoundError) Error() string {
 	return fmt.Sprintf("public key not found for: %s",
 		e.Address.String())
 }
+
+// SnapshotError is returned when the snapshot is invalid or can't be imported.
+type SnapshotError struct {
+	Reason string
+}
+
+func (e SnapshotError) Error() string {
+	return e.Reason
+}
+
+// RollbackError is returned when the store can't be rolled back to the given height.
+type RollbackError struct {
+	Reason string
+}
+
+func (e RollbackError) Error() string {
+	return e.Reason
+}
+
+// ForkError is returned when a detected fork can't be resolved.
+type ForkError struct {
+	Reason string
+}
+
+func (e ForkError) Error() string {
+	return e.Reason
+}
+
+// UnsupportedVersionError is returned when the store is created by a newer version of the node.
+type UnsupportedVersionError struct {
+	Version int32
+}
+
+func (e UnsupportedVersionError) Error() string {
+	return fmt.Sprintf("store version %d is not supported, the latest supported version is %d",
+		e.Version, lastStoreVersion)
+}
+
+// ArchivedHeightError is returned when the state at the given height is not archived.
+type ArchivedHeightError struct {
+	Height      uint32
+	StartHeight uint32
+	LastHeight  uint32
+}
+
+func (e ArchivedHeightError) Error() string {
+	return fmt.Sprintf("state at height %d is not archived, archived heights are from %d to %d",
+		e.Height, e.StartHeight, e.LastHeight)
+}
+
+// AvailabilityRangeError is returned when the participation counters
+// are not recorded for the given range of heights.
+type AvailabilityRangeError struct {
+	From        uint32
+	To          uint32
+	StartHeight uint32
+	LastHeight  uint32
+}
+
+func (e AvailabilityRangeError) Error() string {
+	return fmt.Sprintf("availability is not recorded for heights %d to %d, recorded heights are from %d to %d",
+		e.From, e.To, e.StartHeight, e.LastHeight)
+}
@@ -0,0 +1,110 @@
+package store
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/pactus-project/pactus/util"
+)
+
+// ForkBranch is the branch that the operator chooses to resolve a fork.
+type ForkBranch string
+
+const (
+	// ForkBranchLocal keeps the blocks of this node.
+	ForkBranchLocal = ForkBranch("local")
+	// ForkBranchRemote reverts the last block of this node,
+	// so the node can sync with the branch of the network.
+	ForkBranchRemote = ForkBranch("remote")
+)
+
+// ForkEvidence keeps the competing blocks and certificates of a detected fork.
+// The local block is the last block of this node, and the remote block is
+// a certified block at the next height that doesn't extend the local block.
+// Blocks and certificates are hex encoded.
+type ForkEvidence struct {
+	Height            uint32 `json:"height"`
+	LocalBlockHash    string `json:"local_block_hash"`
+	LocalBlock        string `json:"local_block"`
+	LocalCertificate  string `json:"local_certificate"`
+	RemotePrevHash    string `json:"remote_prev_block_hash"`
+	RemoteBlock       string `json:"remote_block"`
+	RemoteCertificate string `json:"remote_certificate"`
+}
+
+// ForkEvidencePath returns the path of the fork evidence file.
+func (conf *Config) ForkEvidencePath() string {
+	return filepath.Join(conf.DataPath(), "fork_evidence.json")
+}
+
+// LoadForkEvidence loads the fork evidence, or returns nil if there is no evidence.
+func LoadForkEvidence(conf *Config) (*ForkEvidence, error) {
+	path := conf.ForkEvidencePath()
+	if !util.PathExists(path) {
+		return nil, nil
+	}
+
+	data, err := util.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	ev := new(ForkEvidence)
+	if err := json.Unmarshal(data, ev); err != nil {
+		return nil, err
+	}
+
+	return ev, nil
+}
+
+// ResolveFork resolves a detected fork by keeping the given branch and removing the evidence.
+// Choosing the remote branch reverts the local block at the fork height.
+// If the branches diverge before that, the fork is detected again at a lower height
+// while syncing, and it should be resolved again.
+func ResolveFork(conf *Config, branch ForkBranch) (*ForkEvidence, error) {
+	ev, err := LoadForkEvidence(conf)
+	if err != nil {
+		return nil, err
+	}
+	if ev == nil {
+		return nil, ForkError{
+			Reason: "no fork is detected",
+		}
+	}
+
+	switch branch {
+	case ForkBranchLocal:
+	case ForkBranchRemote:
+		if _, err := Rollback(conf, ev.Height-1); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ForkError{
+			Reason: "unknown branch: " + string(branch),
+		}
+	}
+
+	if err := os.Remove(conf.ForkEvidencePath()); err != nil {
+		return nil, err
+	}
+
+	return ev, nil
+}
+
+// SaveForkEvidence writes the fork evidence into the evidence file.
+func (s *store) SaveForkEvidence(ev *ForkEvidence) error {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	data, err := json.MarshalIndent(ev, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return util.WriteFile(s.config.ForkEvidencePath(), data)
+}
+
+// ForkEvidence returns the evidence of a detected fork, or nil if there is no evidence.
+func (s *store) ForkEvidence() (*ForkEvidence, error) {
+	return LoadForkEvidence(s.config)
+}
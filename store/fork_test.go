@@ -0,0 +1,76 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkEvidence(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	conf := testConfig()
+
+	str, err := NewStore(conf)
+	require.NoError(t, err)
+
+	for height := uint32(1); height <= 3; height++ {
+		acc, addr := ts.GenerateTestAccount(int32(height))
+		str.UpdateAccount(addr, acc)
+
+		blk, cert := ts.GenerateTestBlock(height)
+		str.SaveBlock(blk, cert)
+		require.NoError(t, str.WriteBatch())
+	}
+
+	ev, err := str.ForkEvidence()
+	require.NoError(t, err)
+	assert.Nil(t, ev)
+
+	_, err = ResolveFork(conf, ForkBranchLocal)
+	assert.ErrorIs(t, err, ForkError{Reason: "no fork is detected"})
+
+	evidence := &ForkEvidence{
+		Height:         3,
+		LocalBlockHash: ts.RandHash().String(),
+		RemotePrevHash: ts.RandHash().String(),
+	}
+	require.NoError(t, str.SaveForkEvidence(evidence))
+
+	ev, err = str.ForkEvidence()
+	require.NoError(t, err)
+	assert.Equal(t, evidence, ev)
+	require.NoError(t, str.Close())
+
+	t.Run("Unknown branch", func(t *testing.T) {
+		_, err := ResolveFork(conf, ForkBranch("unknown"))
+		assert.ErrorIs(t, err, ForkError{Reason: "unknown branch: unknown"})
+		assert.True(t, util.PathExists(conf.ForkEvidencePath()))
+	})
+
+	t.Run("Resolve the fork", func(t *testing.T) {
+		ev, err := ResolveFork(conf, ForkBranchRemote)
+		require.NoError(t, err)
+		assert.Equal(t, evidence, ev)
+		assert.False(t, util.PathExists(conf.ForkEvidencePath()))
+
+		str, err := NewStore(conf)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), str.LastCertificate().Height())
+
+		// Keeping the local branch only removes the evidence.
+		require.NoError(t, str.SaveForkEvidence(evidence))
+		require.NoError(t, str.Close())
+
+		_, err = ResolveFork(conf, ForkBranchLocal)
+		require.NoError(t, err)
+		assert.False(t, util.PathExists(conf.ForkEvidencePath()))
+
+		str, err = NewStore(conf)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), str.LastCertificate().Height())
+		require.NoError(t, str.Close())
+	})
+}
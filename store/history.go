@@ -0,0 +1,113 @@
+package store
+
+import (
+	"encoding/binary"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/store/kv"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// historyKey is: [prefix: 1 byte]+[address: 21 bytes]+[height: 4 bytes]+[index: 2 bytes].
+// Height and index are encoded in big-endian order, so that the iterator
+// returns the entries of an address sorted by height.
+func historyKey(addr crypto.Address, height uint32, index uint16) []byte {
+	key := make([]byte, 0, 1+crypto.AddressSize+4+2)
+	key = append(key, historyPrefix...)
+	key = append(key, addr.Bytes()...)
+	key = binary.BigEndian.AppendUint32(key, height)
+	key = binary.BigEndian.AppendUint16(key, index)
+
+	return key
+}
+
+func historyAddressPrefix(addr crypto.Address) []byte {
+	return append(historyPrefix, addr.Bytes()...)
+}
+
+type historyStore struct {
+	db kv.DB
+}
+
+func newHistoryStore(db kv.DB) *historyStore {
+	return &historyStore{
+		db: db,
+	}
+}
+
+// indexTxs adds the transactions of a block to the address history index.
+// Both the signer and the receivers of a transaction are indexed.
+func (hs *historyStore) indexTxs(batch kv.Batch, height uint32, txs block.Txs) {
+	for i, trx := range txs {
+		id := trx.ID()
+		index := uint16(i)
+		pld := trx.Payload()
+
+		// The Treasury address signs every subsidy transaction,
+		// indexing it only bloats the database.
+		if !trx.IsSubsidyTx() {
+			batch.Put(historyKey(pld.Signer(), height, index), id.Bytes())
+		}
+
+		for _, receiver := range payload.Receivers(pld) {
+			if receiver != pld.Signer() {
+				batch.Put(historyKey(receiver, height, index), id.Bytes())
+			}
+		}
+	}
+
+	batch.Put(historyHeightKey, util.Uint32ToSlice(height))
+}
+
+// deleteTxs removes the transactions of a pruned block from the address history index.
+func (hs *historyStore) deleteTxs(batch kv.Batch, height uint32, txs block.Txs) {
+	for i, trx := range txs {
+		index := uint16(i)
+		pld := trx.Payload()
+
+		batch.Delete(historyKey(pld.Signer(), height, index))
+		for _, receiver := range payload.Receivers(pld) {
+			batch.Delete(historyKey(receiver, height, index))
+		}
+	}
+}
+
+// indexedHeight returns the height of the last block that has been indexed.
+func (hs *historyStore) indexedHeight() (uint32, bool) {
+	data, err := tryGet(hs.db, historyHeightKey)
+	if err != nil {
+		return 0, false
+	}
+
+	return util.SliceToUint32(data), true
+}
+
+// transactionIDs returns the IDs of the transactions related to the given address,
+// starting from the most recent one.
+// It skips the first `offset` transactions and returns up to `limit` IDs.
+func (hs *historyStore) transactionIDs(addr crypto.Address, offset, limit int) []tx.ID {
+	ids := make([]tx.ID, 0, limit)
+	iter := hs.db.NewIterator(historyAddressPrefix(addr))
+	defer iter.Release()
+
+	for ok := iter.Last(); ok && len(ids) < limit; ok = iter.Prev() {
+		if offset > 0 {
+			offset--
+
+			continue
+		}
+
+		id, err := hash.FromBytes(iter.Value())
+		if err != nil {
+			logger.Panic("unable to decode transaction id", "error", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids
+}
@@ -0,0 +1,108 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressTransactions(t *testing.T) {
+	td := setup(t, nil)
+
+	pub, _ := td.RandBLSKeyPair()
+	sender := pub.AccountAddress()
+	receiver := td.RandAccAddress()
+
+	lastCert := td.store.LastCertificate()
+	ids := make([]tx.ID, 0, 5)
+	for i := uint32(1); i <= 5; i++ {
+		height := lastCert.Height() + i
+		trx := tx.NewTransferTx(height, sender, receiver, 1, 1, "")
+		trx.SetPublicKey(pub)
+		trx.SetSignature(td.RandBLSSignature())
+		ids = append(ids, trx.ID())
+
+		blk := block.MakeBlock(1, util.Now(), block.Txs{trx}, td.RandHash(), td.RandHash(),
+			td.GenerateTestCertificate(height-1), td.RandSeed(), td.RandValAddress())
+		td.store.SaveBlock(blk, td.GenerateTestCertificate(height))
+		require.NoError(t, td.store.WriteBatch())
+	}
+
+	t.Run("Unknown address", func(t *testing.T) {
+		txs, err := td.store.AddressTransactions(td.RandAccAddress(), 0, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, txs)
+	})
+
+	t.Run("Most recent transactions first", func(t *testing.T) {
+		txs, err := td.store.AddressTransactions(sender, 0, 10)
+		assert.NoError(t, err)
+		require.Len(t, txs, 5)
+		for i, committedTx := range txs {
+			assert.Equal(t, ids[4-i], committedTx.TxID)
+			assert.Equal(t, lastCert.Height()+uint32(5-i), committedTx.Height)
+		}
+	})
+
+	t.Run("Receiver is indexed", func(t *testing.T) {
+		txs, err := td.store.AddressTransactions(receiver, 0, 10)
+		assert.NoError(t, err)
+		assert.Len(t, txs, 5)
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		txs, err := td.store.AddressTransactions(sender, 1, 2)
+		assert.NoError(t, err)
+		require.Len(t, txs, 2)
+		assert.Equal(t, ids[3], txs[0].TxID)
+		assert.Equal(t, ids[2], txs[1].TxID)
+
+		txs, err = td.store.AddressTransactions(sender, 5, 2)
+		assert.NoError(t, err)
+		assert.Empty(t, txs)
+	})
+}
+
+func TestIndexHistoryOnOpen(t *testing.T) {
+	conf := testConfig()
+	td := setup(t, conf)
+
+	lastHeight := td.store.LastCertificate().Height()
+	committedBlock, _ := td.store.Block(lastHeight)
+	blk, _ := committedBlock.ToBlock()
+	trx := blk.Transactions()[0]
+	signer := trx.Payload().Signer()
+
+	// Remove the history index, similar to a database created by an older version.
+	batch := td.store.db.NewBatch()
+	iter := td.store.db.NewIterator(historyPrefix)
+	for iter.Next() {
+		batch.Delete(iter.Key())
+	}
+	iter.Release()
+	batch.Delete(historyHeightKey)
+	require.NoError(t, td.store.db.Write(batch))
+	td.store.saveLastInfo(1, td.store.LastCertificate())
+	require.NoError(t, td.store.WriteBatch())
+
+	txs, err := td.store.AddressTransactions(signer, 0, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, txs)
+	require.NoError(t, td.store.Close())
+
+	s, err := NewStore(conf)
+	require.NoError(t, err)
+
+	indexedHeight, ok := s.(*store).historyStore.indexedHeight()
+	assert.True(t, ok)
+	assert.Equal(t, lastHeight, indexedHeight)
+
+	txs, err = s.AddressTransactions(signer, 0, 10)
+	assert.NoError(t, err)
+	require.Len(t, txs, 1)
+	assert.Equal(t, trx.ID(), txs[0].TxID)
+}
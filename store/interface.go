@@ -8,6 +8,7 @@ import (
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/validator"
 )
@@ -19,8 +20,14 @@ import (
 
 // TODO: How to undo or rollback at least for last 21 blocks
 
+// publicKeyReader retrieves the public keys of the signers
+// that are striped from the committed transactions.
+type publicKeyReader interface {
+	PublicKey(addr crypto.Address) (*bls.PublicKey, error)
+}
+
 type CommittedBlock struct {
-	store *store
+	store publicKeyReader
 
 	BlockHash hash.Hash
 	Height    uint32
@@ -51,7 +58,7 @@ func (s *CommittedBlock) ToBlock() (*block.Block, error) {
 }
 
 type CommittedTx struct {
-	store *store
+	store publicKeyReader
 
 	TxID      tx.ID
 	Height    uint32
@@ -78,25 +85,52 @@ func (s *CommittedTx) ToTx() (*tx.Tx, error) {
 	return trx, nil
 }
 
+// SandboxReader is the part of the store that is read while executing transactions.
+// Both the store and its read snapshots implement it.
+type SandboxReader interface {
+	SortitionSeed(blockHeight uint32) *sortition.VerifiableSeed
+	AnyRecentTransaction(id tx.ID) bool
+	HasAccount(crypto.Address) bool
+	Account(addr crypto.Address) (*account.Account, error)
+	TotalAccounts() int32
+	HasValidator(addr crypto.Address) bool
+	Validator(addr crypto.Address) (*validator.Validator, error)
+	ValidatorByPreviousKey(addr crypto.Address) (*validator.Validator, error)
+	TotalValidators() int32
+}
+
 type Reader interface {
 	Block(height uint32) (*CommittedBlock, error)
 	BlockHeight(h hash.Hash) uint32
 	BlockHash(height uint32) hash.Hash
 	SortitionSeed(blockHeight uint32) *sortition.VerifiableSeed
 	Transaction(id tx.ID) (*CommittedTx, error)
+	Receipt(id tx.ID) (*receipt.Receipt, error)
 	AnyRecentTransaction(id tx.ID) bool
+	AddressTransactions(addr crypto.Address, offset, limit int) ([]*CommittedTx, error)
+	IsPruned() bool
+	PruningHeight() uint32
 	PublicKey(addr crypto.Address) (*bls.PublicKey, error)
 	HasAccount(crypto.Address) bool
 	Account(addr crypto.Address) (*account.Account, error)
+	AccountAtHeight(addr crypto.Address, height uint32) (*account.Account, error)
 	TotalAccounts() int32
 	HasValidator(addr crypto.Address) bool
 	ValidatorAddresses() []crypto.Address
 	Validator(addr crypto.Address) (*validator.Validator, error)
+	ValidatorAtHeight(addr crypto.Address, height uint32) (*validator.Validator, error)
 	ValidatorByNumber(num int32) (*validator.Validator, error)
+	ValidatorByPreviousKey(addr crypto.Address) (*validator.Validator, error)
+	Availability(valNum int32, from, to uint32) (Availability, error)
+	AvailabilityRange() (from, to uint32, ok bool)
+	SupplyCounters() SupplyCounters
+	ValidatorRewards(addr crypto.Address, offset, limit int) (RewardSummary, []ValidatorReward)
+	PreviousState(height uint32) (map[crypto.Address]*account.Account, map[crypto.Address]*validator.Validator, error)
 	IterateValidators(consumer func(*validator.Validator) (stop bool))
 	IterateAccounts(consumer func(crypto.Address, *account.Account) (stop bool))
 	TotalValidators() int32
 	LastCertificate() *certificate.Certificate
+	ForkEvidence() (*ForkEvidence, error)
 }
 
 type Store interface {
@@ -105,6 +139,11 @@ type Store interface {
 	UpdateAccount(addr crypto.Address, acc *account.Account)
 	UpdateValidator(val *validator.Validator)
 	SaveBlock(blk *block.Block, cert *certificate.Certificate)
+	SaveReceipt(id tx.ID, rcpt *receipt.Receipt)
+	SaveForkEvidence(ev *ForkEvidence) error
+	// NewReadSnapshot takes a read-only snapshot of the written data.
+	// It fails if there are changes that are not written yet.
+	NewReadSnapshot() (ReadSnapshot, error)
 	WriteBatch() error
 	Close() error
 }
@@ -0,0 +1,64 @@
+// Package kv defines the key-value database that the store is built on,
+// alongside its implementations.
+package kv
+
+import "errors"
+
+var (
+	ErrNotFound = errors.New("not found")
+	ErrClosed   = errors.New("database is closed")
+)
+
+// Iterator iterates over the key-value pairs of a database in key order.
+// The key and value slices are only valid until the next move of the iterator.
+type Iterator interface {
+	First() bool
+	Last() bool
+	Next() bool
+	Prev() bool
+	// Seek moves the iterator to the first key that is greater than or equal to the given key.
+	Seek(key []byte) bool
+	Key() []byte
+	Value() []byte
+	Error() error
+	Release()
+}
+
+// Batch collects the write operations to be applied atomically.
+type Batch interface {
+	Put(key, value []byte)
+	Delete(key []byte)
+	Len() int
+	Reset()
+}
+
+// Reader reads the key-value pairs of a database.
+type Reader interface {
+	// Get returns the value of the given key, or ErrNotFound if the key doesn't exist.
+	Get(key []byte) ([]byte, error)
+	Has(key []byte) (bool, error)
+	// NewIterator returns an iterator over the keys that start with the given prefix.
+	NewIterator(prefix []byte) Iterator
+}
+
+// Snapshot is a frozen, read-only view of a database.
+// The writes after taking the snapshot are not visible through it.
+type Snapshot interface {
+	Reader
+
+	// Release releases the snapshot. The snapshot should not be used after releasing it.
+	Release()
+}
+
+// DB is a key-value database.
+type DB interface {
+	Reader
+
+	// NewSnapshot takes a snapshot of the current state of the database.
+	NewSnapshot() (Snapshot, error)
+	NewBatch() Batch
+	// Write applies the batch atomically. The write is not synced to the disk,
+	// so a system crash can lose the recent writes, but not a process crash.
+	Write(batch Batch) error
+	Close() error
+}
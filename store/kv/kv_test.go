@@ -0,0 +1,210 @@
+package kv
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEngines(t *testing.T) map[string]DB {
+	t.Helper()
+
+	level, err := NewLevelDB(util.TempDirPath(), false)
+	require.NoError(t, err)
+
+	pebble, err := NewPebbleDB(util.TempDirPath(), false)
+	require.NoError(t, err)
+
+	return map[string]DB{
+		"leveldb": level,
+		"pebble":  pebble,
+		"memory":  NewMemoryDB(),
+	}
+}
+
+func TestGetAndHas(t *testing.T) {
+	for name, db := range testEngines(t) {
+		t.Run(name, func(t *testing.T) {
+			_, err := db.Get([]byte("key"))
+			assert.ErrorIs(t, err, ErrNotFound)
+
+			batch := db.NewBatch()
+			batch.Put([]byte("key"), []byte("value"))
+			assert.Equal(t, 1, batch.Len())
+
+			// Nothing is written before writing the batch
+			ok, err := db.Has([]byte("key"))
+			assert.NoError(t, err)
+			assert.False(t, ok)
+
+			require.NoError(t, db.Write(batch))
+
+			ok, err = db.Has([]byte("key"))
+			assert.NoError(t, err)
+			assert.True(t, ok)
+
+			data, err := db.Get([]byte("key"))
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("value"), data)
+
+			batch.Reset()
+			batch.Delete([]byte("key"))
+			require.NoError(t, db.Write(batch))
+
+			_, err = db.Get([]byte("key"))
+			assert.ErrorIs(t, err, ErrNotFound)
+		})
+	}
+}
+
+func TestIterator(t *testing.T) {
+	for name, db := range testEngines(t) {
+		t.Run(name, func(t *testing.T) {
+			batch := db.NewBatch()
+			batch.Put([]byte{0x01, 0x02}, []byte{2})
+			batch.Put([]byte{0x01, 0x01}, []byte{1})
+			batch.Put([]byte{0x01, 0x03}, []byte{3})
+			batch.Put([]byte{0x02, 0x01}, []byte{4})
+			require.NoError(t, db.Write(batch))
+
+			values := []byte{}
+			iter := db.NewIterator([]byte{0x01})
+			for iter.Next() {
+				values = append(values, iter.Value()...)
+			}
+			assert.NoError(t, iter.Error())
+			iter.Release()
+			assert.Equal(t, []byte{1, 2, 3}, values)
+
+			values = []byte{}
+			iter = db.NewIterator([]byte{0x01})
+			for ok := iter.Last(); ok; ok = iter.Prev() {
+				values = append(values, iter.Value()...)
+			}
+			iter.Release()
+			assert.Equal(t, []byte{3, 2, 1}, values)
+
+			iter = db.NewIterator([]byte{0x01})
+			assert.True(t, iter.Seek([]byte{0x01, 0x02}))
+			assert.Equal(t, []byte{2}, iter.Value())
+			assert.False(t, iter.Seek([]byte{0x01, 0x04}))
+			iter.Release()
+		})
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	for name, db := range testEngines(t) {
+		t.Run(name, func(t *testing.T) {
+			batch := db.NewBatch()
+			batch.Put([]byte{0x01, 0x01}, []byte{1})
+			batch.Put([]byte{0x01, 0x02}, []byte{2})
+			require.NoError(t, db.Write(batch))
+
+			snap, err := db.NewSnapshot()
+			require.NoError(t, err)
+
+			batch.Reset()
+			batch.Put([]byte{0x01, 0x01}, []byte{3})
+			batch.Delete([]byte{0x01, 0x02})
+			batch.Put([]byte{0x01, 0x03}, []byte{4})
+			require.NoError(t, db.Write(batch))
+
+			// The snapshot doesn't see the later writes.
+			data, err := snap.Get([]byte{0x01, 0x01})
+			assert.NoError(t, err)
+			assert.Equal(t, []byte{1}, data)
+
+			ok, err := snap.Has([]byte{0x01, 0x02})
+			assert.NoError(t, err)
+			assert.True(t, ok)
+
+			_, err = snap.Get([]byte{0x01, 0x03})
+			assert.ErrorIs(t, err, ErrNotFound)
+
+			values := []byte{}
+			iter := snap.NewIterator([]byte{0x01})
+			for iter.Next() {
+				values = append(values, iter.Value()...)
+			}
+			iter.Release()
+			assert.Equal(t, []byte{1, 2}, values)
+
+			// A later snapshot sees the writes before it, and not the ones after it.
+			snap2, err := db.NewSnapshot()
+			require.NoError(t, err)
+
+			batch.Reset()
+			batch.Put([]byte{0x01, 0x02}, []byte{5})
+			batch.Delete([]byte{0x01, 0x03})
+			require.NoError(t, db.Write(batch))
+
+			values = []byte{}
+			iter = snap2.NewIterator([]byte{0x01})
+			for iter.Next() {
+				values = append(values, iter.Value()...)
+			}
+			iter.Release()
+			assert.Equal(t, []byte{3, 4}, values)
+
+			data, err = snap.Get([]byte{0x01, 0x02})
+			assert.NoError(t, err)
+			assert.Equal(t, []byte{2}, data)
+
+			snap2.Release()
+			snap.Release()
+
+			data, err = db.Get([]byte{0x01, 0x01})
+			assert.NoError(t, err)
+			assert.Equal(t, []byte{3}, data)
+		})
+	}
+}
+
+func TestClose(t *testing.T) {
+	for name, db := range testEngines(t) {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, db.Close())
+
+			_, err := db.Get([]byte("key"))
+			assert.Error(t, err)
+
+			batch := db.NewBatch()
+			batch.Put([]byte("key"), []byte("value"))
+			assert.Error(t, db.Write(batch))
+		})
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	openers := map[string]func(path string, readOnly bool) (DB, error){
+		"leveldb": NewLevelDB,
+		"pebble":  NewPebbleDB,
+	}
+
+	for name, open := range openers {
+		t.Run(name, func(t *testing.T) {
+			path := util.TempDirPath()
+
+			_, err := open(path, true)
+			assert.Error(t, err, "database doesn't exist")
+
+			db, err := open(path, false)
+			require.NoError(t, err)
+			batch := db.NewBatch()
+			batch.Put([]byte("key"), []byte("value"))
+			require.NoError(t, db.Write(batch))
+			require.NoError(t, db.Close())
+
+			db, err = open(path, true)
+			require.NoError(t, err)
+
+			data, err := db.Get([]byte("key"))
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("value"), data)
+			assert.Error(t, db.Write(batch))
+		})
+	}
+}
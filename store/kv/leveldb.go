@@ -0,0 +1,97 @@
+package kv
+
+import (
+	"errors"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+type levelDB struct {
+	db *leveldb.DB
+}
+
+// NewLevelDB opens or creates a LevelDB database at the given path.
+// In read-only mode, the database should exist and all the writes fail.
+func NewLevelDB(path string, readOnly bool) (DB, error) {
+	options := &opt.Options{
+		Strict:         opt.DefaultStrict,
+		Compression:    opt.NoCompression,
+		ReadOnly:       readOnly,
+		ErrorIfMissing: readOnly,
+	}
+
+	db, err := leveldb.OpenFile(path, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &levelDB{
+		db: db,
+	}, nil
+}
+
+func (l *levelDB) Get(key []byte) ([]byte, error) {
+	data, err := l.db.Get(key, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+
+	return data, err
+}
+
+func (l *levelDB) Has(key []byte) (bool, error) {
+	return l.db.Has(key, nil)
+}
+
+func (l *levelDB) NewIterator(prefix []byte) Iterator {
+	return l.db.NewIterator(util.BytesPrefix(prefix), nil)
+}
+
+func (l *levelDB) NewSnapshot() (Snapshot, error) {
+	snap, err := l.db.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	return &levelSnapshot{snap: snap}, nil
+}
+
+func (l *levelDB) NewBatch() Batch {
+	return new(leveldb.Batch)
+}
+
+func (l *levelDB) Write(batch Batch) error {
+	return l.db.Write(batch.(*leveldb.Batch), nil)
+}
+
+func (l *levelDB) Close() error {
+	return l.db.Close()
+}
+
+// levelSnapshot wraps a LevelDB snapshot.
+type levelSnapshot struct {
+	snap *leveldb.Snapshot
+}
+
+func (l *levelSnapshot) Get(key []byte) ([]byte, error) {
+	data, err := l.snap.Get(key, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+
+	return data, err
+}
+
+func (l *levelSnapshot) Has(key []byte) (bool, error) {
+	return l.snap.Has(key, nil)
+}
+
+func (l *levelSnapshot) NewIterator(prefix []byte) Iterator {
+	return l.snap.NewIterator(util.BytesPrefix(prefix), nil)
+}
+
+func (l *levelSnapshot) Release() {
+	l.snap.Release()
+}
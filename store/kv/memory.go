@@ -0,0 +1,231 @@
+package kv
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// memoryDB keeps the data in memory.
+// The data is lost when the database is closed, therefore it is useful for testing.
+type memoryDB struct {
+	lk sync.RWMutex
+
+	db        *memdb.DB
+	snapshots map[*memorySnapshot]struct{}
+	closed    bool
+}
+
+// NewMemoryDB creates an empty in-memory database.
+func NewMemoryDB() DB {
+	return &memoryDB{
+		db:        memdb.New(comparer.DefaultComparer, 0),
+		snapshots: make(map[*memorySnapshot]struct{}),
+	}
+}
+
+func (m *memoryDB) Get(key []byte) ([]byte, error) {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	if m.closed {
+		return nil, ErrClosed
+	}
+
+	data, err := m.db.Get(key)
+	if errors.Is(err, memdb.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The returned slice belongs to the memory database, so we copy it.
+	return append([]byte{}, data...), nil
+}
+
+func (m *memoryDB) Has(key []byte) (bool, error) {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	if m.closed {
+		return false, ErrClosed
+	}
+
+	return m.db.Contains(key), nil
+}
+
+func (m *memoryDB) NewIterator(prefix []byte) Iterator {
+	return m.db.NewIterator(util.BytesPrefix(prefix))
+}
+
+// NewSnapshot creates a copy-on-write snapshot of the memory database.
+// Nothing is copied when the snapshot is taken. Instead, the previous values of the keys
+// are kept in the snapshot when they are changed for the first time.
+func (m *memoryDB) NewSnapshot() (Snapshot, error) {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	if m.closed {
+		return nil, ErrClosed
+	}
+
+	snap := &memorySnapshot{
+		mdb:   m,
+		saved: make(map[string][]byte),
+	}
+	m.snapshots[snap] = struct{}{}
+
+	return snap, nil
+}
+
+func (m *memoryDB) NewBatch() Batch {
+	return new(leveldb.Batch)
+}
+
+func (m *memoryDB) Write(batch Batch) error {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	if m.closed {
+		return ErrClosed
+	}
+
+	return batch.(*leveldb.Batch).Replay(memoryReplay{mdb: m})
+}
+
+func (m *memoryDB) Close() error {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	m.closed = true
+	m.db.Reset()
+
+	return nil
+}
+
+// memoryReplay applies the operations of a batch to the memory database.
+// The previous values of the changed keys are kept in the snapshots before applying the operations.
+type memoryReplay struct {
+	mdb *memoryDB
+}
+
+func (r memoryReplay) Put(key, value []byte) {
+	r.mdb.saveForSnapshots(key)
+	_ = r.mdb.db.Put(key, value)
+}
+
+func (r memoryReplay) Delete(key []byte) {
+	r.mdb.saveForSnapshots(key)
+	_ = r.mdb.db.Delete(key)
+}
+
+// saveForSnapshots keeps the current value of the key in the snapshots that don't have it yet.
+// The caller should hold the write lock.
+func (m *memoryDB) saveForSnapshots(key []byte) {
+	if len(m.snapshots) == 0 {
+		return
+	}
+
+	// A nil value means that the key doesn't exist.
+	var value []byte
+	if data, err := m.db.Get(key); err == nil {
+		value = append([]byte{}, data...)
+	}
+
+	for snap := range m.snapshots {
+		if _, ok := snap.saved[string(key)]; !ok {
+			snap.saved[string(key)] = value
+		}
+	}
+}
+
+// memorySnapshot is a read-only, copy-on-write view of a memory database.
+// The keys that are changed after taking the snapshot are read from the saved values,
+// and the rest are read from the database.
+type memorySnapshot struct {
+	mdb      *memoryDB
+	saved    map[string][]byte
+	released bool
+}
+
+func (s *memorySnapshot) Get(key []byte) ([]byte, error) {
+	s.mdb.lk.RLock()
+	defer s.mdb.lk.RUnlock()
+
+	if s.released || s.mdb.closed {
+		return nil, ErrClosed
+	}
+
+	if value, ok := s.saved[string(key)]; ok {
+		if value == nil {
+			return nil, ErrNotFound
+		}
+
+		return append([]byte{}, value...), nil
+	}
+
+	data, err := s.mdb.db.Get(key)
+	if errors.Is(err, memdb.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{}, data...), nil
+}
+
+func (s *memorySnapshot) Has(key []byte) (bool, error) {
+	_, err := s.Get(key)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// NewIterator copies the entries with the given prefix, as they were when the snapshot is taken,
+// and iterates over the copy.
+func (s *memorySnapshot) NewIterator(prefix []byte) Iterator {
+	s.mdb.lk.RLock()
+	defer s.mdb.lk.RUnlock()
+
+	entries := memdb.New(comparer.DefaultComparer, 0)
+	if s.released || s.mdb.closed {
+		return entries.NewIterator(nil)
+	}
+
+	iter := s.mdb.db.NewIterator(util.BytesPrefix(prefix))
+	for iter.Next() {
+		if _, ok := s.saved[string(iter.Key())]; !ok {
+			_ = entries.Put(iter.Key(), iter.Value())
+		}
+	}
+	iter.Release()
+
+	for key, value := range s.saved {
+		if value != nil && bytes.HasPrefix([]byte(key), prefix) {
+			_ = entries.Put([]byte(key), value)
+		}
+	}
+
+	return entries.NewIterator(nil)
+}
+
+func (s *memorySnapshot) Release() {
+	s.mdb.lk.Lock()
+	defer s.mdb.lk.Unlock()
+
+	delete(s.mdb.snapshots, s)
+	s.released = true
+	s.saved = nil
+}
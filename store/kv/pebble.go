@@ -0,0 +1,300 @@
+package kv
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// pebbleDB wraps a Pebble database.
+// Pebble panics when a closed database is used, so the database keeps track of its state.
+type pebbleDB struct {
+	lk sync.RWMutex
+
+	db     *pebble.DB
+	closed bool
+}
+
+// NewPebbleDB opens or creates a Pebble database at the given path.
+// In read-only mode, the database should exist and all the writes fail.
+func NewPebbleDB(path string, readOnly bool) (DB, error) {
+	options := &pebble.Options{
+		ReadOnly:         readOnly,
+		ErrorIfNotExists: readOnly,
+		Logger:           pebbleLogger{},
+	}
+
+	db, err := pebble.Open(path, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pebbleDB{
+		db: db,
+	}, nil
+}
+
+func (p *pebbleDB) Get(key []byte) ([]byte, error) {
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	if p.closed {
+		return nil, ErrClosed
+	}
+
+	return pebbleGet(p.db.Get(key))
+}
+
+func (p *pebbleDB) Has(key []byte) (bool, error) {
+	return pebbleHas(p.Get(key))
+}
+
+func (p *pebbleDB) NewIterator(prefix []byte) Iterator {
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	if p.closed {
+		return newPebbleIterator(nil, ErrClosed)
+	}
+
+	return newPebbleIterator(p.db.NewIter(pebbleIterOptions(prefix)))
+}
+
+func (p *pebbleDB) NewSnapshot() (Snapshot, error) {
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	if p.closed {
+		return nil, ErrClosed
+	}
+
+	return &pebbleSnapshot{snap: p.db.NewSnapshot()}, nil
+}
+
+func (p *pebbleDB) NewBatch() Batch {
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	// The batch of a closed database still collects the operations, but writing it fails.
+	if p.closed {
+		return &pebbleBatch{batch: new(pebble.Batch)}
+	}
+
+	return &pebbleBatch{batch: p.db.NewBatch()}
+}
+
+func (p *pebbleDB) Write(batch Batch) error {
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	if p.closed {
+		return ErrClosed
+	}
+
+	// A Pebble batch can't be applied more than once, but the batches of the store are kept
+	// when writing fails. Therefore, a copy of the batch is applied.
+	copied := p.db.NewBatch()
+	defer func() { _ = copied.Close() }()
+
+	err := copied.Apply(batch.(*pebbleBatch).batch, nil)
+	if err != nil {
+		return err
+	}
+
+	return copied.Commit(pebble.NoSync)
+}
+
+func (p *pebbleDB) Close() error {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+
+	if p.closed {
+		return ErrClosed
+	}
+	p.closed = true
+
+	return p.db.Close()
+}
+
+// pebbleSnapshot wraps a Pebble snapshot.
+type pebbleSnapshot struct {
+	snap *pebble.Snapshot
+}
+
+func (p *pebbleSnapshot) Get(key []byte) ([]byte, error) {
+	return pebbleGet(p.snap.Get(key))
+}
+
+func (p *pebbleSnapshot) Has(key []byte) (bool, error) {
+	return pebbleHas(p.Get(key))
+}
+
+func (p *pebbleSnapshot) NewIterator(prefix []byte) Iterator {
+	return newPebbleIterator(p.snap.NewIter(pebbleIterOptions(prefix)))
+}
+
+func (p *pebbleSnapshot) Release() {
+	_ = p.snap.Close()
+}
+
+// pebbleBatch wraps a Pebble batch.
+// The length of a Pebble batch is the size of its data, so the number of operations is returned instead.
+type pebbleBatch struct {
+	batch *pebble.Batch
+}
+
+func (b *pebbleBatch) Put(key, value []byte) {
+	_ = b.batch.Set(key, value, nil)
+}
+
+func (b *pebbleBatch) Delete(key []byte) {
+	_ = b.batch.Delete(key, nil)
+}
+
+func (b *pebbleBatch) Len() int {
+	return int(b.batch.Count())
+}
+
+func (b *pebbleBatch) Reset() {
+	b.batch.Reset()
+}
+
+// pebbleIterator wraps a Pebble iterator.
+// Unlike LevelDB, a Pebble iterator should be positioned before moving it,
+// so the first move positions it at the first or the last key.
+type pebbleIterator struct {
+	iter       *pebble.Iterator
+	err        error
+	positioned bool
+}
+
+func newPebbleIterator(iter *pebble.Iterator, err error) *pebbleIterator {
+	return &pebbleIterator{
+		iter: iter,
+		err:  err,
+	}
+}
+
+func (i *pebbleIterator) First() bool {
+	if i.iter == nil {
+		return false
+	}
+	i.positioned = true
+
+	return i.iter.First()
+}
+
+func (i *pebbleIterator) Last() bool {
+	if i.iter == nil {
+		return false
+	}
+	i.positioned = true
+
+	return i.iter.Last()
+}
+
+func (i *pebbleIterator) Next() bool {
+	if !i.positioned {
+		return i.First()
+	}
+
+	return i.iter.Next()
+}
+
+func (i *pebbleIterator) Prev() bool {
+	if !i.positioned {
+		return i.Last()
+	}
+
+	return i.iter.Prev()
+}
+
+func (i *pebbleIterator) Seek(key []byte) bool {
+	if i.iter == nil {
+		return false
+	}
+	i.positioned = true
+
+	return i.iter.SeekGE(key)
+}
+
+func (i *pebbleIterator) Key() []byte {
+	if i.iter == nil || !i.iter.Valid() {
+		return nil
+	}
+
+	return i.iter.Key()
+}
+
+func (i *pebbleIterator) Value() []byte {
+	if i.iter == nil || !i.iter.Valid() {
+		return nil
+	}
+
+	return i.iter.Value()
+}
+
+func (i *pebbleIterator) Error() error {
+	if i.iter == nil {
+		return i.err
+	}
+
+	return i.iter.Error()
+}
+
+func (i *pebbleIterator) Release() {
+	if i.iter != nil {
+		_ = i.iter.Close()
+		i.iter = nil
+	}
+}
+
+// pebbleIterOptions limits the iterator to the keys that start with the given prefix.
+func pebbleIterOptions(prefix []byte) *pebble.IterOptions {
+	rng := util.BytesPrefix(prefix)
+
+	return &pebble.IterOptions{
+		LowerBound: rng.Start,
+		UpperBound: rng.Limit,
+	}
+}
+
+// pebbleGet copies the value returned by Pebble, since it is only valid until the closer is closed.
+func pebbleGet(data []byte, closer io.Closer, err error) ([]byte, error) {
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = closer.Close() }()
+
+	return append([]byte{}, data...), nil
+}
+
+func pebbleHas(_ []byte, err error) (bool, error) {
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// pebbleLogger writes the logs of Pebble into the logger of the node.
+type pebbleLogger struct{}
+
+func (pebbleLogger) Infof(format string, args ...interface{}) {
+	logger.Debug(fmt.Sprintf(format, args...))
+}
+
+func (pebbleLogger) Fatalf(format string, args ...interface{}) {
+	logger.Fatal(fmt.Sprintf(format, args...))
+}
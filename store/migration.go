@@ -0,0 +1,81 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// migration upgrades the on-disk format of the store to its version.
+// The migration should be resumable, since the node might be stopped in the middle of it.
+// The new version is written after the migration is done, so an interrupted migration runs again.
+type migration struct {
+	version     int32
+	description string
+	migrate     func(s *store, currentHeight uint32) error
+}
+
+// migrations is the ordered list of migrations.
+// The version of the last migration should be equal to lastStoreVersion.
+var migrations = []migration{
+	{
+		version:     2,
+		description: "indexing transaction history",
+		migrate:     (*store).indexHistory,
+	},
+	{
+		version:     3,
+		description: "recording availability of validators",
+		migrate:     (*store).recordAvailability,
+	},
+	{
+		version:     4,
+		description: "recording supply counters",
+		migrate:     (*store).recordSupply,
+	},
+	{
+		version:     5,
+		description: "indexing validator rewards",
+		migrate:     (*store).indexRewards,
+	},
+}
+
+// migrate runs the migrations that are needed to upgrade the store to the last version.
+// A store that is created by a newer version of the node is not supported.
+func (s *store) migrate(currentHeight uint32) error {
+	version, lastCert := s.lastInfo()
+	if lastCert == nil {
+		// The store is empty, nothing to migrate.
+		return nil
+	}
+
+	if version > lastStoreVersion {
+		return UnsupportedVersionError{
+			Version: version,
+		}
+	}
+
+	for _, m := range migrations {
+		if m.version <= version {
+			continue
+		}
+
+		if s.config.ReadOnly {
+			return fmt.Errorf("store version %d should be upgraded to %d, which is not possible in read-only mode",
+				version, lastStoreVersion)
+		}
+
+		logger.Info("migrating store", "version", m.version, "description", m.description)
+		if err := m.migrate(s, currentHeight); err != nil {
+			return fmt.Errorf("unable to migrate store to version %d: %w", m.version, err)
+		}
+
+		s.saveLastInfo(m.version, lastCert)
+		if err := s.WriteBatch(); err != nil {
+			return err
+		}
+		version = m.version
+	}
+
+	return nil
+}
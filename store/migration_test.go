@@ -0,0 +1,61 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationsOrder(t *testing.T) {
+	version := int32(1)
+	for _, m := range migrations {
+		assert.Equal(t, version+1, m.version)
+		version = m.version
+	}
+	assert.Equal(t, lastStoreVersion, version)
+}
+
+func TestMigrate(t *testing.T) {
+	t.Run("Empty store", func(t *testing.T) {
+		conf := testConfig()
+		s, err := NewStore(conf)
+		require.NoError(t, err)
+
+		version, lastCert := s.(*store).lastInfo()
+		assert.Zero(t, version)
+		assert.Nil(t, lastCert)
+	})
+
+	t.Run("Older version", func(t *testing.T) {
+		conf := testConfig()
+		td := setup(t, conf)
+		lastCert := td.store.LastCertificate()
+		td.store.saveLastInfo(1, lastCert)
+		require.NoError(t, td.store.WriteBatch())
+		require.NoError(t, td.store.Close())
+
+		conf.ReadOnly = true
+		_, err := NewStore(conf)
+		assert.Error(t, err, "read-only store can't be migrated")
+
+		conf.ReadOnly = false
+		s, err := NewStore(conf)
+		require.NoError(t, err)
+
+		version, migratedCert := s.(*store).lastInfo()
+		assert.Equal(t, lastStoreVersion, version)
+		assert.Equal(t, lastCert.Hash(), migratedCert.Hash())
+	})
+
+	t.Run("Newer version", func(t *testing.T) {
+		conf := testConfig()
+		td := setup(t, conf)
+		td.store.saveLastInfo(lastStoreVersion+1, td.store.LastCertificate())
+		require.NoError(t, td.store.WriteBatch())
+		require.NoError(t, td.store.Close())
+
+		_, err := NewStore(conf)
+		assert.ErrorIs(t, err, UnsupportedVersionError{Version: lastStoreVersion + 1})
+	})
+}
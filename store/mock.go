@@ -2,6 +2,8 @@ package store
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
@@ -10,7 +12,9 @@ import (
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util/testsuite"
 )
@@ -20,11 +24,20 @@ var _ Store = &MockStore{}
 type MockStore struct {
 	ts *testsuite.TestSuite
 
-	Blocks     map[uint32]*block.Block
-	Accounts   map[crypto.Address]*account.Account
-	Validators map[crypto.Address]*validator.Validator
-	LastCert   *certificate.Certificate
-	LastHeight uint32
+	Blocks       map[uint32]*block.Block
+	Accounts     map[crypto.Address]*account.Account
+	Validators   map[crypto.Address]*validator.Validator
+	Receipts     map[tx.ID]*receipt.Receipt
+	Evidence     *ForkEvidence
+	LastCert     *certificate.Certificate
+	LastHeight   uint32
+	PrunedHeight uint32
+
+	// The previous state of the accounts and validators, before committing the blocks.
+	prevAccounts   map[uint32]map[crypto.Address]*account.Account
+	prevValidators map[uint32]map[crypto.Address]*validator.Validator
+	pendingAccs    map[crypto.Address]*account.Account
+	pendingVals    map[crypto.Address]*validator.Validator
 }
 
 func MockingStore(ts *testsuite.TestSuite) *MockStore {
@@ -33,6 +46,12 @@ func MockingStore(ts *testsuite.TestSuite) *MockStore {
 		Blocks:     make(map[uint32]*block.Block),
 		Accounts:   make(map[crypto.Address]*account.Account),
 		Validators: make(map[crypto.Address]*validator.Validator),
+		Receipts:   make(map[tx.ID]*receipt.Receipt),
+
+		prevAccounts:   make(map[uint32]map[crypto.Address]*account.Account),
+		prevValidators: make(map[uint32]map[crypto.Address]*validator.Validator),
+		pendingAccs:    make(map[crypto.Address]*account.Account),
+		pendingVals:    make(map[crypto.Address]*validator.Validator),
 	}
 }
 
@@ -83,8 +102,11 @@ func (m *MockStore) SortitionSeed(blockHeight uint32) *sortition.VerifiableSeed
 func (m *MockStore) PublicKey(addr crypto.Address) (*bls.PublicKey, error) {
 	for _, block := range m.Blocks {
 		for _, trx := range block.Transactions() {
-			if trx.Payload().Signer() == addr {
-				return trx.PublicKey().(*bls.PublicKey), nil
+			if trx.Payload().Signer() != addr {
+				continue
+			}
+			if pub, ok := trx.PublicKey().(*bls.PublicKey); ok {
+				return pub, nil
 			}
 		}
 	}
@@ -116,6 +138,19 @@ func (m *MockStore) Transaction(id tx.ID) (*CommittedTx, error) {
 	return nil, fmt.Errorf("not found")
 }
 
+func (m *MockStore) Receipt(id tx.ID) (*receipt.Receipt, error) {
+	rcpt, ok := m.Receipts[id]
+	if ok {
+		return rcpt, nil
+	}
+
+	return nil, fmt.Errorf("not found")
+}
+
+func (m *MockStore) SaveReceipt(id tx.ID, rcpt *receipt.Receipt) {
+	m.Receipts[id] = rcpt
+}
+
 func (m *MockStore) AnyRecentTransaction(id tx.ID) bool {
 	for _, block := range m.Blocks {
 		for _, trx := range block.Transactions() {
@@ -128,6 +163,47 @@ func (m *MockStore) AnyRecentTransaction(id tx.ID) bool {
 	return false
 }
 
+func (m *MockStore) AddressTransactions(addr crypto.Address, offset, limit int) ([]*CommittedTx, error) {
+	txs := make([]*CommittedTx, 0, limit)
+	for height := m.LastHeight; height > 0 && len(txs) < limit; height-- {
+		blk, ok := m.Blocks[height]
+		if !ok {
+			continue
+		}
+		for _, trx := range blk.Transactions() {
+			if len(txs) == limit {
+				break
+			}
+			if trx.Payload().Signer() != addr &&
+				!slices.Contains(payload.Receivers(trx.Payload()), addr) {
+				continue
+			}
+			if offset > 0 {
+				offset--
+
+				continue
+			}
+			d, _ := trx.Bytes()
+			txs = append(txs, &CommittedTx{
+				TxID:      trx.ID(),
+				Height:    height,
+				BlockTime: blk.Header().UnixTime(),
+				Data:      d,
+			})
+		}
+	}
+
+	return txs, nil
+}
+
+func (m *MockStore) IsPruned() bool {
+	return m.PrunedHeight > 0
+}
+
+func (m *MockStore) PruningHeight() uint32 {
+	return m.PrunedHeight
+}
+
 func (m *MockStore) HasAccount(addr crypto.Address) bool {
 	_, ok := m.Accounts[addr]
 
@@ -143,6 +219,15 @@ func (m *MockStore) Account(addr crypto.Address) (*account.Account, error) {
 	return nil, fmt.Errorf("not found")
 }
 
+// AccountAtHeight returns the latest state of the account, since the mock store doesn't keep the history.
+func (m *MockStore) AccountAtHeight(addr crypto.Address, height uint32) (*account.Account, error) {
+	if height > m.LastHeight {
+		return nil, ArchivedHeightError{Height: height, LastHeight: m.LastHeight}
+	}
+
+	return m.Account(addr)
+}
+
 func (m *MockStore) AccountByNumber(number int32) (*account.Account, error) {
 	for _, v := range m.Accounts {
 		if v.Number() == number {
@@ -154,6 +239,10 @@ func (m *MockStore) AccountByNumber(number int32) (*account.Account, error) {
 }
 
 func (m *MockStore) UpdateAccount(addr crypto.Address, acc *account.Account) {
+	if _, ok := m.pendingAccs[addr]; !ok {
+		prevAcc, _ := m.Account(addr)
+		m.pendingAccs[addr] = prevAcc
+	}
 	m.Accounts[addr] = acc
 }
 
@@ -185,6 +274,15 @@ func (m *MockStore) Validator(addr crypto.Address) (*validator.Validator, error)
 	return nil, ErrNotFound
 }
 
+// ValidatorAtHeight returns the latest state of the validator, since the mock store doesn't keep the history.
+func (m *MockStore) ValidatorAtHeight(addr crypto.Address, height uint32) (*validator.Validator, error) {
+	if height > m.LastHeight {
+		return nil, ArchivedHeightError{Height: height, LastHeight: m.LastHeight}
+	}
+
+	return m.Validator(addr)
+}
+
 func (m *MockStore) ValidatorByNumber(num int32) (*validator.Validator, error) {
 	for _, v := range m.Validators {
 		if v.Number() == num {
@@ -195,7 +293,21 @@ func (m *MockStore) ValidatorByNumber(num int32) (*validator.Validator, error) {
 	return nil, fmt.Errorf("not found")
 }
 
+func (m *MockStore) ValidatorByPreviousKey(addr crypto.Address) (*validator.Validator, error) {
+	for _, v := range m.Validators {
+		if _, ok := v.PreviousKey(addr); ok {
+			return v.Clone(), nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
 func (m *MockStore) UpdateValidator(val *validator.Validator) {
+	if _, ok := m.pendingVals[val.Address()]; !ok {
+		prevVal, _ := m.Validator(val.Address())
+		m.pendingVals[val.Address()] = prevVal
+	}
 	m.Validators[val.Address()] = val
 }
 
@@ -233,6 +345,99 @@ func (m *MockStore) SaveBlock(b *block.Block, cert *certificate.Certificate) {
 	m.Blocks[cert.Height()] = b
 	m.LastHeight = cert.Height()
 	m.LastCert = cert
+
+	m.prevAccounts[cert.Height()] = m.pendingAccs
+	m.prevValidators[cert.Height()] = m.pendingVals
+	m.pendingAccs = make(map[crypto.Address]*account.Account)
+	m.pendingVals = make(map[crypto.Address]*validator.Validator)
+}
+
+func (m *MockStore) PreviousState(height uint32) (
+	map[crypto.Address]*account.Account, map[crypto.Address]*validator.Validator, error,
+) {
+	accs, ok := m.prevAccounts[height]
+	if !ok {
+		return nil, nil, ErrNotFound
+	}
+
+	return accs, m.prevValidators[height], nil
+}
+
+// Availability computes the counters from the certificates of the stored blocks.
+func (m *MockStore) Availability(valNum int32, from, to uint32) (Availability, error) {
+	avail := Availability{}
+	for height := from; height <= to; height++ {
+		// The block at a height holds the certificate of the previous block.
+		blk, ok := m.Blocks[height+1]
+		if !ok || blk.PrevCertificate() == nil {
+			return Availability{}, AvailabilityRangeError{From: from, To: to}
+		}
+		cert := blk.PrevCertificate()
+		if slices.Contains(cert.Committers(), valNum) {
+			avail.InCommittee++
+		}
+		if slices.Contains(cert.Absentees(), valNum) {
+			avail.Absent++
+		}
+	}
+
+	return avail, nil
+}
+
+func (m *MockStore) AvailabilityRange() (uint32, uint32, bool) {
+	if m.LastHeight < 2 {
+		return 0, 0, false
+	}
+
+	return 1, m.LastHeight - 1, true
+}
+
+// SupplyCounters computes the counters from the stored blocks.
+func (m *MockStore) SupplyCounters() SupplyCounters {
+	counters := SupplyCounters{}
+	for height := m.PrunedHeight + 1; height <= m.LastHeight; height++ {
+		blk, ok := m.Blocks[height]
+		if !ok {
+			continue
+		}
+		if counters.FromHeight == 0 {
+			counters.FromHeight = height
+		}
+		fees, reward := blockFeesAndReward(blk)
+		counters.ToHeight = height
+		counters.Fees += fees
+		counters.Rewards += reward
+	}
+
+	return counters
+}
+
+// ValidatorRewards computes the rewards from the stored blocks.
+func (m *MockStore) ValidatorRewards(addr crypto.Address, offset, limit int) (RewardSummary, []ValidatorReward) {
+	summary := RewardSummary{FromHeight: m.PrunedHeight + 1}
+	rewards := make([]ValidatorReward, 0, limit)
+	for height := m.LastHeight; height > m.PrunedHeight; height-- {
+		blk, ok := m.Blocks[height]
+		if !ok || blk.Header().ProposerAddress() != addr {
+			continue
+		}
+		reward, ok := blockReward(height, blk)
+		if !ok {
+			continue
+		}
+
+		summary.ProposedBlocks++
+		summary.Rewards += reward.Reward
+		summary.Fees += reward.Fee
+
+		if offset > 0 {
+			offset--
+		} else if len(rewards) < limit {
+			rewards = append(rewards, reward)
+		}
+	}
+
+	return summary, rewards
 }
 
 func (m *MockStore) LastCertificate() *certificate.Certificate {
@@ -243,10 +448,41 @@ func (m *MockStore) LastCertificate() *certificate.Certificate {
 	return m.LastCert
 }
 
+func (m *MockStore) SaveForkEvidence(ev *ForkEvidence) error {
+	m.Evidence = ev
+
+	return nil
+}
+
+func (m *MockStore) ForkEvidence() (*ForkEvidence, error) {
+	return m.Evidence, nil
+}
+
 func (m *MockStore) WriteBatch() error {
+	// The changes without a block belong to the genesis state.
+	m.pendingAccs = make(map[crypto.Address]*account.Account)
+	m.pendingVals = make(map[crypto.Address]*validator.Validator)
+
 	return nil
 }
 
+// NewReadSnapshot returns a copy of the mock store.
+func (m *MockStore) NewReadSnapshot() (ReadSnapshot, error) {
+	snap := *m
+	snap.Blocks = maps.Clone(m.Blocks)
+	snap.Accounts = maps.Clone(m.Accounts)
+	snap.Validators = maps.Clone(m.Validators)
+	snap.Receipts = maps.Clone(m.Receipts)
+
+	return mockReadSnapshot{&snap}, nil
+}
+
+type mockReadSnapshot struct {
+	*MockStore
+}
+
+func (mockReadSnapshot) Release() {}
+
 func (m *MockStore) AddTestValidator() *validator.Validator {
 	val, _ := m.ts.GenerateTestValidator(m.ts.RandInt32(10000))
 	m.UpdateValidator(val)
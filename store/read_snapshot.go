@@ -0,0 +1,243 @@
+package store
+
+import (
+	"bytes"
+	"maps"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/sortition"
+	"github.com/pactus-project/pactus/store/kv"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/receipt"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+)
+
+var _ SandboxReader = &readSnapshot{}
+
+// ReadSnapshot is a consistent, read-only view of the store at the time it is taken.
+// The blocks that are saved afterwards don't change the view,
+// and reading from it doesn't take the store lock.
+// The snapshot should be released when it is no longer needed.
+type ReadSnapshot interface {
+	Block(height uint32) (*CommittedBlock, error)
+	BlockHeight(h hash.Hash) uint32
+	BlockHash(height uint32) hash.Hash
+	Transaction(id tx.ID) (*CommittedTx, error)
+	Receipt(id tx.ID) (*receipt.Receipt, error)
+	SortitionSeed(blockHeight uint32) *sortition.VerifiableSeed
+	AnyRecentTransaction(id tx.ID) bool
+	PublicKey(addr crypto.Address) (*bls.PublicKey, error)
+	HasAccount(addr crypto.Address) bool
+	Account(addr crypto.Address) (*account.Account, error)
+	TotalAccounts() int32
+	HasValidator(addr crypto.Address) bool
+	Validator(addr crypto.Address) (*validator.Validator, error)
+	ValidatorByNumber(num int32) (*validator.Validator, error)
+	ValidatorByPreviousKey(addr crypto.Address) (*validator.Validator, error)
+	TotalValidators() int32
+	Availability(valNum int32, from, to uint32) (Availability, error)
+	AvailabilityRange() (from, to uint32, ok bool)
+	LastCertificate() *certificate.Certificate
+	Release()
+}
+
+// readSnapshot reads the data directly from a database snapshot, bypassing the caches.
+type readSnapshot struct {
+	snap   kv.Snapshot
+	scores *scoreStore
+	// validators maps the validator numbers to the validators.
+	// The validators are immutable, so it is a shallow copy of the validator store.
+	validators map[int32]*validator.Validator
+	// previousKeys maps the addresses of the rotated keys to the validator numbers.
+	previousKeys       map[crypto.Address]int32
+	totalAccounts      int32
+	totalValidators    int32
+	lastCert           *certificate.Certificate
+	lastHeight         uint32
+	txCacheSize        uint32
+	sortitionCacheSize uint32
+}
+
+func (s *store) NewReadSnapshot() (ReadSnapshot, error) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	if s.batch.Len() > 0 {
+		return nil, ErrUnwrittenChanges
+	}
+
+	snap, err := s.db.NewSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	_, lastCert := readLastInfo(snap)
+	lastHeight := uint32(0)
+	if lastCert != nil {
+		lastHeight = lastCert.Height()
+	}
+
+	return &readSnapshot{
+		snap:               snap,
+		scores:             newScoreStore(snap),
+		validators:         maps.Clone(s.validatorStore.numberMap),
+		previousKeys:       maps.Clone(s.validatorStore.previousKeyMap),
+		totalAccounts:      s.accountStore.total,
+		totalValidators:    s.validatorStore.total,
+		lastCert:           lastCert,
+		lastHeight:         lastHeight,
+		txCacheSize:        s.config.TxCacheSize,
+		sortitionCacheSize: s.config.SortitionCacheSize,
+	}, nil
+}
+
+func (rs *readSnapshot) Block(height uint32) (*CommittedBlock, error) {
+	return readCommittedBlock(rs.snap, rs, height)
+}
+
+func (rs *readSnapshot) BlockHeight(h hash.Hash) uint32 {
+	data, err := tryGet(rs.snap, blockHashKey(h))
+	if err != nil {
+		return 0
+	}
+
+	return util.SliceToUint32(data)
+}
+
+func (rs *readSnapshot) BlockHash(height uint32) hash.Hash {
+	data, err := tryGet(rs.snap, blockKey(height))
+	if err == nil {
+		blockHash, _ := hash.FromBytes(data[0:hash.HashSize])
+
+		return blockHash
+	}
+
+	return hash.UndefHash
+}
+
+// SortitionSeed reads the seed from the block header.
+// Like the store, it only returns the seeds of the recent blocks.
+func (rs *readSnapshot) SortitionSeed(blockHeight uint32) *sortition.VerifiableSeed {
+	if blockHeight > rs.lastHeight || rs.lastHeight-blockHeight >= rs.sortitionCacheSize {
+		return nil
+	}
+
+	data, err := tryGet(rs.snap, blockKey(blockHeight))
+	if err != nil {
+		return nil
+	}
+
+	header := new(block.Header)
+	if err := header.Decode(bytes.NewReader(data[hash.HashSize:])); err != nil {
+		return nil
+	}
+	seed := header.SortitionSeed()
+
+	return &seed
+}
+
+// AnyRecentTransaction checks if the transaction is committed within the recent blocks.
+// Like the store, the transactions of the last blocks, up to the transaction cache size, are recent.
+func (rs *readSnapshot) AnyRecentTransaction(id tx.ID) bool {
+	reg, err := readTxRegion(rs.snap, id)
+	if err != nil {
+		return false
+	}
+
+	return rs.lastHeight-reg.height <= rs.txCacheSize
+}
+
+func (rs *readSnapshot) Transaction(id tx.ID) (*CommittedTx, error) {
+	return readCommittedTx(rs.snap, rs, id)
+}
+
+func (rs *readSnapshot) Receipt(id tx.ID) (*receipt.Receipt, error) {
+	data, err := tryGet(rs.snap, receiptKey(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return receipt.FromBytes(data)
+}
+
+func (rs *readSnapshot) PublicKey(addr crypto.Address) (*bls.PublicKey, error) {
+	data, err := tryGet(rs.snap, publicKeyKey(addr))
+	if err != nil {
+		return nil, err
+	}
+
+	return bls.PublicKeyFromBytes(data)
+}
+
+func (rs *readSnapshot) HasAccount(addr crypto.Address) bool {
+	return tryHas(rs.snap, accountKey(addr))
+}
+
+func (rs *readSnapshot) Account(addr crypto.Address) (*account.Account, error) {
+	data, err := tryGet(rs.snap, accountKey(addr))
+	if err != nil {
+		return nil, err
+	}
+
+	return account.FromBytes(data)
+}
+
+func (rs *readSnapshot) TotalAccounts() int32 {
+	return rs.totalAccounts
+}
+
+func (rs *readSnapshot) HasValidator(addr crypto.Address) bool {
+	return tryHas(rs.snap, valKey(addr))
+}
+
+func (rs *readSnapshot) Validator(addr crypto.Address) (*validator.Validator, error) {
+	data, err := tryGet(rs.snap, valKey(addr))
+	if err != nil {
+		return nil, err
+	}
+
+	return validator.FromBytes(data)
+}
+
+func (rs *readSnapshot) ValidatorByNumber(num int32) (*validator.Validator, error) {
+	val, ok := rs.validators[num]
+	if ok {
+		return val.Clone(), nil
+	}
+
+	return nil, ErrNotFound
+}
+
+func (rs *readSnapshot) ValidatorByPreviousKey(addr crypto.Address) (*validator.Validator, error) {
+	num, ok := rs.previousKeys[addr]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return rs.ValidatorByNumber(num)
+}
+
+func (rs *readSnapshot) TotalValidators() int32 {
+	return rs.totalValidators
+}
+
+func (rs *readSnapshot) Availability(valNum int32, from, to uint32) (Availability, error) {
+	return rs.scores.rangeAvailability(valNum, from, to)
+}
+
+func (rs *readSnapshot) AvailabilityRange() (uint32, uint32, bool) {
+	return rs.scores.availabilityRange()
+}
+
+func (rs *readSnapshot) LastCertificate() *certificate.Certificate {
+	return rs.lastCert
+}
+
+func (rs *readSnapshot) Release() {
+	rs.snap.Release()
+}
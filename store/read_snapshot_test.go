@@ -0,0 +1,93 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadSnapshot(t *testing.T) {
+	td := setup(t, nil)
+
+	acc, addr := td.GenerateTestAccount(td.RandInt32(10000))
+	val, _ := td.GenerateTestValidator(td.RandInt32(10000))
+	td.store.UpdateAccount(addr, acc)
+	td.store.UpdateValidator(val)
+
+	t.Run("Unwritten changes", func(t *testing.T) {
+		_, err := td.store.NewReadSnapshot()
+		assert.ErrorIs(t, err, ErrUnwrittenChanges)
+	})
+
+	require.NoError(t, td.store.WriteBatch())
+
+	snap, err := td.store.NewReadSnapshot()
+	require.NoError(t, err)
+	defer snap.Release()
+
+	cb10, _ := td.store.Block(10)
+	blk10, _ := cb10.ToBlock()
+	trx10 := blk10.Transactions()[0]
+	totalAccounts := td.store.TotalAccounts()
+	totalValidators := td.store.TotalValidators()
+
+	// Changing the store after taking the snapshot
+	updatedAcc := acc.Clone()
+	updatedAcc.AddToBalance(1)
+	updatedVal := val.Clone()
+	updatedVal.AddToStake(1)
+	newAcc, newAddr := td.GenerateTestAccount(td.RandInt32(10000))
+	td.store.UpdateAccount(addr, updatedAcc)
+	td.store.UpdateAccount(newAddr, newAcc)
+	td.store.UpdateValidator(updatedVal)
+	blk11, cert11 := td.GenerateTestBlock(11)
+	td.store.SaveBlock(blk11, cert11)
+	require.NoError(t, td.store.WriteBatch())
+
+	t.Run("Accounts and validators", func(t *testing.T) {
+		snapAcc, err := snap.Account(addr)
+		require.NoError(t, err)
+		assert.Equal(t, acc, snapAcc)
+
+		_, err = snap.Account(newAddr)
+		assert.Error(t, err)
+		assert.Equal(t, totalAccounts, snap.TotalAccounts())
+
+		snapVal, err := snap.Validator(val.Address())
+		require.NoError(t, err)
+		assert.Equal(t, val.Stake(), snapVal.Stake())
+
+		snapVal, err = snap.ValidatorByNumber(val.Number())
+		require.NoError(t, err)
+		assert.Equal(t, val.Stake(), snapVal.Stake())
+		assert.Equal(t, totalValidators, snap.TotalValidators())
+
+		// The store has the latest data.
+		storeAcc, _ := td.store.Account(addr)
+		assert.Equal(t, updatedAcc, storeAcc)
+	})
+
+	t.Run("Blocks and transactions", func(t *testing.T) {
+		assert.Equal(t, uint32(10), snap.LastCertificate().Height())
+		assert.Equal(t, cb10.BlockHash, snap.BlockHash(10))
+		assert.Equal(t, uint32(10), snap.BlockHeight(cb10.BlockHash))
+
+		_, err := snap.Block(11)
+		assert.Error(t, err)
+		assert.Zero(t, snap.BlockHeight(blk11.Hash()))
+
+		cb, err := snap.Block(10)
+		require.NoError(t, err)
+		blk, err := cb.ToBlock()
+		require.NoError(t, err)
+		assert.Equal(t, blk10.Hash(), blk.Hash())
+
+		ctx, err := snap.Transaction(trx10.ID())
+		require.NoError(t, err)
+		assert.Equal(t, uint32(10), ctx.Height)
+
+		_, err = snap.Transaction(blk11.Transactions()[0].ID())
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,43 @@
+package store
+
+import (
+	"github.com/pactus-project/pactus/store/kv"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/receipt"
+	"github.com/pactus-project/pactus/types/tx"
+)
+
+func receiptKey(id tx.ID) []byte { return append(receiptPrefix, id.Bytes()...) }
+
+type receiptStore struct {
+	db kv.DB
+}
+
+func newReceiptStore(db kv.DB) *receiptStore {
+	return &receiptStore{
+		db: db,
+	}
+}
+
+func (rs *receiptStore) saveReceipt(batch kv.Batch, id tx.ID, rcpt *receipt.Receipt) {
+	data, err := rcpt.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	batch.Put(receiptKey(id), data)
+}
+
+func (rs *receiptStore) deleteReceipts(batch kv.Batch, txs block.Txs) {
+	for _, trx := range txs {
+		batch.Delete(receiptKey(trx.ID()))
+	}
+}
+
+func (rs *receiptStore) receipt(id tx.ID) (*receipt.Receipt, error) {
+	data, err := tryGet(rs.db, receiptKey(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return receipt.FromBytes(data)
+}
@@ -0,0 +1,267 @@
+package store
+
+import (
+	"encoding/binary"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/store/kv"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// ValidatorReward holds the reward of a block that is proposed by a validator.
+type ValidatorReward struct {
+	// Height is the height of the proposed block.
+	Height uint32
+	// RewardAddress is the address that the subsidy transaction has paid.
+	RewardAddress crypto.Address
+	// Reward is the block reward, excluding the fees.
+	Reward amount.Amount
+	// Fee is the sum of the transaction fees in the block.
+	Fee amount.Amount
+}
+
+// RewardSummary holds the cumulative rewards of a validator.
+type RewardSummary struct {
+	// FromHeight is the height of the first block that its reward is recorded.
+	// The rewards of the earlier blocks are not recorded, like when the node is bootstrapped from a snapshot.
+	// It is not persisted with the summary, since it is the same for all validators.
+	FromHeight uint32
+	// ProposedBlocks is the number of blocks that the validator has proposed.
+	ProposedBlocks uint32
+	// Rewards is the sum of the block rewards, excluding the fees.
+	Rewards amount.Amount
+	// Fees is the sum of the collected transaction fees.
+	Fees amount.Amount
+}
+
+func (r ValidatorReward) bytes() []byte {
+	data := make([]byte, 0, crypto.AddressSize+8+8)
+	data = append(data, r.RewardAddress.Bytes()...)
+	data = binary.BigEndian.AppendUint64(data, uint64(r.Reward))
+	data = binary.BigEndian.AppendUint64(data, uint64(r.Fee))
+
+	return data
+}
+
+func validatorRewardFromBytes(height uint32, data []byte) ValidatorReward {
+	var addr crypto.Address
+	copy(addr[:], data[0:crypto.AddressSize])
+	data = data[crypto.AddressSize:]
+
+	return ValidatorReward{
+		Height:        height,
+		RewardAddress: addr,
+		Reward:        amount.Amount(binary.BigEndian.Uint64(data[0:8])),
+		Fee:           amount.Amount(binary.BigEndian.Uint64(data[8:16])),
+	}
+}
+
+func (s RewardSummary) bytes() []byte {
+	data := make([]byte, 0, 4+8+8)
+	data = binary.BigEndian.AppendUint32(data, s.ProposedBlocks)
+	data = binary.BigEndian.AppendUint64(data, uint64(s.Rewards))
+	data = binary.BigEndian.AppendUint64(data, uint64(s.Fees))
+
+	return data
+}
+
+func rewardSummaryFromBytes(data []byte) RewardSummary {
+	return RewardSummary{
+		ProposedBlocks: binary.BigEndian.Uint32(data[0:4]),
+		Rewards:        amount.Amount(binary.BigEndian.Uint64(data[4:12])),
+		Fees:           amount.Amount(binary.BigEndian.Uint64(data[12:20])),
+	}
+}
+
+// rewardKey is: [prefix: 1 byte]+[validator number: 4 bytes]+[height: 4 bytes].
+// The rewards are keyed by the validator number, which is kept when the validator rotates its key.
+// Height is encoded in big-endian order, so that the iterator
+// returns the rewards of a validator sorted by height.
+func rewardKey(num int32, height uint32) []byte {
+	key := make([]byte, 0, 1+4+4)
+	key = append(key, rewardPrefix...)
+	key = binary.BigEndian.AppendUint32(key, uint32(num))
+	key = binary.BigEndian.AppendUint32(key, height)
+
+	return key
+}
+
+func rewardValidatorPrefix(num int32) []byte {
+	return binary.BigEndian.AppendUint32(append([]byte{}, rewardPrefix...), uint32(num))
+}
+
+func rewardSummaryKey(num int32) []byte {
+	return binary.BigEndian.AppendUint32(append([]byte{}, rewardSummaryPrefix...), uint32(num))
+}
+
+// rewardStore keeps the rewards of the blocks per proposing validator,
+// alongside the cumulative rewards of each validator.
+// Unlike blocks, the rewards are not pruned.
+type rewardStore struct {
+	db         kv.DB
+	validators *validatorStore
+	// summaries caches the latest summaries of the validators, including the ones that are not written yet.
+	summaries map[int32]RewardSummary
+	// started is set when the start height is recorded, even if it is not written yet.
+	started bool
+}
+
+func newRewardStore(db kv.DB, validators *validatorStore) *rewardStore {
+	return &rewardStore{
+		db:         db,
+		validators: validators,
+		summaries:  make(map[int32]RewardSummary),
+	}
+}
+
+// blockReward returns the reward of the block for its proposer.
+// The block should start with a subsidy transaction, otherwise there is no reward.
+func blockReward(height uint32, blk *block.Block) (ValidatorReward, bool) {
+	txs := blk.Transactions()
+	if len(txs) == 0 || !txs[0].IsSubsidyTx() {
+		return ValidatorReward{}, false
+	}
+
+	fee, reward := blockFeesAndReward(blk)
+
+	return ValidatorReward{
+		Height:        height,
+		RewardAddress: *txs[0].Payload().Receiver(),
+		Reward:        reward,
+		Fee:           fee,
+	}, true
+}
+
+// proposerReward returns the number of the validator that has proposed the block, alongside its reward.
+// The proposer might have rotated its key since then, so it is looked up by its previous keys too.
+func (rs *rewardStore) proposerReward(height uint32, blk *block.Block) (int32, ValidatorReward, bool) {
+	reward, ok := blockReward(height, blk)
+	if !ok {
+		return 0, ValidatorReward{}, false
+	}
+
+	proposer := blk.Header().ProposerAddress()
+	num, ok := rs.validators.validatorNumber(proposer)
+	if !ok {
+		logger.Warn("unable to find the proposer of the block", "height", height, "proposer", proposer)
+
+		return 0, ValidatorReward{}, false
+	}
+
+	return num, reward, true
+}
+
+// saveBlock records the reward of the block for its proposer.
+func (rs *rewardStore) saveBlock(batch kv.Batch, height uint32, blk *block.Block) {
+	if proposer, reward, ok := rs.proposerReward(height, blk); ok {
+		summary := rs.summary(proposer)
+		summary.ProposedBlocks++
+		summary.Rewards += reward.Reward
+		summary.Fees += reward.Fee
+
+		batch.Put(rewardKey(proposer, height), reward.bytes())
+		batch.Put(rewardSummaryKey(proposer), summary.bytes())
+		rs.summaries[proposer] = summary
+	}
+
+	if !rs.started {
+		if _, ok := rs.startHeight(); !ok {
+			batch.Put(rewardStartKey, util.Uint32ToSlice(height))
+		}
+		rs.started = true
+	}
+	batch.Put(rewardHeightKey, util.Uint32ToSlice(height))
+}
+
+// revertBlock removes the reward of a reverted block.
+func (rs *rewardStore) revertBlock(batch kv.Batch, height uint32, blk *block.Block) {
+	if indexedHeight, ok := rs.indexedHeight(); !ok || indexedHeight < height {
+		return
+	}
+
+	if proposer, reward, ok := rs.proposerReward(height, blk); ok {
+		summary := rs.summary(proposer)
+		summary.ProposedBlocks--
+		summary.Rewards -= reward.Reward
+		summary.Fees -= reward.Fee
+
+		batch.Delete(rewardKey(proposer, height))
+		if summary.ProposedBlocks == 0 {
+			batch.Delete(rewardSummaryKey(proposer))
+		} else {
+			batch.Put(rewardSummaryKey(proposer), summary.bytes())
+		}
+		rs.summaries[proposer] = summary
+	}
+
+	if startHeight, _ := rs.startHeight(); startHeight >= height {
+		batch.Delete(rewardStartKey)
+		batch.Delete(rewardHeightKey)
+		rs.started = false
+
+		return
+	}
+	batch.Put(rewardHeightKey, util.Uint32ToSlice(height-1))
+}
+
+// summary returns the cumulative rewards of the validator.
+func (rs *rewardStore) summary(num int32) RewardSummary {
+	if summary, ok := rs.summaries[num]; ok {
+		return summary
+	}
+
+	data, err := tryGet(rs.db, rewardSummaryKey(num))
+	if err != nil {
+		return RewardSummary{}
+	}
+
+	return rewardSummaryFromBytes(data)
+}
+
+// rewards returns the rewards of the validator, starting from the most recent one.
+// It skips the first `offset` rewards and returns up to `limit` rewards.
+func (rs *rewardStore) rewards(num int32, offset, limit int) []ValidatorReward {
+	rewards := make([]ValidatorReward, 0, limit)
+	iter := rs.db.NewIterator(rewardValidatorPrefix(num))
+	defer iter.Release()
+
+	for ok := iter.Last(); ok && len(rewards) < limit; ok = iter.Prev() {
+		if offset > 0 {
+			offset--
+
+			continue
+		}
+
+		key := iter.Key()
+		if len(key) != 1+4+4 {
+			logger.Panic("invalid reward key", "key", key)
+		}
+		height := binary.BigEndian.Uint32(key[1+4:])
+		rewards = append(rewards, validatorRewardFromBytes(height, iter.Value()))
+	}
+
+	return rewards
+}
+
+// indexedHeight returns the height of the last block that its reward is recorded.
+func (rs *rewardStore) indexedHeight() (uint32, bool) {
+	data, err := tryGet(rs.db, rewardHeightKey)
+	if err != nil {
+		return 0, false
+	}
+
+	return util.SliceToUint32(data), true
+}
+
+// startHeight returns the height of the first block that its reward is recorded.
+func (rs *rewardStore) startHeight() (uint32, bool) {
+	data, err := tryGet(rs.db, rewardStartKey)
+	if err != nil {
+		return 0, false
+	}
+
+	return util.SliceToUint32(data), true
+}
@@ -0,0 +1,162 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// generateRewardedBlock generates a block that starts with a subsidy transaction.
+func generateRewardedBlock(ts *testsuite.TestSuite, height uint32, proposer crypto.Address,
+) (*block.Block, ValidatorReward) {
+	blk, _ := ts.GenerateTestBlock(height)
+	trx1, _ := ts.GenerateTestTransferTx()
+	trx2, _ := ts.GenerateTestWithdrawTx()
+
+	reward := ValidatorReward{
+		Height:        height,
+		RewardAddress: ts.RandAccAddress(),
+		Reward:        amount.Amount(1e9),
+		Fee:           trx1.Fee() + trx2.Fee(),
+	}
+	txs := block.NewTxs()
+	txs.Append(tx.NewSubsidyTx(height, reward.RewardAddress, reward.Reward+reward.Fee, ""))
+	txs.Append(trx1)
+	txs.Append(trx2)
+
+	header := blk.Header()
+	blk = block.MakeBlock(header.Version(), header.Time(), txs, header.PrevBlockHash(),
+		header.StateRoot(), blk.PrevCertificate(), header.SortitionSeed(), proposer)
+
+	return blk, reward
+}
+
+func TestValidatorRewards(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	conf := testConfig()
+
+	str, err := NewStore(conf)
+	require.NoError(t, err)
+	s := str.(*store)
+
+	val1, _ := ts.GenerateTestValidator(0)
+	val2, _ := ts.GenerateTestValidator(1)
+	s.UpdateValidator(val1)
+	s.UpdateValidator(val2)
+	require.NoError(t, s.WriteBatch())
+
+	// The first proposer rotates its key at height 4.
+	proposer1 := val1.Address()
+	proposer2 := val2.Address()
+	rotatedVal := val1.Clone()
+	rotatedVal.RotatePublicKey(ts.RandValKey().PublicKey(), 4)
+	rewards1 := []ValidatorReward{}
+	for height := uint32(1); height <= 6; height++ {
+		// Updating an account to keep an undo record for the block.
+		acc, addr := ts.GenerateTestAccount(int32(height))
+		s.UpdateAccount(addr, acc)
+
+		if height == 4 {
+			s.UpdateValidator(rotatedVal)
+		}
+
+		proposer := proposer1
+		if height >= 4 {
+			proposer = rotatedVal.Address()
+		}
+		if height%3 == 0 {
+			proposer = proposer2
+		}
+		blk, reward := generateRewardedBlock(ts, height, proposer)
+		if proposer != proposer2 {
+			rewards1 = append([]ValidatorReward{reward}, rewards1...)
+		}
+
+		_, cert := ts.GenerateTestBlock(height)
+		s.SaveBlock(blk, cert)
+		require.NoError(t, s.WriteBatch())
+	}
+
+	expectedSummary := RewardSummary{FromHeight: 1}
+	for _, reward := range rewards1 {
+		expectedSummary.ProposedBlocks++
+		expectedSummary.Rewards += reward.Reward
+		expectedSummary.Fees += reward.Fee
+	}
+
+	t.Run("Paginated rewards", func(t *testing.T) {
+		summary, rewards := s.ValidatorRewards(proposer1, 0, 10)
+		assert.Equal(t, expectedSummary, summary)
+		assert.Equal(t, rewards1, rewards)
+
+		_, rewards = s.ValidatorRewards(proposer1, 1, 2)
+		assert.Equal(t, rewards1[1:3], rewards)
+
+		_, rewards = s.ValidatorRewards(proposer1, 4, 10)
+		assert.Empty(t, rewards)
+
+		summary, rewards = s.ValidatorRewards(proposer2, 0, 10)
+		assert.Equal(t, uint32(2), summary.ProposedBlocks)
+		assert.Equal(t, []uint32{6, 3}, []uint32{rewards[0].Height, rewards[1].Height})
+	})
+
+	t.Run("Rewards are kept after rotating the key", func(t *testing.T) {
+		summary, rewards := s.ValidatorRewards(rotatedVal.Address(), 0, 10)
+		assert.Equal(t, expectedSummary, summary)
+		assert.Equal(t, rewards1, rewards)
+	})
+
+	t.Run("Unknown validator", func(t *testing.T) {
+		summary, rewards := s.ValidatorRewards(ts.RandValAddress(), 0, 10)
+		assert.Zero(t, summary)
+		assert.Empty(t, rewards)
+	})
+
+	t.Run("Indexing rewards of an older store", func(t *testing.T) {
+		for _, prefix := range [][]byte{rewardPrefix, rewardSummaryPrefix} {
+			iter := s.db.NewIterator(prefix)
+			for iter.Next() {
+				s.batch.Delete(append([]byte{}, iter.Key()...))
+			}
+			iter.Release()
+		}
+		s.batch.Delete(rewardHeightKey)
+		s.batch.Delete(rewardStartKey)
+		s.saveLastInfo(4, s.LastCertificate())
+		require.NoError(t, s.WriteBatch())
+		require.NoError(t, s.Close())
+
+		str, err := NewStore(conf)
+		require.NoError(t, err)
+
+		summary, rewards := str.ValidatorRewards(proposer1, 0, 10)
+		assert.Equal(t, expectedSummary, summary)
+		assert.Equal(t, rewards1, rewards)
+		require.NoError(t, str.Close())
+	})
+
+	t.Run("Rollback", func(t *testing.T) {
+		_, err := Rollback(conf, 3)
+		require.NoError(t, err)
+
+		str, err := NewStore(conf)
+		require.NoError(t, err)
+		defer func() { _ = str.Close() }()
+
+		// Heights 1 and 2 are proposed by the first proposer.
+		summary, rewards := str.ValidatorRewards(proposer1, 0, 10)
+		assert.Equal(t, uint32(2), summary.ProposedBlocks)
+		assert.Equal(t, uint32(1), summary.FromHeight)
+		assert.Equal(t, rewards1[2:], rewards)
+
+		summary, rewards = str.ValidatorRewards(proposer2, 0, 10)
+		assert.Equal(t, uint32(1), summary.ProposedBlocks)
+		assert.Len(t, rewards, 1)
+	})
+}
@@ -0,0 +1,169 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+)
+
+// RollbackInfo contains the information of a rolled back store.
+type RollbackInfo struct {
+	FromHeight uint32
+	ToHeight   uint32
+	BlockHash  hash.Hash
+}
+
+// Rollback reverts the store to the given height.
+// The blocks after the given height are deleted and the accounts and validators
+// are restored using the undo records that are written at the commit time.
+// Blocks are reverted one by one, each in a single batch,
+// so an interrupted rollback leaves the store at a valid height.
+func Rollback(conf *Config, height uint32) (*RollbackInfo, error) {
+	str, err := NewStore(conf)
+	if err != nil {
+		return nil, err
+	}
+	s := str.(*store)
+	defer func() { _ = s.Close() }()
+
+	lastCert := s.LastCertificate()
+	if lastCert == nil {
+		return nil, RollbackError{
+			Reason: "store is empty",
+		}
+	}
+
+	lastHeight := lastCert.Height()
+	if height >= lastHeight {
+		return nil, RollbackError{
+			Reason: fmt.Sprintf("height should be less than the last height: %d", lastHeight),
+		}
+	}
+
+	if height <= s.PruningHeight() {
+		return nil, RollbackError{
+			Reason: fmt.Sprintf("block %d is pruned", height),
+		}
+	}
+
+	// An imported store has no undo records for the blocks of the snapshot.
+	if snapshotHeight, ok := s.snapshotHeight(); ok && height < snapshotHeight {
+		return nil, RollbackError{
+			Reason: fmt.Sprintf("state is imported from a snapshot at height %d and can't be rolled back before it",
+				snapshotHeight),
+		}
+	}
+
+	if startHeight, ok := s.archiveStore.startHeight(); ok && height < startHeight {
+		return nil, RollbackError{
+			Reason: fmt.Sprintf("archived state is not available before height %d", startHeight),
+		}
+	}
+
+	// Make sure all the blocks can be reverted, before changing anything.
+	for h := height + 1; h <= lastHeight; h++ {
+		if !s.undoStore.hasUndo(h) {
+			return nil, RollbackError{
+				Reason: fmt.Sprintf("no undo record for block %d", h),
+			}
+		}
+	}
+
+	for h := lastHeight; h > height; h-- {
+		if err := s.revertBlock(h); err != nil {
+			return nil, err
+		}
+
+		if err := s.WriteBatch(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &RollbackInfo{
+		FromHeight: lastHeight,
+		ToHeight:   height,
+		BlockHash:  s.BlockHash(height),
+	}, nil
+}
+
+// revertBlock reverts the last block in the store.
+// The state is restored from the undo record of the block, and the block is deleted
+// alongside its transactions, history entries and the public keys it has registered.
+// The certificate of the previous block, which is kept in the reverted block,
+// becomes the last certificate.
+func (s *store) revertBlock(height uint32) error {
+	data, err := s.blockStore.block(height)
+	if err != nil {
+		return err
+	}
+	blockHash, err := hash.FromBytes(data[0:hash.HashSize])
+	if err != nil {
+		return err
+	}
+	blk, err := block.FromBytes(data[hash.HashSize:])
+	if err != nil {
+		return err
+	}
+
+	rec, err := s.undoStore.undo(height)
+	if err != nil {
+		return err
+	}
+
+	// The proposer of the block is looked up in the state after committing the block,
+	// so the reward is reverted before the state.
+	s.rewardStore.revertBlock(s.batch, height, blk)
+
+	for _, entry := range rec.accounts {
+		if len(entry.data) == 0 {
+			s.accountStore.deleteAccount(s.batch, entry.addr)
+
+			continue
+		}
+
+		acc, err := account.FromBytes(entry.data)
+		if err != nil {
+			return err
+		}
+		s.accountStore.updateAccount(s.batch, entry.addr, acc)
+	}
+
+	for _, entry := range rec.validators {
+		if len(entry.data) == 0 {
+			s.validatorStore.deleteValidator(s.batch, entry.addr)
+
+			continue
+		}
+
+		val, err := validator.FromBytes(entry.data)
+		if err != nil {
+			return err
+		}
+		s.validatorStore.updateValidator(s.batch, val)
+	}
+
+	s.blockStore.deleteBlock(s.batch, height, blockHash)
+	s.blockStore.deletePublicKeys(s.batch, blk)
+	s.txStore.deleteTxs(s.batch, blk.Transactions())
+	s.receiptStore.deleteReceipts(s.batch, blk.Transactions())
+	s.historyStore.deleteTxs(s.batch, height, blk.Transactions())
+	if prevCert := blk.PrevCertificate(); prevCert != nil {
+		s.scoreStore.deleteCertificate(s.batch, prevCert)
+	}
+	s.supplyStore.revertBlock(s.batch, height, blk)
+	s.undoStore.deleteUndo(s.batch, height)
+	if _, ok := s.archiveStore.startHeight(); ok {
+		s.archiveStore.deleteVersions(s.batch, height, rec)
+	}
+
+	if indexedHeight, _ := s.historyStore.indexedHeight(); indexedHeight >= height {
+		s.batch.Put(historyHeightKey, util.Uint32ToSlice(height-1))
+	}
+	s.saveLastInfo(lastStoreVersion, blk.PrevCertificate())
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stateHashes(s *store) (map[crypto.Address]hash.Hash, map[crypto.Address]hash.Hash) {
+	accs := make(map[crypto.Address]hash.Hash)
+	s.IterateAccounts(func(addr crypto.Address, acc *account.Account) bool {
+		accs[addr] = acc.Hash()
+
+		return false
+	})
+
+	vals := make(map[crypto.Address]hash.Hash)
+	s.IterateValidators(func(val *validator.Validator) bool {
+		vals[val.Address()] = val.Hash()
+
+		return false
+	})
+
+	return accs, vals
+}
+
+func TestRollback(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	conf := testConfig()
+
+	str, err := NewStore(conf)
+	require.NoError(t, err)
+	s := str.(*store)
+
+	// Genesis state
+	acc0, addr0 := ts.GenerateTestAccount(0)
+	s.UpdateAccount(addr0, acc0)
+	require.NoError(t, s.WriteBatch())
+
+	var accsAt3, valsAt3 map[crypto.Address]hash.Hash
+	blocks := make(map[uint32]*block.Block)
+	for height := uint32(1); height <= 6; height++ {
+		// The store takes the ownership of the updated account.
+		acc0 = acc0.Clone()
+		acc0.AddToBalance(1)
+		s.UpdateAccount(addr0, acc0)
+
+		acc, addr := ts.GenerateTestAccount(int32(height))
+		s.UpdateAccount(addr, acc)
+
+		val, _ := ts.GenerateTestValidator(int32(height - 1))
+		s.UpdateValidator(val)
+
+		blk, cert := ts.GenerateTestBlock(height)
+		s.SaveBlock(blk, cert)
+		require.NoError(t, s.WriteBatch())
+		blocks[height] = blk
+
+		if height == 3 {
+			accsAt3, valsAt3 = stateHashes(s)
+		}
+	}
+	require.NoError(t, s.Close())
+
+	t.Run("Invalid height", func(t *testing.T) {
+		_, err := Rollback(conf, 6)
+		assert.ErrorIs(t, err, RollbackError{Reason: "height should be less than the last height: 6"})
+
+		_, err = Rollback(conf, 0)
+		assert.ErrorIs(t, err, RollbackError{Reason: "block 0 is pruned"})
+	})
+
+	t.Run("Rollback to height 3", func(t *testing.T) {
+		info, err := Rollback(conf, 3)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(6), info.FromHeight)
+		assert.Equal(t, uint32(3), info.ToHeight)
+		assert.Equal(t, blocks[3].Hash(), info.BlockHash)
+
+		str, err := NewStore(conf)
+		require.NoError(t, err)
+		s := str.(*store)
+		defer func() { _ = s.Close() }()
+
+		accs, vals := stateHashes(s)
+		assert.Equal(t, accsAt3, accs)
+		assert.Equal(t, valsAt3, vals)
+		assert.Equal(t, int32(4), s.TotalAccounts())
+		assert.Equal(t, int32(3), s.TotalValidators())
+
+		assert.Equal(t, blocks[4].PrevCertificate().Hash(), s.LastCertificate().Hash())
+		assert.Equal(t, blocks[3].Hash(), s.BlockHash(3))
+		assert.Equal(t, hash.UndefHash, s.BlockHash(4))
+		assert.Zero(t, s.BlockHeight(blocks[4].Hash()))
+
+		// The certificate of block 3 is not part of any block anymore.
+		from, to, ok := s.AvailabilityRange()
+		assert.True(t, ok)
+		assert.Equal(t, uint32(1), from)
+		assert.Equal(t, uint32(2), to)
+		_, err = s.Availability(blocks[4].PrevCertificate().Committers()[0], 1, 3)
+		assert.Error(t, err)
+
+		for _, trx := range blocks[4].Transactions() {
+			_, err := s.Transaction(trx.ID())
+			assert.Error(t, err)
+			assert.False(t, s.AnyRecentTransaction(trx.ID()))
+
+			_, err = s.PublicKey(trx.Payload().Signer())
+			assert.Error(t, err)
+		}
+		for _, trx := range blocks[3].Transactions() {
+			assert.True(t, s.AnyRecentTransaction(trx.ID()))
+		}
+
+		counters := s.SupplyCounters()
+		assert.Equal(t, uint32(1), counters.FromHeight)
+		assert.Equal(t, uint32(3), counters.ToHeight)
+		expectedFees := amount.Amount(0)
+		for height := uint32(1); height <= 3; height++ {
+			fees, _ := blockFeesAndReward(blocks[height])
+			expectedFees += fees
+		}
+		assert.Equal(t, expectedFees, counters.Fees)
+
+		indexedHeight, _ := s.historyStore.indexedHeight()
+		assert.Equal(t, uint32(3), indexedHeight)
+		assert.False(t, s.undoStore.hasUndo(4))
+		assert.True(t, s.undoStore.hasUndo(3))
+	})
+
+	t.Run("No undo record", func(t *testing.T) {
+		td := setup(t, nil)
+		require.NoError(t, td.store.Close())
+
+		_, err := Rollback(td.store.config, 5)
+		assert.ErrorIs(t, err, RollbackError{Reason: "no undo record for block 6"})
+	})
+}
+
+func TestRollbackKeyRotation(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	conf := testConfig()
+
+	str, err := NewStore(conf)
+	require.NoError(t, err)
+	s := str.(*store)
+
+	acc0, addr0 := ts.GenerateTestAccount(0)
+	s.UpdateAccount(addr0, acc0)
+	val0, _ := ts.GenerateTestValidator(0)
+	s.UpdateValidator(val0)
+	require.NoError(t, s.WriteBatch())
+
+	for height := uint32(1); height <= 3; height++ {
+		acc0 = acc0.Clone()
+		acc0.AddToBalance(1)
+		s.UpdateAccount(addr0, acc0)
+
+		if height == 2 {
+			rotatedVal := val0.Clone()
+			newPub, _ := ts.RandBLSKeyPair()
+			rotatedVal.RotatePublicKey(newPub, height)
+			s.UpdateValidator(rotatedVal)
+		}
+
+		blk, cert := ts.GenerateTestBlock(height)
+		s.SaveBlock(blk, cert)
+		require.NoError(t, s.WriteBatch())
+	}
+	assert.Equal(t, int32(1), s.TotalValidators())
+	_, err = s.ValidatorByPreviousKey(val0.Address())
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	_, err = Rollback(conf, 1)
+	require.NoError(t, err)
+
+	str, err = NewStore(conf)
+	require.NoError(t, err)
+	s = str.(*store)
+	defer func() { _ = s.Close() }()
+
+	assert.Equal(t, int32(1), s.TotalValidators())
+	val, err := s.ValidatorByNumber(0)
+	require.NoError(t, err)
+	assert.Equal(t, val0.Hash(), val.Hash())
+	_, err = s.ValidatorByPreviousKey(val0.Address())
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestPreviousState(t *testing.T) {
+	td := setup(t, nil)
+
+	acc0, addr0 := td.GenerateTestAccount(0)
+	td.store.UpdateAccount(addr0, acc0)
+	require.NoError(t, td.store.WriteBatch())
+
+	// The updated account is recorded with its previous state,
+	// and the created account and validator are recorded with nil.
+	updatedAcc := acc0.Clone()
+	updatedAcc.AddToBalance(1)
+	td.store.UpdateAccount(addr0, updatedAcc)
+	acc1, addr1 := td.GenerateTestAccount(1)
+	td.store.UpdateAccount(addr1, acc1)
+	val, _ := td.GenerateTestValidator(0)
+	td.store.UpdateValidator(val)
+
+	height := td.store.LastCertificate().Height() + 1
+	blk, cert := td.GenerateTestBlock(height)
+	td.store.SaveBlock(blk, cert)
+	require.NoError(t, td.store.WriteBatch())
+
+	accs, vals, err := td.store.PreviousState(height)
+	require.NoError(t, err)
+	assert.Equal(t, acc0.Hash(), accs[addr0].Hash())
+	assert.Contains(t, accs, addr1)
+	assert.Nil(t, accs[addr1])
+	assert.Contains(t, vals, val.Address())
+	assert.Nil(t, vals[val.Address()])
+
+	_, _, err = td.store.PreviousState(height + 1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,200 @@
+package store
+
+import (
+	"encoding/binary"
+
+	"github.com/pactus-project/pactus/store/kv"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/util"
+)
+
+// Availability holds the participation counters of a validator in certificates.
+type Availability struct {
+	// InCommittee is the number of certificates that the validator was a committer of.
+	InCommittee uint32
+	// Absent is the number of certificates that the validator didn't sign.
+	Absent uint32
+}
+
+// Score returns the availability score of the validator.
+// A validator that was never in the committee has a perfect score.
+func (a Availability) Score() float64 {
+	if a.InCommittee == 0 {
+		return 1.0
+	}
+
+	return 1 - (float64(a.Absent) / float64(a.InCommittee))
+}
+
+func (a Availability) bytes() []byte {
+	data := make([]byte, 0, 8)
+	data = binary.BigEndian.AppendUint32(data, a.InCommittee)
+	data = binary.BigEndian.AppendUint32(data, a.Absent)
+
+	return data
+}
+
+func availabilityFromBytes(data []byte) Availability {
+	return Availability{
+		InCommittee: binary.BigEndian.Uint32(data[0:4]),
+		Absent:      binary.BigEndian.Uint32(data[4:8]),
+	}
+}
+
+// scoreKey is: [prefix: 1 byte]+[validator number: 4 bytes]+[height: 4 bytes].
+// Both are encoded in big-endian order, so that the counters of a validator
+// are sorted by height.
+func scoreKey(valNum int32, height uint32) []byte {
+	key := make([]byte, 0, 1+4+4)
+	key = append(key, scorePrefix...)
+	key = binary.BigEndian.AppendUint32(key, uint32(valNum))
+	key = binary.BigEndian.AppendUint32(key, height)
+
+	return key
+}
+
+func scoreValidatorPrefix(valNum int32) []byte {
+	return binary.BigEndian.AppendUint32(append([]byte{}, scorePrefix...), uint32(valNum))
+}
+
+// scoreStore keeps the cumulative participation counters of the validators.
+// A new entry is written for each committer of a certificate, at the height of the certificate.
+// The counters over a range of heights are the difference of the counters at both ends.
+// Unlike blocks, the counters are not pruned.
+type scoreStore struct {
+	db kv.Reader
+	// last caches the latest counters of the validators, including the ones that are not written yet.
+	last map[int32]Availability
+	// started is set when the start height is recorded, even if it is not written yet.
+	started bool
+}
+
+func newScoreStore(db kv.Reader) *scoreStore {
+	return &scoreStore{
+		db:   db,
+		last: make(map[int32]Availability),
+	}
+}
+
+// saveCertificate updates the counters of the committers of the certificate.
+func (ss *scoreStore) saveCertificate(batch kv.Batch, cert *certificate.Certificate) {
+	height := cert.Height()
+	absentees := make(map[int32]bool, len(cert.Absentees()))
+	for _, num := range cert.Absentees() {
+		absentees[num] = true
+	}
+
+	for _, num := range cert.Committers() {
+		avail, ok := ss.last[num]
+		if !ok {
+			avail = ss.availability(num, height)
+		}
+		avail.InCommittee++
+		if absentees[num] {
+			avail.Absent++
+		}
+
+		batch.Put(scoreKey(num, height), avail.bytes())
+		ss.last[num] = avail
+	}
+
+	if !ss.started {
+		if _, ok := ss.startHeight(); !ok {
+			batch.Put(scoreStartKey, util.Uint32ToSlice(height))
+		}
+		ss.started = true
+	}
+	batch.Put(scoreHeightKey, util.Uint32ToSlice(height))
+}
+
+// deleteCertificate removes the counters of the committers of a reverted certificate.
+func (ss *scoreStore) deleteCertificate(batch kv.Batch, cert *certificate.Certificate) {
+	height := cert.Height()
+	for _, num := range cert.Committers() {
+		batch.Delete(scoreKey(num, height))
+	}
+	ss.last = make(map[int32]Availability)
+
+	if startHeight, _ := ss.startHeight(); startHeight >= height {
+		batch.Delete(scoreStartKey)
+		batch.Delete(scoreHeightKey)
+		ss.started = false
+
+		return
+	}
+	batch.Put(scoreHeightKey, util.Uint32ToSlice(height-1))
+}
+
+// availability returns the cumulative counters of the validator at the given height.
+func (ss *scoreStore) availability(valNum int32, height uint32) Availability {
+	iter := ss.db.NewIterator(scoreValidatorPrefix(valNum))
+	defer iter.Release()
+
+	// Find the last entry at or before the height.
+	var found bool
+	if iter.Seek(scoreKey(valNum, height+1)) {
+		found = iter.Prev()
+	} else {
+		found = iter.Last()
+	}
+
+	if !found {
+		return Availability{}
+	}
+
+	return availabilityFromBytes(iter.Value())
+}
+
+// rangeAvailability returns the participation counters of the validator
+// in the certificates from the given height to the given height, inclusive.
+func (ss *scoreStore) rangeAvailability(valNum int32, from, to uint32) (Availability, error) {
+	startHeight, ok := ss.startHeight()
+	lastHeight, _ := ss.recordedHeight()
+	if !ok || from > to || from < startHeight || to > lastHeight {
+		return Availability{}, AvailabilityRangeError{
+			From:        from,
+			To:          to,
+			StartHeight: startHeight,
+			LastHeight:  lastHeight,
+		}
+	}
+
+	last := ss.availability(valNum, to)
+	first := ss.availability(valNum, from-1)
+
+	return Availability{
+		InCommittee: last.InCommittee - first.InCommittee,
+		Absent:      last.Absent - first.Absent,
+	}, nil
+}
+
+// availabilityRange returns the heights of the first and the last certificates that are recorded.
+func (ss *scoreStore) availabilityRange() (uint32, uint32, bool) {
+	startHeight, ok := ss.startHeight()
+	if !ok {
+		return 0, 0, false
+	}
+	lastHeight, _ := ss.recordedHeight()
+
+	return startHeight, lastHeight, true
+}
+
+// startHeight returns the height of the first certificate that is recorded.
+func (ss *scoreStore) startHeight() (uint32, bool) {
+	data, err := tryGet(ss.db, scoreStartKey)
+	if err != nil {
+		return 0, false
+	}
+
+	return util.SliceToUint32(data), true
+}
+
+// recordedHeight returns the height of the last certificate that is recorded.
+func (ss *scoreStore) recordedHeight() (uint32, bool) {
+	data, err := tryGet(ss.db, scoreHeightKey)
+	if err != nil {
+		return 0, false
+	}
+
+	return util.SliceToUint32(data), true
+}
@@ -0,0 +1,104 @@
+package store
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// expectedAvailability calculates the availability from the certificates of the stored blocks.
+func (td *testData) expectedAvailability(t *testing.T, valNum int32, from, to uint32) Availability {
+	t.Helper()
+
+	avail := Availability{}
+	for height := from; height <= to; height++ {
+		cb, err := td.store.Block(height + 1)
+		require.NoError(t, err)
+		blk, err := cb.ToBlock()
+		require.NoError(t, err)
+
+		cert := blk.PrevCertificate()
+		if slices.Contains(cert.Committers(), valNum) {
+			avail.InCommittee++
+		}
+		if slices.Contains(cert.Absentees(), valNum) {
+			avail.Absent++
+		}
+	}
+
+	return avail
+}
+
+func TestAvailability(t *testing.T) {
+	conf := testConfig()
+	td := setup(t, conf)
+
+	from, to, ok := td.store.AvailabilityRange()
+	require.True(t, ok)
+	assert.Equal(t, uint32(1), from)
+	assert.Equal(t, uint32(9), to)
+
+	t.Run("Invalid ranges", func(t *testing.T) {
+		_, err := td.store.Availability(1, 0, 9)
+		assert.ErrorIs(t, err, AvailabilityRangeError{From: 0, To: 9, StartHeight: 1, LastHeight: 9})
+
+		_, err = td.store.Availability(1, 1, 10)
+		assert.ErrorIs(t, err, AvailabilityRangeError{From: 1, To: 10, StartHeight: 1, LastHeight: 9})
+
+		_, err = td.store.Availability(1, 5, 4)
+		assert.ErrorIs(t, err, AvailabilityRangeError{From: 5, To: 4, StartHeight: 1, LastHeight: 9})
+	})
+
+	t.Run("Valid ranges", func(t *testing.T) {
+		for valNum := int32(1); valNum < 40; valNum++ {
+			for _, r := range [][2]uint32{{1, 9}, {3, 7}, {5, 5}} {
+				avail, err := td.store.Availability(valNum, r[0], r[1])
+				require.NoError(t, err)
+				assert.Equal(t, td.expectedAvailability(t, valNum, r[0], r[1]), avail)
+			}
+		}
+	})
+
+	t.Run("Unknown validator", func(t *testing.T) {
+		avail, err := td.store.Availability(1000, 1, 9)
+		assert.NoError(t, err)
+		assert.Zero(t, avail.InCommittee)
+		assert.Equal(t, 1.0, avail.Score())
+	})
+
+	t.Run("Recording availability of an older store", func(t *testing.T) {
+		expected := make(map[int32]Availability)
+		for valNum := int32(1); valNum < 40; valNum++ {
+			expected[valNum], _ = td.store.Availability(valNum, 1, 9)
+		}
+
+		iter := td.store.db.NewIterator(scorePrefix)
+		for iter.Next() {
+			td.store.batch.Delete(append([]byte{}, iter.Key()...))
+		}
+		iter.Release()
+		td.store.batch.Delete(scoreStartKey)
+		td.store.batch.Delete(scoreHeightKey)
+		td.store.saveLastInfo(2, td.store.LastCertificate())
+		require.NoError(t, td.store.WriteBatch())
+		require.NoError(t, td.store.Close())
+
+		str, err := NewStore(conf)
+		require.NoError(t, err)
+
+		for valNum := int32(1); valNum < 40; valNum++ {
+			avail, err := str.Availability(valNum, 1, 9)
+			require.NoError(t, err)
+			assert.Equal(t, expected[valNum], avail)
+		}
+	})
+}
+
+func TestAvailabilityScore(t *testing.T) {
+	assert.Equal(t, 1.0, Availability{}.Score())
+	assert.Equal(t, 1.0, Availability{InCommittee: 4}.Score())
+	assert.Equal(t, 0.75, Availability{InCommittee: 4, Absent: 1}.Score())
+	assert.Equal(t, 0.0, Availability{InCommittee: 4, Absent: 4}.Score())
+}
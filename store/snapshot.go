@@ -0,0 +1,551 @@
+package store
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/encoding"
+	"github.com/pactus-project/pactus/util/simplemerkle"
+)
+
+// The snapshot archive is a gzip stream with this layout:
+//
+//	[version: 4 bytes]+[genesis hash: 32 bytes]+[state root: 32 bytes]
+//	[last certificate: variant]
+//	[number of blocks: varint]+[blocks: variant]
+//	[number of public keys: varint]+[address: 21 bytes, public key: 96 bytes]
+//	[number of accounts: varint]+[address: 21 bytes, account: varbytes]
+//	[number of validators: varint]+[validator: varbytes]
+const snapshotVersion = int32(1)
+
+// SnapshotInfo contains the information of an exported or imported snapshot.
+type SnapshotInfo struct {
+	Height       uint32
+	StateRoot    hash.Hash
+	AccountsRoot hash.Hash
+	NumBlocks    int
+}
+
+// ExportSnapshot writes a snapshot of the store into w.
+// The snapshot contains the accounts, validators and public keys,
+// alongside the last certificate and the recent blocks.
+// The recent blocks are needed to restore the sortition seeds and the recent transactions.
+// The returned state root should match the state root in the header of the next block.
+// The returned accounts root binds the accounts to their addresses, which the state root doesn't.
+func ExportSnapshot(conf *Config, genesisHash hash.Hash, w io.Writer) (*SnapshotInfo, error) {
+	str, err := NewStore(conf)
+	if err != nil {
+		return nil, err
+	}
+	s := str.(*store)
+	defer func() { _ = s.Close() }()
+
+	lastCert := s.LastCertificate()
+	if lastCert == nil {
+		return nil, SnapshotError{
+			Reason: "store is empty",
+		}
+	}
+
+	lastHeight := lastCert.Height()
+	numBlocks := util.Max(conf.TxCacheSize, conf.SortitionCacheSize) + 1
+	fromHeight := uint32(1)
+	if lastHeight > numBlocks {
+		fromHeight = lastHeight - numBlocks + 1
+	}
+	if fromHeight <= s.PruningHeight() {
+		fromHeight = s.PruningHeight() + 1
+	}
+
+	accs, vals := s.snapshotState()
+	stateRoot := calcStateRoot(accs, vals)
+
+	zw := gzip.NewWriter(w)
+	err = encoding.WriteElements(zw, snapshotVersion, &genesisHash, &stateRoot)
+	if err != nil {
+		return nil, err
+	}
+	err = lastCert.Encode(zw)
+	if err != nil {
+		return nil, err
+	}
+
+	err = encoding.WriteVarInt(zw, uint64(lastHeight-fromHeight+1))
+	if err != nil {
+		return nil, err
+	}
+	for height := fromHeight; height <= lastHeight; height++ {
+		committedBlock, err := s.Block(height)
+		if err != nil {
+			return nil, err
+		}
+		blk, err := committedBlock.ToBlock()
+		if err != nil {
+			return nil, err
+		}
+		err = blk.Encode(zw)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.writeSnapshotPublicKeys(zw)
+	if err != nil {
+		return nil, err
+	}
+
+	err = encoding.WriteVarInt(zw, uint64(len(accs)))
+	if err != nil {
+		return nil, err
+	}
+	for addr, acc := range accs {
+		data, err := acc.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		err = addr.Encode(zw)
+		if err != nil {
+			return nil, err
+		}
+		err = encoding.WriteVarBytes(zw, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = encoding.WriteVarInt(zw, uint64(len(vals)))
+	if err != nil {
+		return nil, err
+	}
+	for _, val := range vals {
+		data, err := val.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		err = encoding.WriteVarBytes(zw, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = zw.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return &SnapshotInfo{
+		Height:       lastHeight,
+		StateRoot:    stateRoot,
+		AccountsRoot: calcAccountsRoot(accs),
+		NumBlocks:    int(lastHeight - fromHeight + 1),
+	}, nil
+}
+
+// ImportSnapshot reads a snapshot from r and writes it into an empty store.
+// The imported store is pruned, since it only has the recent blocks.
+//
+// The snapshot is verified against the trusted state root, which is the state root
+// in the header of the block that is committed after the snapshot height.
+// Since the state root doesn't commit to the addresses of the accounts,
+// the accounts are also verified against the trusted accounts root, which is reported by exporting
+// a snapshot at the same height on a trusted node.
+// The accounts and validators are checked against the trusted roots,
+// then the last certificate is checked against the committee and the recent blocks are
+// checked against the last certificate.
+// The public keys are checked against their addresses.
+//
+// The imported store has no undo records for the blocks of the snapshot,
+// so it can't be rolled back before the snapshot height.
+//
+// The supply counters, the validator rewards and the availability scores are not part of the snapshot.
+// They are recorded from the first block of the snapshot, and they are reported with their start heights.
+func ImportSnapshot(conf *Config, genesisHash, trustedStateRoot, trustedAccountsRoot hash.Hash,
+	r io.Reader,
+) (*SnapshotInfo, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = zr.Close() }()
+
+	version := int32(0)
+	snapshotGenesisHash := hash.UndefHash
+	stateRoot := hash.UndefHash
+	err = encoding.ReadElements(zr, &version, &snapshotGenesisHash, &stateRoot)
+	if err != nil {
+		return nil, err
+	}
+	if version != snapshotVersion {
+		return nil, SnapshotError{
+			Reason: fmt.Sprintf("unsupported snapshot version: %d", version),
+		}
+	}
+	if snapshotGenesisHash != genesisHash {
+		return nil, SnapshotError{
+			Reason: "snapshot belongs to another network",
+		}
+	}
+
+	lastCert := new(certificate.Certificate)
+	err = lastCert.Decode(zr)
+	if err != nil {
+		return nil, err
+	}
+	err = lastCert.BasicCheck()
+	if err != nil {
+		return nil, err
+	}
+
+	numBlocks, err := encoding.ReadVarInt(zr)
+	if err != nil {
+		return nil, err
+	}
+	if numBlocks == 0 || numBlocks > uint64(lastCert.Height()) {
+		return nil, SnapshotError{
+			Reason: fmt.Sprintf("invalid number of blocks: %d", numBlocks),
+		}
+	}
+	blocks := make([]*block.Block, 0)
+	for i := uint64(0); i < numBlocks; i++ {
+		blk := new(block.Block)
+		err = blk.Decode(zr)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, blk)
+	}
+
+	numPubKeys, err := encoding.ReadVarInt(zr)
+	if err != nil {
+		return nil, err
+	}
+	pubKeys := make(map[crypto.Address]*bls.PublicKey)
+	for i := uint64(0); i < numPubKeys; i++ {
+		addr := crypto.Address{}
+		err = addr.Decode(zr)
+		if err != nil {
+			return nil, err
+		}
+		pub := new(bls.PublicKey)
+		err = pub.Decode(zr)
+		if err != nil {
+			return nil, err
+		}
+		pubKeys[addr] = pub
+	}
+
+	numAccs, err := encoding.ReadVarInt(zr)
+	if err != nil {
+		return nil, err
+	}
+	accs := make(map[crypto.Address]*account.Account)
+	for i := uint64(0); i < numAccs; i++ {
+		addr := crypto.Address{}
+		err = addr.Decode(zr)
+		if err != nil {
+			return nil, err
+		}
+		data, err := encoding.ReadVarBytes(zr)
+		if err != nil {
+			return nil, err
+		}
+		acc, err := account.FromBytes(data)
+		if err != nil {
+			return nil, err
+		}
+		accs[addr] = acc
+	}
+
+	numVals, err := encoding.ReadVarInt(zr)
+	if err != nil {
+		return nil, err
+	}
+	vals := make([]*validator.Validator, 0)
+	for i := uint64(0); i < numVals; i++ {
+		data, err := encoding.ReadVarBytes(zr)
+		if err != nil {
+			return nil, err
+		}
+		val, err := validator.FromBytes(data)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+
+	err = verifySnapshot(trustedStateRoot, trustedAccountsRoot, stateRoot, lastCert, blocks, pubKeys, accs, vals)
+	if err != nil {
+		return nil, err
+	}
+
+	str, err := NewStore(conf)
+	if err != nil {
+		return nil, err
+	}
+	s := str.(*store)
+	defer func() { _ = s.Close() }()
+
+	if s.LastCertificate() != nil {
+		return nil, SnapshotError{
+			Reason: "store is not empty",
+		}
+	}
+
+	// The blocks before the snapshot are treated as pruned blocks.
+	fromHeight := lastCert.Height() - uint32(numBlocks) + 1
+	if fromHeight > 1 {
+		s.prunedHeight = fromHeight - 1
+		s.batch.Put(prunedHeightKey, util.Uint32ToSlice(s.prunedHeight))
+	}
+
+	for i, blk := range blocks {
+		cert := lastCert
+		if i < len(blocks)-1 {
+			cert = blocks[i+1].PrevCertificate()
+		}
+		s.SaveBlock(blk, cert)
+
+		// The previous block should be written before saving the next one.
+		if err := s.WriteBatch(); err != nil {
+			return nil, err
+		}
+	}
+
+	for addr, pub := range pubKeys {
+		s.batch.Put(publicKeyKey(addr), pub.Bytes())
+	}
+	for addr, acc := range accs {
+		s.accountStore.updateAccount(s.batch, addr, acc)
+	}
+	for _, val := range vals {
+		s.validatorStore.updateValidator(s.batch, val)
+	}
+	s.batch.Put(snapshotHeightKey, util.Uint32ToSlice(lastCert.Height()))
+	if err := s.WriteBatch(); err != nil {
+		return nil, err
+	}
+
+	// The archived history starts from the snapshot height.
+	if conf.Archival {
+		if err := s.archiveState(lastCert.Height()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &SnapshotInfo{
+		Height:       lastCert.Height(),
+		StateRoot:    stateRoot,
+		AccountsRoot: trustedAccountsRoot,
+		NumBlocks:    len(blocks),
+	}, nil
+}
+
+func verifySnapshot(trustedStateRoot, trustedAccountsRoot, stateRoot hash.Hash, lastCert *certificate.Certificate,
+	blocks []*block.Block, pubKeys map[crypto.Address]*bls.PublicKey,
+	accs map[crypto.Address]*account.Account, vals []*validator.Validator,
+) error {
+	// The state root of the archive can't be trusted by itself,
+	// since it is written by the same party that has written the state.
+	if stateRoot != trustedStateRoot {
+		return SnapshotError{
+			Reason: "snapshot doesn't match the trusted state root",
+		}
+	}
+
+	accNumbers := make(map[int32]bool, len(accs))
+	for _, acc := range accs {
+		if acc.Number() < 0 || int(acc.Number()) >= len(accs) {
+			return SnapshotError{
+				Reason: fmt.Sprintf("account number is out of range: %d", acc.Number()),
+			}
+		}
+		if accNumbers[acc.Number()] {
+			return SnapshotError{
+				Reason: fmt.Sprintf("duplicated account number: %d", acc.Number()),
+			}
+		}
+		accNumbers[acc.Number()] = true
+	}
+
+	valByNumber := make(map[int32]*validator.Validator, len(vals))
+	for _, val := range vals {
+		if val.Number() < 0 || int(val.Number()) >= len(vals) {
+			return SnapshotError{
+				Reason: fmt.Sprintf("validator number is out of range: %d", val.Number()),
+			}
+		}
+		if _, ok := valByNumber[val.Number()]; ok {
+			return SnapshotError{
+				Reason: fmt.Sprintf("duplicated validator number: %d", val.Number()),
+			}
+		}
+		valByNumber[val.Number()] = val
+	}
+
+	if calcStateRoot(accs, vals) != trustedStateRoot {
+		return SnapshotError{
+			Reason: "state root mismatch",
+		}
+	}
+
+	// The validators are bound to their addresses by their public keys, but the accounts are not.
+	if calcAccountsRoot(accs) != trustedAccountsRoot {
+		return SnapshotError{
+			Reason: "snapshot doesn't match the trusted accounts root",
+		}
+	}
+
+	for addr, pub := range pubKeys {
+		if pub.AccountAddress() != addr && pub.ValidatorAddress() != addr {
+			return SnapshotError{
+				Reason: fmt.Sprintf("public key doesn't match the address: %s", addr),
+			}
+		}
+	}
+
+	fromHeight := lastCert.Height() - uint32(len(blocks)) + 1
+	for i, blk := range blocks {
+		if err := blk.BasicCheck(); err != nil {
+			return err
+		}
+
+		if i > 0 {
+			prevBlock := blocks[i-1]
+			if blk.Header().PrevBlockHash() != prevBlock.Hash() {
+				return SnapshotError{
+					Reason: fmt.Sprintf("invalid previous block hash at height %d", fromHeight+uint32(i)),
+				}
+			}
+
+			prevCert := blk.PrevCertificate()
+			if prevCert == nil || prevCert.Height() != fromHeight+uint32(i)-1 {
+				return SnapshotError{
+					Reason: fmt.Sprintf("invalid previous certificate at height %d", fromHeight+uint32(i)),
+				}
+			}
+		}
+	}
+
+	// The validators are trusted now, so the last certificate proves the last block,
+	// and the previous blocks are proved by their hashes.
+	committers := make([]*validator.Validator, 0, len(lastCert.Committers()))
+	for _, num := range lastCert.Committers() {
+		val, ok := valByNumber[num]
+		if !ok {
+			return SnapshotError{
+				Reason: fmt.Sprintf("committee member not found: %d", num),
+			}
+		}
+		committers = append(committers, val)
+	}
+
+	lastBlock := blocks[len(blocks)-1]
+	signBytes := certificate.BlockCertificateSignBytes(lastBlock.Hash(), lastCert.Height(), lastCert.Round())
+
+	return lastCert.Validate(lastCert.Height(), committers, signBytes)
+}
+
+// snapshotState returns the accounts and validators of the store.
+func (s *store) snapshotState() (map[crypto.Address]*account.Account, []*validator.Validator) {
+	accs := make(map[crypto.Address]*account.Account, s.TotalAccounts())
+	s.IterateAccounts(func(addr crypto.Address, acc *account.Account) bool {
+		accs[addr] = acc
+
+		return false
+	})
+
+	vals := make([]*validator.Validator, 0, s.TotalValidators())
+	s.IterateValidators(func(val *validator.Validator) bool {
+		vals = append(vals, val)
+
+		return false
+	})
+
+	return accs, vals
+}
+
+func (s *store) writeSnapshotPublicKeys(w io.Writer) error {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	type entry struct {
+		addr crypto.Address
+		data []byte
+	}
+	entries := make([]entry, 0)
+	iter := s.db.NewIterator(publicKeyPrefix)
+	for iter.Next() {
+		addr := crypto.Address{}
+		copy(addr[:], iter.Key()[len(publicKeyPrefix):])
+		entries = append(entries, entry{
+			addr: addr,
+			data: append([]byte{}, iter.Value()...),
+		})
+	}
+	iter.Release()
+
+	err := encoding.WriteVarInt(w, uint64(len(entries)))
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		err = e.addr.Encode(w)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(e.data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// calcAccountsRoot calculates the merkle root of the accounts alongside their addresses.
+// Each leaf is the hash of the address and the hash of the account.
+func calcAccountsRoot(accs map[crypto.Address]*account.Account) hash.Hash {
+	leaves := make([]hash.Hash, len(accs))
+	for addr, acc := range accs {
+		accHash := acc.Hash()
+		leaves[acc.Number()] = hash.CalcHash(append(addr.Bytes(), accHash.Bytes()...))
+	}
+
+	return simplemerkle.NewTreeFromHashes(leaves).Root()
+}
+
+// snapshotHeight returns the height of the imported snapshot, if the store is imported from one.
+func (s *store) snapshotHeight() (uint32, bool) {
+	data, err := tryGet(s.db, snapshotHeightKey)
+	if err != nil {
+		return 0, false
+	}
+
+	return util.SliceToUint32(data), true
+}
+
+// calcStateRoot calculates the state root in the same way as the state module does.
+func calcStateRoot(accs map[crypto.Address]*account.Account, vals []*validator.Validator) hash.Hash {
+	accHashes := make([]hash.Hash, len(accs))
+	valHashes := make([]hash.Hash, len(vals))
+	for _, acc := range accs {
+		accHashes[acc.Number()] = acc.Hash()
+	}
+	for _, val := range vals {
+		valHashes[val.Number()] = val.Hash()
+	}
+
+	accRoot := simplemerkle.NewTreeFromHashes(accHashes).Root()
+	valRoot := simplemerkle.NewTreeFromHashes(valHashes).Root()
+
+	return *simplemerkle.HashMerkleBranches(&accRoot, &valRoot)
+}
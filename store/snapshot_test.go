@@ -0,0 +1,213 @@
+package store
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// makeSnapshotTestStore creates a store with a chain of blocks that are certified by the validators.
+func makeSnapshotTestStore(t *testing.T, ts *testsuite.TestSuite, conf *Config, numBlocks uint32) *store {
+	t.Helper()
+
+	str, err := NewStore(conf)
+	require.NoError(t, err)
+	s := str.(*store)
+
+	valKeys := make([]*bls.ValidatorKey, 0, 4)
+	for i := int32(0); i < 4; i++ {
+		valKey := ts.RandValKey()
+		valKeys = append(valKeys, valKey)
+		s.UpdateValidator(validator.NewValidator(valKey.PublicKey(), i))
+	}
+	for i := 0; i < 3; i++ {
+		acc, addr := ts.GenerateTestAccount(int32(i))
+		s.UpdateAccount(addr, acc)
+	}
+
+	prevHash := hash.UndefHash
+	var prevCert *certificate.Certificate
+	for height := uint32(1); height <= numBlocks; height++ {
+		trx, _ := ts.GenerateTestTransferTx()
+		blk := block.MakeBlock(1, util.Now(), block.Txs{trx}, prevHash, ts.RandHash(),
+			prevCert, ts.RandSeed(), valKeys[0].Address())
+
+		signBytes := certificate.BlockCertificateSignBytes(blk.Hash(), height, 0)
+		sigs := make([]*bls.Signature, 0, len(valKeys))
+		for _, valKey := range valKeys {
+			sigs = append(sigs, valKey.Sign(signBytes))
+		}
+		cert := certificate.NewCertificate(height, 0, []int32{0, 1, 2, 3}, []int32{},
+			bls.SignatureAggregate(sigs...))
+
+		s.SaveBlock(blk, cert)
+		require.NoError(t, s.WriteBatch())
+
+		prevHash = blk.Hash()
+		prevCert = cert
+	}
+
+	return s
+}
+
+func TestSnapshot(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	exportConf := testConfig()
+	exportConf.TxCacheSize = 2
+	exportConf.SortitionCacheSize = 2
+	exportStore := makeSnapshotTestStore(t, ts, exportConf, 5)
+	lastCert := exportStore.LastCertificate()
+	committedBlock, _ := exportStore.Block(5)
+	lastBlock, _ := committedBlock.ToBlock()
+	signer := lastBlock.Transactions()[0].Payload().Signer()
+	require.NoError(t, exportStore.Close())
+
+	genesisHash := ts.RandHash()
+	buf := new(bytes.Buffer)
+	exportInfo, err := ExportSnapshot(exportConf, genesisHash, buf)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(5), exportInfo.Height)
+	assert.Equal(t, 3, exportInfo.NumBlocks)
+
+	t.Run("Snapshot belongs to another network", func(t *testing.T) {
+		conf := testConfig()
+		_, err := ImportSnapshot(conf, ts.RandHash(), exportInfo.StateRoot, exportInfo.AccountsRoot, bytes.NewReader(buf.Bytes()))
+		assert.ErrorIs(t, err, SnapshotError{Reason: "snapshot belongs to another network"})
+	})
+
+	t.Run("Snapshot doesn't match the trusted state root", func(t *testing.T) {
+		conf := testConfig()
+		_, err := ImportSnapshot(conf, genesisHash, ts.RandHash(), exportInfo.AccountsRoot, bytes.NewReader(buf.Bytes()))
+		assert.ErrorIs(t, err, SnapshotError{Reason: "snapshot doesn't match the trusted state root"})
+
+		str, err := NewStore(conf)
+		require.NoError(t, err)
+		assert.Nil(t, str.LastCertificate())
+		require.NoError(t, str.Close())
+	})
+
+	t.Run("Snapshot doesn't match the trusted accounts root", func(t *testing.T) {
+		conf := testConfig()
+		_, err := ImportSnapshot(conf, genesisHash, exportInfo.StateRoot, ts.RandHash(), bytes.NewReader(buf.Bytes()))
+		assert.ErrorIs(t, err, SnapshotError{Reason: "snapshot doesn't match the trusted accounts root"})
+	})
+
+	t.Run("Store is not empty", func(t *testing.T) {
+		_, err := ImportSnapshot(exportConf, genesisHash, exportInfo.StateRoot, exportInfo.AccountsRoot, bytes.NewReader(buf.Bytes()))
+		assert.ErrorIs(t, err, SnapshotError{Reason: "store is not empty"})
+	})
+
+	t.Run("Import snapshot", func(t *testing.T) {
+		conf := testConfig()
+		conf.TxCacheSize = 2
+		conf.SortitionCacheSize = 2
+		importInfo, err := ImportSnapshot(conf, genesisHash, exportInfo.StateRoot, exportInfo.AccountsRoot, bytes.NewReader(buf.Bytes()))
+		require.NoError(t, err)
+		assert.Equal(t, exportInfo, importInfo)
+
+		str, err := NewStore(conf)
+		require.NoError(t, err)
+		s := str.(*store)
+
+		assert.Equal(t, lastCert.Hash(), s.LastCertificate().Hash())
+		assert.True(t, s.IsPruned())
+		assert.Equal(t, uint32(2), s.PruningHeight())
+		assert.Equal(t, lastBlock.Hash(), s.BlockHash(5))
+		assert.Equal(t, hash.UndefHash, s.BlockHash(2))
+		assert.NotNil(t, s.SortitionSeed(5))
+		assert.True(t, s.AnyRecentTransaction(lastBlock.Transactions()[0].ID()))
+
+		_, err = s.PublicKey(signer)
+		assert.NoError(t, err)
+
+		// The supply counters and the rewards are recorded from the first block of the snapshot,
+		// and the scores are recorded from the previous certificate of the first block.
+		assert.Equal(t, uint32(3), s.SupplyCounters().FromHeight)
+		summary, _ := s.ValidatorRewards(lastBlock.Header().ProposerAddress(), 0, 1)
+		assert.Equal(t, uint32(3), summary.FromHeight)
+		startHeight, _ := s.scoreStore.startHeight()
+		assert.Equal(t, uint32(2), startHeight)
+
+		accs, vals := s.snapshotState()
+		assert.Len(t, accs, 3)
+		assert.Len(t, vals, 4)
+		assert.Equal(t, exportInfo.StateRoot, calcStateRoot(accs, vals))
+		assert.Equal(t, exportInfo.AccountsRoot, calcAccountsRoot(accs))
+		require.NoError(t, s.Close())
+
+		_, err = Rollback(conf, 4)
+		assert.ErrorIs(t, err, RollbackError{
+			Reason: "state is imported from a snapshot at height 5 and can't be rolled back before it",
+		})
+	})
+
+	t.Run("Import snapshot in archival mode", func(t *testing.T) {
+		conf := testConfig()
+		conf.TxCacheSize = 2
+		conf.SortitionCacheSize = 2
+		conf.Archival = true
+		_, err := ImportSnapshot(conf, genesisHash, exportInfo.StateRoot, exportInfo.AccountsRoot, bytes.NewReader(buf.Bytes()))
+		require.NoError(t, err)
+
+		str, err := NewStore(conf)
+		require.NoError(t, err)
+
+		val, err := str.ValidatorByNumber(0)
+		require.NoError(t, err)
+		archivedVal, err := str.ValidatorAtHeight(val.Address(), 5)
+		assert.NoError(t, err)
+		assert.Equal(t, val.Hash(), archivedVal.Hash())
+
+		_, err = str.ValidatorAtHeight(val.Address(), 4)
+		assert.ErrorIs(t, err, ArchivedHeightError{Height: 4, StartHeight: 5, LastHeight: 5})
+	})
+}
+
+func TestVerifySnapshotAddresses(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conf := testConfig()
+	s := makeSnapshotTestStore(t, ts, conf, 1)
+	accs, vals := s.snapshotState()
+	stateRoot := calcStateRoot(accs, vals)
+	accountsRoot := calcAccountsRoot(accs)
+	lastCert := s.LastCertificate()
+	require.NoError(t, s.Close())
+
+	t.Run("Accounts are reassigned to other addresses", func(t *testing.T) {
+		reassigned := make(map[crypto.Address]*account.Account, len(accs))
+		addrs := make([]crypto.Address, 0, len(accs))
+		for addr := range accs {
+			addrs = append(addrs, addr)
+		}
+		for i, addr := range addrs {
+			reassigned[addrs[(i+1)%len(addrs)]] = accs[addr]
+		}
+
+		// The state root doesn't commit to the addresses, so it still matches.
+		assert.Equal(t, stateRoot, calcStateRoot(reassigned, vals))
+
+		err := verifySnapshot(stateRoot, accountsRoot, stateRoot, lastCert, nil, nil, reassigned, vals)
+		assert.ErrorIs(t, err, SnapshotError{Reason: "snapshot doesn't match the trusted accounts root"})
+	})
+
+	t.Run("Public key doesn't match the address", func(t *testing.T) {
+		addr := ts.RandAccAddress()
+		pubKeys := map[crypto.Address]*bls.PublicKey{addr: ts.RandValKey().PublicKey()}
+
+		err := verifySnapshot(stateRoot, accountsRoot, stateRoot, lastCert, nil, pubKeys, accs, vals)
+		assert.ErrorIs(t, err, SnapshotError{Reason: "public key doesn't match the address: " + addr.String()})
+	})
+}
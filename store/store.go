@@ -3,45 +3,70 @@ package store
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"sync"
 
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/sortition"
+	"github.com/pactus-project/pactus/store/kv"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/encoding"
 	"github.com/pactus-project/pactus/util/logger"
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/opt"
 )
 
 var (
 	ErrNotFound  = errors.New("not found")
 	ErrBadOffset = errors.New("offset is out of range")
+
+	ErrNotArchival = errors.New("store is not in archival mode")
+
+	ErrUnwrittenChanges = errors.New("there are changes that are not written yet")
 )
 
+// lastStoreVersion is the version of the current on-disk format.
+// Changing the format requires increasing this version and registering a migration.
 const (
-	lastStoreVersion = int32(1)
+	lastStoreVersion = int32(5)
 )
 
 var (
 	lastInfoKey       = []byte{0x00}
 	blockPrefix       = []byte{0x01}
+	historyHeightKey  = []byte{0x02}
+	prunedHeightKey   = []byte{0x04}
+	archiveHeightKey  = []byte{0x06}
+	scoreHeightKey    = []byte{0x08}
+	scoreStartKey     = []byte{0x0a}
+	supplyKey         = []byte{0x0c}
+	rewardHeightKey   = []byte{0x0e}
+	rewardStartKey    = []byte{0x10}
+	snapshotHeightKey = []byte{0x12}
 	txPrefix          = []byte{0x03}
 	accountPrefix     = []byte{0x05}
 	validatorPrefix   = []byte{0x07}
 	blockHeightPrefix = []byte{0x09}
 	publicKeyPrefix   = []byte{0x0b}
+	historyPrefix     = []byte{0x0d}
+	undoPrefix        = []byte{0x0f}
+
+	accountVersionPrefix   = []byte{0x11}
+	validatorVersionPrefix = []byte{0x13}
+	receiptPrefix          = []byte{0x15}
+	scorePrefix            = []byte{0x17}
+	rewardPrefix           = []byte{0x19}
+	rewardSummaryPrefix    = []byte{0x1b}
 )
 
-func tryGet(db *leveldb.DB, key []byte) ([]byte, error) {
-	data, err := db.Get(key, nil)
+func tryGet(db kv.Reader, key []byte) ([]byte, error) {
+	data, err := db.Get(key)
 	if err != nil {
 		// Probably key doesn't exist in database
 		logger.Trace("database `get` error", "error", err, "key", key)
@@ -52,8 +77,8 @@ func tryGet(db *leveldb.DB, key []byte) ([]byte, error) {
 	return data, nil
 }
 
-func tryHas(db *leveldb.DB, key []byte) bool {
-	ok, err := db.Has(key, nil)
+func tryHas(db kv.Reader, key []byte) bool {
+	ok, err := db.Has(key)
 	if err != nil {
 		logger.Error("database `has` error", "error", err, "key", key)
 
@@ -63,36 +88,76 @@ func tryHas(db *leveldb.DB, key []byte) bool {
 	return ok
 }
 
+func openDB(conf *Config) (kv.DB, error) {
+	switch conf.Engine {
+	case EngineLevelDB:
+		return kv.NewLevelDB(conf.StorePath(), conf.ReadOnly)
+	case EnginePebble:
+		return kv.NewPebbleDB(conf.StorePath(), conf.ReadOnly)
+	case EngineMemory:
+		return kv.NewMemoryDB(), nil
+	default:
+		return nil, ConfigError{
+			Reason: fmt.Sprintf("unknown storage engine: %s", conf.Engine),
+		}
+	}
+}
+
 type store struct {
 	lk sync.RWMutex
 
 	config         *Config
-	db             *leveldb.DB
-	batch          *leveldb.Batch
+	db             kv.DB
+	batch          kv.Batch
 	blockStore     *blockStore
 	txStore        *txStore
 	accountStore   *accountStore
 	validatorStore *validatorStore
+	historyStore   *historyStore
+	undoStore      *undoStore
+	archiveStore   *archiveStore
+	receiptStore   *receiptStore
+	scoreStore     *scoreStore
+	supplyStore    *supplyStore
+	rewardStore    *rewardStore
+	prunedHeight   uint32
+	// pendingPrunedHeight is the height of the last block that is pruned in the batch.
+	// It becomes the pruned height once the batch is written.
+	pendingPrunedHeight uint32
 }
 
 func NewStore(conf *Config) (Store, error) {
-	options := &opt.Options{
-		Strict:      opt.DefaultStrict,
-		Compression: opt.NoCompression,
-	}
-
-	db, err := leveldb.OpenFile(conf.StorePath(), options)
+	db, err := openDB(conf)
 	if err != nil {
 		return nil, err
 	}
+	validatorStore := newValidatorStore(db)
 	s := &store{
 		config:         conf,
 		db:             db,
-		batch:          new(leveldb.Batch),
+		batch:          db.NewBatch(),
 		blockStore:     newBlockStore(db, conf.SortitionCacheSize, conf.PublicKeyCacheSize),
 		txStore:        newTxStore(db, conf.TxCacheSize),
 		accountStore:   newAccountStore(db, conf.AccountCacheSize),
-		validatorStore: newValidatorStore(db),
+		validatorStore: validatorStore,
+		historyStore:   newHistoryStore(db),
+		undoStore:      newUndoStore(db),
+		archiveStore:   newArchiveStore(db),
+		receiptStore:   newReceiptStore(db),
+		scoreStore:     newScoreStore(db),
+		supplyStore:    newSupplyStore(db),
+		rewardStore:    newRewardStore(db, validatorStore),
+	}
+
+	data, err := tryGet(db, prunedHeightKey)
+	if err == nil {
+		s.prunedHeight = util.SliceToUint32(data)
+	}
+
+	if err := s.prepareArchive(); err != nil {
+		_ = db.Close()
+
+		return nil, err
 	}
 
 	lc := s.LastCertificate()
@@ -101,18 +166,40 @@ func NewStore(conf *Config) (Store, error) {
 	}
 
 	currentHeight := lc.Height()
+	if err := s.migrate(currentHeight); err != nil {
+		_ = db.Close()
+
+		return nil, err
+	}
+
+	// A read-only store can't be updated, so the maintenance tasks are skipped.
+	if !conf.ReadOnly {
+		if err := s.pruneBlocks(currentHeight); err != nil {
+			_ = db.Close()
+
+			return nil, err
+		}
+	}
+
 	startHeight := uint32(1)
 	if currentHeight > conf.TxCacheSize {
 		startHeight = currentHeight - conf.TxCacheSize
 	}
+	if startHeight <= s.prunedHeight {
+		startHeight = s.prunedHeight + 1
+	}
 
 	for i := startHeight; i < currentHeight+1; i++ {
 		committedBlock, err := s.Block(i)
 		if err != nil {
+			_ = db.Close()
+
 			return nil, err
 		}
 		blk, err := committedBlock.ToBlock()
 		if err != nil {
+			_ = db.Close()
+
 			return nil, err
 		}
 
@@ -128,6 +215,262 @@ func NewStore(conf *Config) (Store, error) {
 	return s, nil
 }
 
+// indexHistory builds the address history index for the blocks that are not indexed yet.
+// This happens when the database is created by an older version of the node.
+// The indexed height is kept in the database, so indexing can resume after interruption.
+func (s *store) indexHistory(currentHeight uint32) error {
+	indexedHeight, _ := s.historyStore.indexedHeight()
+	if indexedHeight >= currentHeight {
+		return nil
+	}
+
+	logger.Info("indexing transaction history", "from", indexedHeight+1, "to", currentHeight)
+	for height := indexedHeight + 1; height <= currentHeight; height++ {
+		data, err := s.blockStore.block(height)
+		if err != nil {
+			return err
+		}
+		blk, err := block.FromBytes(data[hash.HashSize:])
+		if err != nil {
+			return err
+		}
+		s.historyStore.indexTxs(s.batch, height, blk.Transactions())
+
+		// Write the batch periodically to keep memory usage bounded.
+		// The index height is written in the same batch, so indexing can resume after interruption.
+		if height%1000 == 0 || height == currentHeight {
+			if err := s.WriteBatch(); err != nil {
+				return err
+			}
+			logger.Debug("transaction history indexed", "height", height)
+		}
+	}
+
+	return nil
+}
+
+// recordAvailability records the participation counters for the certificates
+// of the stored blocks that are not recorded yet.
+// This happens when the database is created by an older version of the node.
+// The counters of the pruned blocks can't be recorded.
+func (s *store) recordAvailability(currentHeight uint32) error {
+	// The block at a height holds the certificate of the previous block.
+	fromHeight := util.Max(s.prunedHeight+1, 2)
+	if recordedHeight, ok := s.scoreStore.recordedHeight(); ok {
+		fromHeight = recordedHeight + 2
+	}
+	if fromHeight > currentHeight {
+		return nil
+	}
+
+	logger.Info("recording availability of validators", "from", fromHeight, "to", currentHeight)
+	for height := fromHeight; height <= currentHeight; height++ {
+		data, err := s.blockStore.block(height)
+		if err != nil {
+			return err
+		}
+		blk, err := block.FromBytes(data[hash.HashSize:])
+		if err != nil {
+			return err
+		}
+		s.scoreStore.saveCertificate(s.batch, blk.PrevCertificate())
+
+		// Write the batch periodically to keep memory usage bounded.
+		// The recorded height is written in the same batch, so recording can resume after interruption.
+		if height%1000 == 0 || height == currentHeight {
+			if err := s.WriteBatch(); err != nil {
+				return err
+			}
+			logger.Debug("availability recorded", "height", height)
+		}
+	}
+
+	return nil
+}
+
+// recordSupply counts the fees and block rewards of the stored blocks that are not counted yet.
+// This happens when the database is created by an older version of the node.
+// The pruned blocks can't be counted.
+func (s *store) recordSupply(currentHeight uint32) error {
+	fromHeight := s.prunedHeight + 1
+	if counters := s.supplyStore.counters; counters.FromHeight > 0 {
+		fromHeight = counters.ToHeight + 1
+	}
+	if fromHeight > currentHeight {
+		return nil
+	}
+
+	logger.Info("recording supply counters", "from", fromHeight, "to", currentHeight)
+	for height := fromHeight; height <= currentHeight; height++ {
+		data, err := s.blockStore.block(height)
+		if err != nil {
+			return err
+		}
+		blk, err := block.FromBytes(data[hash.HashSize:])
+		if err != nil {
+			return err
+		}
+		s.supplyStore.saveBlock(s.batch, height, blk)
+
+		// Write the batch periodically to keep memory usage bounded.
+		// The counted height is written in the same batch, so recording can resume after interruption.
+		if height%1000 == 0 || height == currentHeight {
+			if err := s.WriteBatch(); err != nil {
+				return err
+			}
+			logger.Debug("supply counters recorded", "height", height)
+		}
+	}
+
+	return nil
+}
+
+// indexRewards records the rewards of the stored blocks that are not recorded yet.
+// This happens when the database is created by an older version of the node.
+// The rewards of the pruned blocks can't be recorded.
+func (s *store) indexRewards(currentHeight uint32) error {
+	fromHeight := s.prunedHeight + 1
+	if indexedHeight, ok := s.rewardStore.indexedHeight(); ok {
+		fromHeight = indexedHeight + 1
+	}
+	if fromHeight > currentHeight {
+		return nil
+	}
+
+	logger.Info("indexing validator rewards", "from", fromHeight, "to", currentHeight)
+	for height := fromHeight; height <= currentHeight; height++ {
+		data, err := s.blockStore.block(height)
+		if err != nil {
+			return err
+		}
+		blk, err := block.FromBytes(data[hash.HashSize:])
+		if err != nil {
+			return err
+		}
+		s.rewardStore.saveBlock(s.batch, height, blk)
+
+		// Write the batch periodically to keep memory usage bounded.
+		// The indexed height is written in the same batch, so indexing can resume after interruption.
+		if height%1000 == 0 || height == currentHeight {
+			if err := s.WriteBatch(); err != nil {
+				return err
+			}
+			logger.Debug("validator rewards indexed", "height", height)
+		}
+	}
+
+	return nil
+}
+
+// prepareArchive starts archiving the state when archival mode is enabled.
+// The current state is archived first, so the history is available from the current height.
+// When archival mode is disabled, the archive start height is removed,
+// so enabling it again restarts the history.
+func (s *store) prepareArchive() error {
+	if s.config.ReadOnly {
+		return nil
+	}
+
+	_, started := s.archiveStore.startHeight()
+	if s.config.Archival == started {
+		return nil
+	}
+
+	if !s.config.Archival {
+		s.batch.Delete(archiveHeightKey)
+
+		return s.WriteBatch()
+	}
+
+	currentHeight := uint32(0)
+	if _, lc := s.lastInfo(); lc != nil {
+		currentHeight = lc.Height()
+	}
+
+	return s.archiveState(currentHeight)
+}
+
+// archiveState archives the current state of all accounts and validators at the given height,
+// and starts the archived history from this height.
+func (s *store) archiveState(height uint32) error {
+	logger.Info("archiving the current state", "height", height)
+
+	s.accountStore.iterateAccounts(func(addr crypto.Address, acc *account.Account) bool {
+		data, _ := acc.Bytes()
+		s.archiveStore.recordAccount(addr, data)
+
+		return false
+	})
+	s.validatorStore.iterateValidators(func(val *validator.Validator) bool {
+		data, _ := val.Bytes()
+		s.archiveStore.recordValidator(val.Address(), data)
+
+		return false
+	})
+	s.archiveStore.saveVersions(s.batch, height)
+	s.batch.Put(archiveHeightKey, util.Uint32ToSlice(height))
+
+	return s.WriteBatch()
+}
+
+// pruneBlocks removes the blocks that are out of the retention window.
+// This happens when the node restarts in pruning mode or the retention window is reduced.
+func (s *store) pruneBlocks(currentHeight uint32) error {
+	if !s.config.IsPruned() || currentHeight <= s.config.RetentionBlocks {
+		return nil
+	}
+
+	pruneHeight := currentHeight - s.config.RetentionBlocks
+	if pruneHeight <= s.prunedHeight {
+		return nil
+	}
+
+	logger.Info("pruning old blocks", "from", s.prunedHeight+1, "to", pruneHeight)
+	for height := s.prunedHeight + 1; height <= pruneHeight; height++ {
+		if err := s.pruneBlock(height); err != nil {
+			return err
+		}
+
+		// Write the batch periodically to keep memory usage bounded.
+		if height%1000 == 0 || height == pruneHeight {
+			if err := s.WriteBatch(); err != nil {
+				return err
+			}
+			logger.Debug("blocks pruned", "height", height)
+		}
+	}
+
+	return nil
+}
+
+// pruneBlock removes the block at the given height, alongside its transactions
+// and their address history entries.
+// Public keys are kept, since they are needed for verifying the future transactions.
+func (s *store) pruneBlock(height uint32) error {
+	data, err := s.blockStore.block(height)
+	if err != nil {
+		return err
+	}
+	blockHash, err := hash.FromBytes(data[0:hash.HashSize])
+	if err != nil {
+		return err
+	}
+	blk, err := block.FromBytes(data[hash.HashSize:])
+	if err != nil {
+		return err
+	}
+
+	s.blockStore.deleteBlock(s.batch, height, blockHash)
+	s.txStore.deleteTxs(s.batch, blk.Transactions())
+	s.receiptStore.deleteReceipts(s.batch, blk.Transactions())
+	s.historyStore.deleteTxs(s.batch, height, blk.Transactions())
+	s.undoStore.deleteUndo(s.batch, height)
+	s.batch.Put(prunedHeightKey, util.Uint32ToSlice(height))
+	s.pendingPrunedHeight = height
+
+	return nil
+}
+
 func (s *store) Close() error {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -140,13 +483,42 @@ func (s *store) SaveBlock(blk *block.Block, cert *certificate.Certificate) {
 	defer s.lk.Unlock()
 
 	height := cert.Height()
+	// The previous block should exist, unless it is pruned.
+	if height > s.prunedHeight+1 && !s.blockStore.hasBlock(height-1) {
+		logger.Panic("previous block not found", "height", height)
+	}
+
 	regs := s.blockStore.saveBlock(s.batch, height, blk)
 	s.txStore.saveTxs(s.batch, blk.Transactions(), regs)
 	s.txStore.pruneCache(height)
+	s.historyStore.indexTxs(s.batch, height, blk.Transactions())
+	if prevCert := blk.PrevCertificate(); prevCert != nil {
+		s.scoreStore.saveCertificate(s.batch, prevCert)
+	}
+	s.supplyStore.saveBlock(s.batch, height, blk)
+	s.rewardStore.saveBlock(s.batch, height, blk)
+	s.undoStore.saveUndo(s.batch, height)
+	if s.config.Archival {
+		s.archiveStore.saveVersions(s.batch, height)
+	}
+
+	if s.config.IsPruned() && height > s.config.RetentionBlocks {
+		for h := util.Max(s.prunedHeight, s.pendingPrunedHeight) + 1; h <= height-s.config.RetentionBlocks; h++ {
+			if err := s.pruneBlock(h); err != nil {
+				logger.Error("unable to prune the block", "height", h, "error", err)
 
+				break
+			}
+		}
+	}
+
+	s.saveLastInfo(lastStoreVersion, cert)
+}
+
+func (s *store) saveLastInfo(version int32, cert *certificate.Certificate) {
 	// Save last certificate: [version: 4 bytes]+[certificate: variant]
 	w := bytes.NewBuffer(make([]byte, 0, 4+cert.SerializeSize()))
-	err := encoding.WriteElements(w, lastStoreVersion)
+	err := encoding.WriteElements(w, version)
 	if err != nil {
 		panic(err)
 	}
@@ -162,7 +534,11 @@ func (s *store) Block(height uint32) (*CommittedBlock, error) {
 	s.lk.Lock()
 	defer s.lk.Unlock()
 
-	data, err := s.blockStore.block(height)
+	return readCommittedBlock(s.db, s, height)
+}
+
+func readCommittedBlock(db kv.Reader, pubKeys publicKeyReader, height uint32) (*CommittedBlock, error) {
+	data, err := tryGet(db, blockKey(height))
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +549,7 @@ func (s *store) Block(height uint32) (*CommittedBlock, error) {
 	}
 
 	return &CommittedBlock{
-		store:     s,
+		store:     pubKeys,
 		BlockHash: blockHash,
 		Height:    height,
 		Data:      data[hash.HashSize:],
@@ -219,11 +595,15 @@ func (s *store) Transaction(id tx.ID) (*CommittedTx, error) {
 	s.lk.Lock()
 	defer s.lk.Unlock()
 
-	pos, err := s.txStore.tx(id)
+	return readCommittedTx(s.db, s, id)
+}
+
+func readCommittedTx(db kv.Reader, pubKeys publicKeyReader, id tx.ID) (*CommittedTx, error) {
+	pos, err := readTxRegion(db, id)
 	if err != nil {
 		return nil, err
 	}
-	data, err := s.blockStore.block(pos.height)
+	data, err := tryGet(db, blockKey(pos.height))
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +615,7 @@ func (s *store) Transaction(id tx.ID) (*CommittedTx, error) {
 	blockTime := util.SliceToUint32(data[hash.HashSize+1 : hash.HashSize+5])
 
 	return &CommittedTx{
-		store:     s,
+		store:     pubKeys,
 		TxID:      id,
 		Height:    pos.height,
 		BlockTime: blockTime,
@@ -243,6 +623,67 @@ func (s *store) Transaction(id tx.ID) (*CommittedTx, error) {
 	}, nil
 }
 
+// SaveReceipt saves the receipt of a transaction in the current batch.
+func (s *store) SaveReceipt(id tx.ID, rcpt *receipt.Receipt) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.receiptStore.saveReceipt(s.batch, id, rcpt)
+}
+
+func (s *store) Receipt(id tx.ID) (*receipt.Receipt, error) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.receiptStore.receipt(id)
+}
+
+// Availability returns the participation counters of the validator
+// in the certificates from the given height to the given height, inclusive.
+func (s *store) Availability(valNum int32, from, to uint32) (Availability, error) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.scoreStore.rangeAvailability(valNum, from, to)
+}
+
+// AvailabilityRange returns the heights of the first and the last certificates
+// that the participation counters are recorded for.
+func (s *store) AvailabilityRange() (uint32, uint32, bool) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.scoreStore.availabilityRange()
+}
+
+// SupplyCounters returns the cumulative fees and block rewards of the committed blocks.
+func (s *store) SupplyCounters() SupplyCounters {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.supplyStore.counters
+}
+
+// ValidatorRewards returns the cumulative rewards of the validator,
+// alongside its rewards per proposed block, starting from the most recent one.
+// The rewards are recorded from the height that is set in the summary.
+// The validator can be looked up by its current key or by one of its previous keys.
+// It skips the first `offset` rewards and returns up to `limit` rewards.
+func (s *store) ValidatorRewards(addr crypto.Address, offset, limit int) (RewardSummary, []ValidatorReward) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	num, ok := s.validatorStore.validatorNumber(addr)
+	if !ok {
+		return RewardSummary{}, []ValidatorReward{}
+	}
+
+	summary := s.rewardStore.summary(num)
+	summary.FromHeight, _ = s.rewardStore.startHeight()
+
+	return summary, s.rewardStore.rewards(num, offset, limit)
+}
+
 func (s *store) AnyRecentTransaction(id tx.ID) bool {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -250,6 +691,37 @@ func (s *store) AnyRecentTransaction(id tx.ID) bool {
 	return s.txStore.hasTX(id)
 }
 
+func (s *store) AddressTransactions(addr crypto.Address, offset, limit int) ([]*CommittedTx, error) {
+	s.lk.RLock()
+	ids := s.historyStore.transactionIDs(addr, offset, limit)
+	s.lk.RUnlock()
+
+	txs := make([]*CommittedTx, 0, len(ids))
+	for _, id := range ids {
+		committedTx, err := s.Transaction(id)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, committedTx)
+	}
+
+	return txs, nil
+}
+
+func (s *store) IsPruned() bool {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.config.IsPruned() || s.prunedHeight > 0
+}
+
+func (s *store) PruningHeight() uint32 {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.prunedHeight
+}
+
 func (s *store) HasAccount(addr crypto.Address) bool {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -282,9 +754,37 @@ func (s *store) UpdateAccount(addr crypto.Address, acc *account.Account) {
 	s.lk.Lock()
 	defer s.lk.Unlock()
 
+	var prevData []byte
+	if prevAcc, err := s.accountStore.account(addr); err == nil {
+		prevData, _ = prevAcc.Bytes()
+	}
+	s.undoStore.recordAccount(addr, prevData)
+	if s.config.Archival {
+		data, _ := acc.Bytes()
+		s.archiveStore.recordAccount(addr, data)
+	}
+
 	s.accountStore.updateAccount(s.batch, addr, acc)
 }
 
+// AccountAtHeight returns the state of the account after committing the block at the given height.
+// It is only available in archival mode.
+func (s *store) AccountAtHeight(addr crypto.Address, height uint32) (*account.Account, error) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	if err := s.checkArchivedHeight(height); err != nil {
+		return nil, err
+	}
+
+	data, err := s.archiveStore.account(addr, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return account.FromBytes(data)
+}
+
 func (s *store) HasValidator(addr crypto.Address) bool {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -313,6 +813,55 @@ func (s *store) ValidatorByNumber(num int32) (*validator.Validator, error) {
 	return s.validatorStore.validatorByNumber(num)
 }
 
+// ValidatorByPreviousKey returns the validator that has rotated out the key with the given address.
+func (s *store) ValidatorByPreviousKey(addr crypto.Address) (*validator.Validator, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.validatorStore.validatorByPreviousKey(addr)
+}
+
+// ValidatorAtHeight returns the state of the validator after committing the block at the given height.
+// It is only available in archival mode.
+func (s *store) ValidatorAtHeight(addr crypto.Address, height uint32) (*validator.Validator, error) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	if err := s.checkArchivedHeight(height); err != nil {
+		return nil, err
+	}
+
+	data, err := s.archiveStore.validator(addr, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return validator.FromBytes(data)
+}
+
+// checkArchivedHeight checks if the state at the given height is archived.
+func (s *store) checkArchivedHeight(height uint32) error {
+	if !s.config.Archival {
+		return ErrNotArchival
+	}
+
+	startHeight, _ := s.archiveStore.startHeight()
+	lastHeight := uint32(0)
+	if _, lc := s.lastInfo(); lc != nil {
+		lastHeight = lc.Height()
+	}
+
+	if height < startHeight || height > lastHeight {
+		return ArchivedHeightError{
+			Height:      height,
+			StartHeight: startHeight,
+			LastHeight:  lastHeight,
+		}
+	}
+
+	return nil
+}
+
 func (s *store) TotalValidators() int32 {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -331,43 +880,131 @@ func (s *store) UpdateValidator(acc *validator.Validator) {
 	s.lk.Lock()
 	defer s.lk.Unlock()
 
+	// If the validator has rotated its key, its previous address is removed.
+	if prevVal, err := s.validatorStore.validatorByNumber(acc.Number()); err == nil &&
+		prevVal.Address() != acc.Address() {
+		prevData, _ := prevVal.Bytes()
+		s.undoStore.recordValidator(prevVal.Address(), prevData)
+		if s.config.Archival {
+			s.archiveStore.recordValidator(prevVal.Address(), nil)
+		}
+	}
+
+	var prevData []byte
+	if prevVal, err := s.validatorStore.validator(acc.Address()); err == nil {
+		prevData, _ = prevVal.Bytes()
+	}
+	s.undoStore.recordValidator(acc.Address(), prevData)
+	if s.config.Archival {
+		data, _ := acc.Bytes()
+		s.archiveStore.recordValidator(acc.Address(), data)
+	}
+
 	s.validatorStore.updateValidator(s.batch, acc)
 }
 
+// PreviousState returns the accounts and validators that are changed by the block at the given height,
+// as they were before committing the block. A nil entry means that it didn't exist before.
+// It is read from the undo record of the block.
+func (s *store) PreviousState(height uint32) (
+	map[crypto.Address]*account.Account, map[crypto.Address]*validator.Validator, error,
+) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	rec, err := s.undoStore.undo(height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accs := make(map[crypto.Address]*account.Account, len(rec.accounts))
+	for _, entry := range rec.accounts {
+		if len(entry.data) == 0 {
+			accs[entry.addr] = nil
+
+			continue
+		}
+
+		acc, err := account.FromBytes(entry.data)
+		if err != nil {
+			return nil, nil, err
+		}
+		accs[entry.addr] = acc
+	}
+
+	vals := make(map[crypto.Address]*validator.Validator, len(rec.validators))
+	for _, entry := range rec.validators {
+		if len(entry.data) == 0 {
+			vals[entry.addr] = nil
+
+			continue
+		}
+
+		val, err := validator.FromBytes(entry.data)
+		if err != nil {
+			return nil, nil, err
+		}
+		vals[entry.addr] = val
+	}
+
+	return accs, vals, nil
+}
+
 func (s *store) LastCertificate() *certificate.Certificate {
 	s.lk.Lock()
 	defer s.lk.Unlock()
 
-	data, _ := tryGet(s.db, lastInfoKey)
+	_, cert := s.lastInfo()
+
+	return cert
+}
+
+// lastInfo returns the store version and the last certificate.
+// For an empty store, it returns nil.
+func (s *store) lastInfo() (int32, *certificate.Certificate) {
+	return readLastInfo(s.db)
+}
+
+func readLastInfo(db kv.Reader) (int32, *certificate.Certificate) {
+	data, _ := tryGet(db, lastInfoKey)
 	if data == nil {
 		// Genesis block
-		return nil
+		return 0, nil
 	}
 	r := bytes.NewReader(data)
 	version := int32(0)
 	cert := new(certificate.Certificate)
 	err := encoding.ReadElements(r, &version)
 	if err != nil {
-		return nil
+		return 0, nil
 	}
 	err = cert.Decode(r)
 	if err != nil {
-		return nil
+		return 0, nil
 	}
 
-	return cert
+	return version, cert
 }
 
 func (s *store) WriteBatch() error {
 	s.lk.Lock()
 	defer s.lk.Unlock()
 
-	if err := s.db.Write(s.batch, nil); err != nil {
+	// The state changes without a block belong to the genesis state.
+	if s.archiveStore.hasPending() {
+		s.archiveStore.saveVersions(s.batch, 0)
+	}
+
+	if err := s.db.Write(s.batch); err != nil {
 		// TODO: Should we panic here?
 		// The store is unreliable if the stored data does not match the cached data.
 		return err
 	}
 	s.batch.Reset()
+	s.undoStore.reset()
+	if s.pendingPrunedHeight > s.prunedHeight {
+		s.prunedHeight = s.pendingPrunedHeight
+	}
 
 	return nil
 }
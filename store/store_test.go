@@ -6,6 +6,7 @@ import (
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/testsuite"
@@ -22,6 +23,7 @@ type testData struct {
 func testConfig() *Config {
 	return &Config{
 		Path:               util.TempDirPath(),
+		Engine:             EngineLevelDB,
 		TxCacheSize:        1024,
 		SortitionCacheSize: 1024,
 		AccountCacheSize:   1024,
@@ -56,6 +58,42 @@ func setup(t *testing.T, config *Config) *testData {
 	return td
 }
 
+func TestMemoryEngine(t *testing.T) {
+	conf := testConfig()
+	conf.Engine = EngineMemory
+	td := setup(t, conf)
+
+	lastCert := td.store.LastCertificate()
+	assert.Equal(t, uint32(10), lastCert.Height())
+
+	committedBlock, err := td.store.Block(10)
+	require.NoError(t, err)
+	blk, err := committedBlock.ToBlock()
+	require.NoError(t, err)
+
+	committedTx, err := td.store.Transaction(blk.Transactions()[0].ID())
+	require.NoError(t, err)
+	assert.Equal(t, uint32(10), committedTx.Height)
+	assert.NoDirExists(t, conf.StorePath())
+}
+
+func TestPebbleEngine(t *testing.T) {
+	conf := testConfig()
+	conf.Engine = EnginePebble
+	td := setup(t, conf)
+
+	lastCert := td.store.LastCertificate()
+	assert.Equal(t, uint32(10), lastCert.Height())
+	require.NoError(t, td.store.Close())
+
+	// The data is persisted.
+	str, err := NewStore(conf)
+	require.NoError(t, err)
+	assert.Equal(t, lastCert.Hash(), str.LastCertificate().Hash())
+	assert.DirExists(t, conf.StorePath())
+	require.NoError(t, str.Close())
+}
+
 func TestBlockHash(t *testing.T) {
 	td := setup(t, nil)
 
@@ -114,6 +152,46 @@ func TestRetrieveBlockAndTransactions(t *testing.T) {
 	}
 }
 
+func TestReceipt(t *testing.T) {
+	conf := testConfig()
+	conf.RetentionBlocks = 4
+	td := setup(t, conf)
+
+	blk11, cert11 := td.GenerateTestBlock(11)
+	trx := blk11.Transactions()[0]
+	rcpt := &receipt.Receipt{
+		Fee: trx.Fee(),
+		Changes: []receipt.Change{
+			{Address: trx.Payload().Signer(), BalanceDelta: -td.RandAmount()},
+		},
+	}
+	td.store.SaveBlock(blk11, cert11)
+	td.store.SaveReceipt(trx.ID(), rcpt)
+	require.NoError(t, td.store.WriteBatch())
+
+	t.Run("Unknown transaction", func(t *testing.T) {
+		_, err := td.store.Receipt(td.RandHash())
+		assert.Error(t, err)
+	})
+
+	t.Run("Retrieve the receipt", func(t *testing.T) {
+		savedRcpt, err := td.store.Receipt(trx.ID())
+		assert.NoError(t, err)
+		assert.Equal(t, rcpt, savedRcpt)
+	})
+
+	t.Run("Receipts of pruned blocks are removed", func(t *testing.T) {
+		for height := uint32(12); height <= 15; height++ {
+			blk, cert := td.GenerateTestBlock(height)
+			td.store.SaveBlock(blk, cert)
+			require.NoError(t, td.store.WriteBatch())
+		}
+
+		_, err := td.store.Receipt(trx.ID())
+		assert.Error(t, err)
+	})
+}
+
 func TestIndexingPublicKeys(t *testing.T) {
 	td := setup(t, nil)
 
@@ -223,3 +301,132 @@ func TestStrippedPublicKey(t *testing.T) {
 		}
 	}
 }
+
+func TestMultisigPublicKeyNotStripped(t *testing.T) {
+	td := setup(t, nil)
+
+	pub, prvs := td.RandMultisigKey(2, 3)
+	lastCert := td.store.LastCertificate()
+	height := lastCert.Height() + 1
+
+	trxs := make(block.Txs, 0, 2)
+	for i := 0; i < 2; i++ {
+		trx := tx.NewTransferTx(height, pub.AccountAddress(), td.RandAccAddress(), 1, 1, "multisig")
+		td.HelperSignTransactionMultisig(pub, prvs, []int{0, 2}, trx)
+		trxs = append(trxs, trx)
+	}
+	blk := block.MakeBlock(1, util.Now(), trxs, td.RandHash(), td.RandHash(),
+		lastCert, td.RandSeed(), td.RandValAddress())
+	td.store.SaveBlock(blk, td.GenerateTestCertificate(height))
+	require.NoError(t, td.store.WriteBatch())
+
+	_, err := td.store.PublicKey(pub.AccountAddress())
+	assert.Error(t, err)
+
+	committedBlock, err := td.store.Block(height)
+	require.NoError(t, err)
+	storedBlk, err := committedBlock.ToBlock()
+	require.NoError(t, err)
+	for _, trx := range storedBlk.Transactions() {
+		assert.False(t, trx.IsPublicKeyStriped())
+		assert.True(t, pub.EqualsTo(trx.PublicKey()))
+		assert.NoError(t, trx.BasicCheck())
+	}
+}
+
+func TestPruning(t *testing.T) {
+	conf := testConfig()
+	conf.TxCacheSize = 2
+	conf.SortitionCacheSize = 2
+	conf.RetentionBlocks = 4
+	td := setup(t, conf)
+
+	committedBlock, _ := td.store.Block(7)
+	blk, _ := committedBlock.ToBlock()
+	trx := blk.Transactions()[0]
+	signer := trx.Payload().Signer()
+
+	// Save the 11th block, it should prune the 7th block
+	blk11, cert11 := td.GenerateTestBlock(11)
+	td.store.SaveBlock(blk11, cert11)
+	require.NoError(t, td.store.WriteBatch())
+
+	t.Run("Pruned blocks are removed", func(t *testing.T) {
+		assert.True(t, td.store.IsPruned())
+		assert.Equal(t, uint32(7), td.store.PruningHeight())
+
+		for height := uint32(1); height <= 7; height++ {
+			_, err := td.store.Block(height)
+			assert.Error(t, err)
+			assert.Equal(t, hash.UndefHash, td.store.BlockHash(height))
+		}
+		for height := uint32(8); height <= 11; height++ {
+			_, err := td.store.Block(height)
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("Transactions of pruned blocks are removed", func(t *testing.T) {
+		_, err := td.store.Transaction(trx.ID())
+		assert.Error(t, err)
+		assert.Equal(t, uint32(0), td.store.BlockHeight(blk.Hash()))
+
+		txs, err := td.store.AddressTransactions(signer, 0, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, txs)
+	})
+
+	t.Run("Public keys are kept", func(t *testing.T) {
+		_, err := td.store.PublicKey(signer)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Pruned height is updated once the batch is written", func(t *testing.T) {
+		blk12, cert12 := td.GenerateTestBlock(12)
+		td.store.SaveBlock(blk12, cert12)
+
+		assert.Equal(t, uint32(7), td.store.PruningHeight())
+		_, err := td.store.Block(8)
+		assert.NoError(t, err)
+
+		require.NoError(t, td.store.WriteBatch())
+		assert.Equal(t, uint32(8), td.store.PruningHeight())
+		_, err = td.store.Block(8)
+		assert.Error(t, err)
+	})
+
+	t.Run("Reduce retention window on reopen", func(t *testing.T) {
+		require.NoError(t, td.store.Close())
+
+		conf.RetentionBlocks = 3
+		s, err := NewStore(conf)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(9), s.PruningHeight())
+		_, err = s.Block(9)
+		assert.Error(t, err)
+		_, err = s.Block(10)
+		assert.NoError(t, err)
+		assert.NotNil(t, s.SortitionSeed(12))
+		require.NoError(t, s.Close())
+	})
+
+	t.Run("Failed pruning closes the database", func(t *testing.T) {
+		// Removing a block that is going to be pruned, to make the pruning fail.
+		db, err := openDB(conf)
+		require.NoError(t, err)
+		batch := db.NewBatch()
+		batch.Delete(blockKey(10))
+		require.NoError(t, db.Write(batch))
+		require.NoError(t, db.Close())
+
+		conf.RetentionBlocks = 2
+		_, err = NewStore(conf)
+		assert.Error(t, err)
+
+		// The database is not locked, so it can be opened again.
+		db, err = openDB(conf)
+		require.NoError(t, err)
+		require.NoError(t, db.Close())
+	})
+}
@@ -0,0 +1,110 @@
+package store
+
+import (
+	"encoding/binary"
+
+	"github.com/pactus-project/pactus/store/kv"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/block"
+)
+
+// SupplyCounters holds the cumulative fees and block rewards of the committed blocks.
+type SupplyCounters struct {
+	// FromHeight is the height of the first block that is counted.
+	// It is zero if no block is counted yet.
+	FromHeight uint32
+	// ToHeight is the height of the last block that is counted.
+	ToHeight uint32
+	// Fees is the sum of the transaction fees.
+	Fees amount.Amount
+	// Rewards is the sum of the block rewards, excluding the fees.
+	Rewards amount.Amount
+}
+
+func (c SupplyCounters) bytes() []byte {
+	data := make([]byte, 0, 24)
+	data = binary.BigEndian.AppendUint32(data, c.FromHeight)
+	data = binary.BigEndian.AppendUint32(data, c.ToHeight)
+	data = binary.BigEndian.AppendUint64(data, uint64(c.Fees))
+	data = binary.BigEndian.AppendUint64(data, uint64(c.Rewards))
+
+	return data
+}
+
+func supplyCountersFromBytes(data []byte) SupplyCounters {
+	return SupplyCounters{
+		FromHeight: binary.BigEndian.Uint32(data[0:4]),
+		ToHeight:   binary.BigEndian.Uint32(data[4:8]),
+		Fees:       amount.Amount(binary.BigEndian.Uint64(data[8:16])),
+		Rewards:    amount.Amount(binary.BigEndian.Uint64(data[16:24])),
+	}
+}
+
+// blockFeesAndReward returns the fees and the block reward of the block.
+// The subsidy transaction pays the block reward alongside the fees of the other transactions.
+func blockFeesAndReward(blk *block.Block) (amount.Amount, amount.Amount) {
+	fees := amount.Amount(0)
+	subsidy := amount.Amount(0)
+	for _, trx := range blk.Transactions() {
+		if trx.IsSubsidyTx() {
+			subsidy += trx.Payload().Value()
+
+			continue
+		}
+		fees += trx.Fee()
+	}
+
+	return fees, subsidy - fees
+}
+
+// supplyStore keeps the cumulative fees and block rewards.
+// The counters are updated by saving or reverting a block and are not pruned.
+type supplyStore struct {
+	// counters caches the latest counters, including the ones that are not written yet.
+	counters SupplyCounters
+}
+
+func newSupplyStore(db kv.DB) *supplyStore {
+	ss := &supplyStore{}
+	data, err := tryGet(db, supplyKey)
+	if err == nil {
+		ss.counters = supplyCountersFromBytes(data)
+	}
+
+	return ss
+}
+
+// saveBlock adds the fees and the block reward of the block to the counters.
+func (ss *supplyStore) saveBlock(batch kv.Batch, height uint32, blk *block.Block) {
+	fees, reward := blockFeesAndReward(blk)
+
+	if ss.counters.FromHeight == 0 {
+		ss.counters.FromHeight = height
+	}
+	ss.counters.ToHeight = height
+	ss.counters.Fees += fees
+	ss.counters.Rewards += reward
+
+	batch.Put(supplyKey, ss.counters.bytes())
+}
+
+// revertBlock subtracts the fees and the block reward of a reverted block from the counters.
+func (ss *supplyStore) revertBlock(batch kv.Batch, height uint32, blk *block.Block) {
+	if ss.counters.FromHeight == 0 || height > ss.counters.ToHeight {
+		return
+	}
+
+	if height <= ss.counters.FromHeight {
+		ss.counters = SupplyCounters{}
+		batch.Delete(supplyKey)
+
+		return
+	}
+
+	fees, reward := blockFeesAndReward(blk)
+	ss.counters.ToHeight = height - 1
+	ss.counters.Fees -= fees
+	ss.counters.Rewards -= reward
+
+	batch.Put(supplyKey, ss.counters.bytes())
+}
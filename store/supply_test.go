@@ -0,0 +1,85 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// expectedSupplyCounters calculates the counters from the stored blocks.
+func (td *testData) expectedSupplyCounters(t *testing.T, from, to uint32) SupplyCounters {
+	t.Helper()
+
+	counters := SupplyCounters{FromHeight: from, ToHeight: to}
+	for height := from; height <= to; height++ {
+		cb, err := td.store.Block(height)
+		require.NoError(t, err)
+		blk, err := cb.ToBlock()
+		require.NoError(t, err)
+
+		fees, reward := blockFeesAndReward(blk)
+		counters.Fees += fees
+		counters.Rewards += reward
+	}
+
+	return counters
+}
+
+func TestBlockFeesAndReward(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	trx1, _ := ts.GenerateTestTransferTx()
+	trx2, _ := ts.GenerateTestBondTx()
+	fees := trx1.Fee() + trx2.Fee()
+	reward := amount.Amount(1e9)
+	subsidyTrx := tx.NewSubsidyTx(ts.RandHeight(), ts.RandAccAddress(), reward+fees, "")
+
+	blk, _ := ts.GenerateTestBlock(ts.RandHeight())
+	txs := block.NewTxs()
+	txs.Append(subsidyTrx)
+	txs.Append(trx1)
+	txs.Append(trx2)
+	blk = block.NewBlock(blk.Header(), blk.PrevCertificate(), txs)
+
+	blockFees, blockReward := blockFeesAndReward(blk)
+	assert.Equal(t, fees, blockFees)
+	assert.Equal(t, reward, blockReward)
+}
+
+func TestSupplyCountersEncoding(t *testing.T) {
+	counters := SupplyCounters{
+		FromHeight: 1,
+		ToHeight:   1000,
+		Fees:       amount.Amount(1234),
+		Rewards:    amount.Amount(1e12),
+	}
+
+	assert.Equal(t, counters, supplyCountersFromBytes(counters.bytes()))
+}
+
+func TestSupplyCounters(t *testing.T) {
+	conf := testConfig()
+	td := setup(t, conf)
+
+	assert.Equal(t, td.expectedSupplyCounters(t, 1, 10), td.store.SupplyCounters())
+
+	t.Run("Recording supply counters of an older store", func(t *testing.T) {
+		expected := td.store.SupplyCounters()
+
+		td.store.batch.Delete(supplyKey)
+		td.store.saveLastInfo(3, td.store.LastCertificate())
+		require.NoError(t, td.store.WriteBatch())
+		require.NoError(t, td.store.Close())
+
+		str, err := NewStore(conf)
+		require.NoError(t, err)
+		defer func() { _ = str.Close() }()
+
+		assert.Equal(t, expected, str.SupplyCounters())
+	})
+}
@@ -3,11 +3,11 @@ package store
 import (
 	"bytes"
 
+	"github.com/pactus-project/pactus/store/kv"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/util/encoding"
 	"github.com/pactus-project/pactus/util/linkedmap"
-	"github.com/syndtr/goleveldb/leveldb"
 )
 
 type blockRegion struct {
@@ -19,12 +19,12 @@ type blockRegion struct {
 func txKey(id tx.ID) []byte { return append(txPrefix, id.Bytes()...) }
 
 type txStore struct {
-	db          *leveldb.DB
+	db          kv.DB
 	txIDCache   *linkedmap.LinkedMap[tx.ID, uint32]
 	txCacheSize uint32
 }
 
-func newTxStore(db *leveldb.DB, txCacheSize uint32) *txStore {
+func newTxStore(db kv.DB, txCacheSize uint32) *txStore {
 	return &txStore{
 		db:          db,
 		txIDCache:   linkedmap.New[tx.ID, uint32](0),
@@ -32,7 +32,7 @@ func newTxStore(db *leveldb.DB, txCacheSize uint32) *txStore {
 	}
 }
 
-func (ts *txStore) saveTxs(batch *leveldb.Batch, txs block.Txs, regs []blockRegion) {
+func (ts *txStore) saveTxs(batch kv.Batch, txs block.Txs, regs []blockRegion) {
 	for i, trx := range txs {
 		w := bytes.NewBuffer(make([]byte, 0, 32+4))
 
@@ -49,6 +49,13 @@ func (ts *txStore) saveTxs(batch *leveldb.Batch, txs block.Txs, regs []blockRegi
 	}
 }
 
+func (ts *txStore) deleteTxs(batch kv.Batch, txs block.Txs) {
+	for _, trx := range txs {
+		batch.Delete(txKey(trx.ID()))
+		ts.txIDCache.Remove(trx.ID())
+	}
+}
+
 func (ts *txStore) pruneCache(currentHeight uint32) {
 	for {
 		head := ts.txIDCache.HeadNode()
@@ -65,8 +72,9 @@ func (ts *txStore) hasTX(id tx.ID) bool {
 	return ts.txIDCache.Has(id)
 }
 
-func (ts *txStore) tx(id tx.ID) (*blockRegion, error) {
-	data, err := tryGet(ts.db, txKey(id))
+// readTxRegion returns the region of the transaction inside its block.
+func readTxRegion(db kv.Reader, id tx.ID) (*blockRegion, error) {
+	data, err := tryGet(db, txKey(id))
 	if err != nil {
 		return nil, err
 	}
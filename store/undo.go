@@ -0,0 +1,155 @@
+package store
+
+import (
+	"bytes"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/store/kv"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+func undoKey(height uint32) []byte { return append(undoPrefix, util.Uint32ToSlice(height)...) }
+
+// undoEntry keeps the previous state of an account or a validator.
+// Empty data means that the entry didn't exist before.
+type undoEntry struct {
+	addr crypto.Address
+	data []byte
+}
+
+// undoRecord keeps the state of the accounts and validators before committing a block.
+// Reverting a block restores the state from its undo record.
+type undoRecord struct {
+	accounts   []undoEntry
+	validators []undoEntry
+}
+
+// undoStore collects the changes of the block that is going to be saved
+// and writes them as an undo record alongside the block.
+type undoStore struct {
+	db         kv.DB
+	pending    *undoRecord
+	accounts   map[crypto.Address]bool
+	validators map[crypto.Address]bool
+}
+
+func newUndoStore(db kv.DB) *undoStore {
+	us := &undoStore{
+		db: db,
+	}
+	us.reset()
+
+	return us
+}
+
+func (us *undoStore) reset() {
+	us.pending = new(undoRecord)
+	us.accounts = make(map[crypto.Address]bool)
+	us.validators = make(map[crypto.Address]bool)
+}
+
+// recordAccount keeps the previous data of an account, before its first update in the current block.
+func (us *undoStore) recordAccount(addr crypto.Address, prevData []byte) {
+	if us.accounts[addr] {
+		return
+	}
+	us.accounts[addr] = true
+	us.pending.accounts = append(us.pending.accounts, undoEntry{addr: addr, data: prevData})
+}
+
+// recordValidator keeps the previous data of a validator, before its first update in the current block.
+func (us *undoStore) recordValidator(addr crypto.Address, prevData []byte) {
+	if us.validators[addr] {
+		return
+	}
+	us.validators[addr] = true
+	us.pending.validators = append(us.pending.validators, undoEntry{addr: addr, data: prevData})
+}
+
+// saveUndo writes the collected changes as the undo record of the given height.
+// Nothing is written if there is no change, like when the blocks are imported from a snapshot.
+func (us *undoStore) saveUndo(batch kv.Batch, height uint32) {
+	if len(us.pending.accounts) == 0 && len(us.pending.validators) == 0 {
+		return
+	}
+
+	w := bytes.NewBuffer(make([]byte, 0, 1024))
+	if err := us.pending.encode(w); err != nil {
+		panic(err)
+	}
+	batch.Put(undoKey(height), w.Bytes())
+	us.reset()
+}
+
+func (us *undoStore) undo(height uint32) (*undoRecord, error) {
+	data, err := tryGet(us.db, undoKey(height))
+	if err != nil {
+		return nil, err
+	}
+
+	rec := new(undoRecord)
+	if err := rec.decode(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	return rec, nil
+}
+
+func (us *undoStore) hasUndo(height uint32) bool {
+	return tryHas(us.db, undoKey(height))
+}
+
+func (us *undoStore) deleteUndo(batch kv.Batch, height uint32) {
+	batch.Delete(undoKey(height))
+}
+
+// encode writes the undo record as:
+// [num accounts: varint]+[address+data: variant]...+[num validators: varint]+[address+data: variant]...
+func (rec *undoRecord) encode(w *bytes.Buffer) error {
+	for _, entries := range [][]undoEntry{rec.accounts, rec.validators} {
+		if err := encoding.WriteVarInt(w, uint64(len(entries))); err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if err := entry.addr.Encode(w); err != nil {
+				return err
+			}
+			if err := encoding.WriteVarBytes(w, entry.data); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (rec *undoRecord) decode(r *bytes.Reader) error {
+	decodeEntries := func() ([]undoEntry, error) {
+		num, err := encoding.ReadVarInt(r)
+		if err != nil {
+			return nil, err
+		}
+		entries := make([]undoEntry, num)
+		for i := range entries {
+			if err := entries[i].addr.Decode(r); err != nil {
+				return nil, err
+			}
+			entries[i].data, err = encoding.ReadVarBytes(r)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return entries, nil
+	}
+
+	var err error
+	rec.accounts, err = decodeEntries()
+	if err != nil {
+		return err
+	}
+	rec.validators, err = decodeEntries()
+
+	return err
+}
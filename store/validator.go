@@ -2,27 +2,28 @@ package store
 
 import (
 	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/store/kv"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util/logger"
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 type validatorStore struct {
-	db         *leveldb.DB
+	db         kv.DB
 	numberMap  map[int32]*validator.Validator
 	addressMap map[crypto.Address]*validator.Validator
-	total      int32
+	// previousKeyMap maps the addresses of the rotated keys to the validator numbers.
+	previousKeyMap map[crypto.Address]int32
+	total          int32
 }
 
 func valKey(addr crypto.Address) []byte { return append(validatorPrefix, addr.Bytes()...) }
 
-func newValidatorStore(db *leveldb.DB) *validatorStore {
+func newValidatorStore(db kv.DB) *validatorStore {
 	total := int32(0)
 	numberMap := make(map[int32]*validator.Validator)
 	addressMap := make(map[crypto.Address]*validator.Validator)
-	r := util.BytesPrefix(validatorPrefix)
-	iter := db.NewIterator(r, nil)
+	previousKeyMap := make(map[crypto.Address]int32)
+	iter := db.NewIterator(validatorPrefix)
 	for iter.Next() {
 		value := iter.Value()
 
@@ -33,15 +34,19 @@ func newValidatorStore(db *leveldb.DB) *validatorStore {
 
 		numberMap[val.Number()] = val
 		addressMap[val.Address()] = val
+		for _, key := range val.PreviousKeys() {
+			previousKeyMap[key.PublicKey.ValidatorAddress()] = val.Number()
+		}
 		total++
 	}
 	iter.Release()
 
 	return &validatorStore{
-		db:         db,
-		total:      total,
-		numberMap:  numberMap,
-		addressMap: addressMap,
+		db:             db,
+		total:          total,
+		numberMap:      numberMap,
+		addressMap:     addressMap,
+		previousKeyMap: previousKeyMap,
 	}
 }
 
@@ -78,6 +83,27 @@ func (vs *validatorStore) validatorByNumber(num int32) (*validator.Validator, er
 	return nil, ErrNotFound
 }
 
+// validatorByPreviousKey returns the validator that has rotated out the key with the given address.
+func (vs *validatorStore) validatorByPreviousKey(addr crypto.Address) (*validator.Validator, error) {
+	num, ok := vs.previousKeyMap[addr]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return vs.validatorByNumber(num)
+}
+
+// validatorNumber returns the number of the validator that has the given address,
+// either as its current key or as one of its previous keys.
+func (vs *validatorStore) validatorNumber(addr crypto.Address) (int32, bool) {
+	if val, ok := vs.addressMap[addr]; ok {
+		return val.Number(), true
+	}
+	num, ok := vs.previousKeyMap[addr]
+
+	return num, ok
+}
+
 func (vs *validatorStore) iterateValidators(consumer func(*validator.Validator) (stop bool)) {
 	for _, val := range vs.addressMap {
 		stopped := consumer(val.Clone())
@@ -90,16 +116,44 @@ func (vs *validatorStore) iterateValidators(consumer func(*validator.Validator)
 // This function takes ownership of the validator pointer.
 // It is important that the caller should not modify the validator data and
 // keep it immutable.
-func (vs *validatorStore) updateValidator(batch *leveldb.Batch, val *validator.Validator) {
+func (vs *validatorStore) updateValidator(batch kv.Batch, val *validator.Validator) {
 	data, err := val.Bytes()
 	if err != nil {
 		logger.Panic("unable to encode validator", "error", err)
 	}
-	if !vs.hasValidator(val.Address()) {
+	if prevVal, ok := vs.numberMap[val.Number()]; ok {
+		// A validator that rotates its key keeps its number, but its address changes.
+		if prevVal.Address() != val.Address() {
+			delete(vs.addressMap, prevVal.Address())
+			batch.Delete(valKey(prevVal.Address()))
+		}
+		vs.removePreviousKeys(prevVal)
+	} else {
 		vs.total++
 	}
 	vs.numberMap[val.Number()] = val
 	vs.addressMap[val.Address()] = val
+	for _, key := range val.PreviousKeys() {
+		vs.previousKeyMap[key.PublicKey.ValidatorAddress()] = val.Number()
+	}
 
 	batch.Put(valKey(val.Address()), data)
 }
+
+func (vs *validatorStore) deleteValidator(batch kv.Batch, addr crypto.Address) {
+	val, ok := vs.addressMap[addr]
+	if ok {
+		vs.total--
+		delete(vs.numberMap, val.Number())
+		delete(vs.addressMap, addr)
+		vs.removePreviousKeys(val)
+	}
+
+	batch.Delete(valKey(addr))
+}
+
+func (vs *validatorStore) removePreviousKeys(val *validator.Validator) {
+	for _, key := range val.PreviousKeys() {
+		delete(vs.previousKeyMap, key.PublicKey.ValidatorAddress())
+	}
+}
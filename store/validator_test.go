@@ -1,6 +1,7 @@
 package store
 
 import (
+	"math"
 	"testing"
 
 	"github.com/pactus-project/pactus/crypto"
@@ -217,3 +218,65 @@ func TestValidatorDeepCopy(t *testing.T) {
 	val3.AddToStake(1)
 	assert.NotEqual(t, td.store.validatorStore.numberMap[num].Hash(), val3.Hash())
 }
+
+func TestValidatorKeyRotation(t *testing.T) {
+	td := setup(t, nil)
+
+	val0, _ := td.GenerateTestValidator(0)
+	val1, _ := td.GenerateTestValidator(1)
+	td.store.UpdateValidator(val0)
+	td.store.UpdateValidator(val1)
+	require.NoError(t, td.store.WriteBatch())
+
+	prevAddr := val1.Address()
+	rotatedVal := val1.Clone()
+	newPub, _ := td.RandBLSKeyPair()
+	rotatedVal.RotatePublicKey(newPub, td.RandHeight())
+
+	t.Run("Rotate the key, should keep the total validators number", func(t *testing.T) {
+		td.store.UpdateValidator(rotatedVal)
+		require.NoError(t, td.store.WriteBatch())
+
+		assert.Equal(t, int32(2), td.store.TotalValidators())
+		assert.False(t, td.store.HasValidator(prevAddr))
+		assert.True(t, td.store.HasValidator(rotatedVal.Address()))
+
+		val, err := td.store.ValidatorByNumber(1)
+		require.NoError(t, err)
+		assert.Equal(t, rotatedVal.Hash(), val.Hash())
+
+		val, err = td.store.ValidatorByPreviousKey(prevAddr)
+		require.NoError(t, err)
+		assert.Equal(t, rotatedVal.Hash(), val.Hash())
+
+		_, err = td.store.ValidatorByPreviousKey(rotatedVal.Address())
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("Remove the previous key", func(t *testing.T) {
+		val := rotatedVal.Clone()
+		val.RemovePreviousKeys(math.MaxUint32)
+		td.store.UpdateValidator(val)
+		require.NoError(t, td.store.WriteBatch())
+
+		_, err := td.store.ValidatorByPreviousKey(prevAddr)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		td.store.UpdateValidator(rotatedVal)
+		require.NoError(t, td.store.WriteBatch())
+	})
+
+	t.Run("Reopen the store", func(t *testing.T) {
+		td.store.Close()
+		store, _ := NewStore(td.store.config)
+
+		assert.Equal(t, int32(2), store.TotalValidators())
+		val, err := store.ValidatorByNumber(1)
+		require.NoError(t, err)
+		assert.Equal(t, rotatedVal.Address(), val.Address())
+
+		val, err = store.ValidatorByPreviousKey(prevAddr)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), val.Number())
+	})
+}
@@ -0,0 +1,27 @@
+package message
+
+import (
+	"github.com/pactus-project/pactus/types/evidence"
+)
+
+type DoubleSignMessage struct {
+	Evidence *evidence.DoubleSign `cbor:"1,keyasint"`
+}
+
+func NewDoubleSignMessage(ev *evidence.DoubleSign) *DoubleSignMessage {
+	return &DoubleSignMessage{
+		Evidence: ev,
+	}
+}
+
+func (m *DoubleSignMessage) BasicCheck() error {
+	return m.Evidence.BasicCheck()
+}
+
+func (m *DoubleSignMessage) Type() Type {
+	return TypeDoubleSign
+}
+
+func (m *DoubleSignMessage) String() string {
+	return m.Evidence.String()
+}
@@ -0,0 +1,43 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/evidence"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoubleSignType(t *testing.T) {
+	m := &DoubleSignMessage{}
+	assert.Equal(t, m.Type(), TypeDoubleSign)
+}
+
+func TestDoubleSignMessage(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("Invalid evidence", func(t *testing.T) {
+		vote1, _ := ts.GenerateTestPrecommitVote(ts.RandHeight(), 0)
+		vote2, _ := ts.GenerateTestPrecommitVote(vote1.Height(), 0)
+		m := NewDoubleSignMessage(evidence.NewDoubleSign(vote1, vote2))
+
+		assert.ErrorIs(t, m.BasicCheck(), evidence.BasicCheckError{Reason: "votes are not conflicting"})
+	})
+
+	t.Run("Invalid vote", func(t *testing.T) {
+		vote1, valKey := ts.GenerateTestPrecommitVote(ts.RandHeight(), 0)
+		vote2 := vote.NewPrecommitVote(ts.RandHash(), vote1.Height(), 0, valKey.Address())
+		m := NewDoubleSignMessage(evidence.NewDoubleSign(vote1, vote2))
+
+		assert.Error(t, m.BasicCheck())
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		ev, _ := ts.GenerateTestDoubleSign(ts.RandHeight(), 0)
+		m := NewDoubleSignMessage(ev)
+
+		assert.NoError(t, m.BasicCheck())
+		assert.Contains(t, m.String(), ev.String())
+	})
+}
@@ -50,11 +50,12 @@ const (
 	TypeBlockAnnounce  = Type(8)
 	TypeBlocksRequest  = Type(9)
 	TypeBlocksResponse = Type(10)
+	TypeDoubleSign     = Type(11)
 )
 
 func (t Type) TopicID() network.TopicID {
 	switch t {
-	case TypeTransactions, TypeBlockAnnounce:
+	case TypeTransactions, TypeBlockAnnounce, TypeDoubleSign:
 
 		return network.TopicIDGeneral
 
@@ -110,6 +111,9 @@ func (t Type) String() string {
 	case TypeBlocksResponse:
 		return "blocks-res"
 
+	case TypeDoubleSign:
+		return "double-sign"
+
 	default:
 		return fmt.Sprintf("%d", t)
 	}
@@ -146,6 +150,9 @@ func MakeMessage(t Type) Message {
 
 	case TypeBlocksResponse:
 		return &BlocksResponseMessage{}
+
+	case TypeDoubleSign:
+		return &DoubleSignMessage{}
 	}
 
 	//
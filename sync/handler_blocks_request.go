@@ -54,6 +54,15 @@ func (handler *blocksRequestHandler) ParseMessage(m message.Message, pid peer.ID
 		}
 	}
 
+	if msg.From <= handler.state.PruningHeight() {
+		response := message.NewBlocksResponseMessage(message.ResponseCodeRejected,
+			fmt.Sprintf("requested blocks are pruned: %v", msg.From), msg.SessionID, 0, nil, nil)
+
+		handler.respond(response, pid)
+
+		return nil
+	}
+
 	if msg.From > ourHeight {
 		response := message.NewBlocksResponseMessage(message.ResponseCodeRejected,
 			fmt.Sprintf("don't have requested blocks: %v", msg.From), msg.SessionID, 0, nil, nil)
@@ -133,4 +133,38 @@ func TestBlocksRequestMessages(t *testing.T) {
 			assert.Equal(t, msg2.Message.(*message.BlocksResponseMessage).ResponseCode, message.ResponseCodeNoMoreBlocks)
 		})
 	})
+	t.Run("Node is pruned", func(t *testing.T) {
+		td.sync.config.NodeNetwork = true
+		td.state.TestStore.PrunedHeight = 10
+		pid := td.addPeer(t, peerset.StatusCodeKnown, service.New(service.None))
+
+		assert.True(t, td.sync.Services().IsPrunedNode())
+		assert.False(t, td.sync.Services().IsNetwork())
+
+		t.Run("Reject requests for pruned blocks", func(t *testing.T) {
+			msg := message.NewBlocksRequestMessage(sid, 10, 2)
+			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+			bdl := td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+			res := bdl.Message.(*message.BlocksResponseMessage)
+			assert.Equal(t, message.ResponseCodeRejected, res.ResponseCode)
+			assert.Contains(t, res.Reason, "requested blocks are pruned")
+		})
+
+		t.Run("Accept requests for retained blocks", func(t *testing.T) {
+			msg := message.NewBlocksRequestMessage(sid, 11, 2)
+			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+			msg1 := td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+			assert.Equal(t, msg1.Message.(*message.BlocksResponseMessage).ResponseCode, message.ResponseCodeMoreBlocks)
+
+			msg2 := td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+			assert.Equal(t, msg2.Message.(*message.BlocksResponseMessage).ResponseCode, message.ResponseCodeNoMoreBlocks)
+		})
+
+		t.Run("Prepare blocks doesn't serve pruned blocks", func(t *testing.T) {
+			assert.Nil(t, td.sync.prepareBlocks(9, 2))
+			assert.Len(t, td.sync.prepareBlocks(11, 2), 2)
+		})
+	})
 }
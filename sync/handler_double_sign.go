@@ -0,0 +1,52 @@
+package sync
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/sync/bundle"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+)
+
+type doubleSignHandler struct {
+	*synchronizer
+}
+
+func newDoubleSignHandler(sync *synchronizer) messageHandler {
+	return &doubleSignHandler{
+		sync,
+	}
+}
+
+func (handler *doubleSignHandler) ParseMessage(m message.Message, _ peer.ID) error {
+	msg := m.(*message.DoubleSignMessage)
+	handler.logger.Trace("parsing DoubleSign message", "msg", msg)
+
+	ev := msg.Evidence
+	// The evidences are kept by the consensus for the height that is being decided and the recent ones.
+	if !consensus.IsEvidenceInWindow(ev, handler.state.LastBlockHeight()+1) {
+		handler.logger.Debug("double-sign evidence is outside the window", "evidence", ev)
+
+		return nil
+	}
+
+	val := handler.state.ValidatorByAddress(ev.Signer())
+	if val == nil {
+		handler.logger.Warn("unknown signer for double-sign evidence", "evidence", ev)
+
+		return nil
+	}
+
+	if err := ev.Verify(val.PublicKey()); err != nil {
+		handler.logger.Warn("invalid double-sign evidence", "evidence", ev, "error", err)
+
+		return nil
+	}
+
+	handler.consMgr.AddDoubleSign(ev)
+
+	return nil
+}
+
+func (handler *doubleSignHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	return bundle.NewBundle(m)
+}
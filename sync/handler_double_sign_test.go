@@ -0,0 +1,56 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsingDoubleSignMessages(t *testing.T) {
+	td := setup(t, nil)
+	height := td.RandHeight()
+	td.state.TestStore.LastHeight = height
+
+	t.Run("Unknown signer", func(t *testing.T) {
+		ev, _ := td.GenerateTestDoubleSign(height, 0)
+		msg := message.NewDoubleSignMessage(ev)
+		pid := td.RandPeerID()
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		assert.Empty(t, td.consMgr.DoubleSigns())
+	})
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		ev, _ := td.GenerateTestDoubleSign(height, 0)
+		// The signer is stored with a different public key.
+		otherKey := td.RandValKey()
+		td.state.TestStore.Validators[ev.Signer()] = validator.NewValidator(otherKey.PublicKey(), td.RandInt32(1000))
+		msg := message.NewDoubleSignMessage(ev)
+		pid := td.RandPeerID()
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		assert.Empty(t, td.consMgr.DoubleSigns())
+	})
+
+	t.Run("Outside the window", func(t *testing.T) {
+		ev, valKey := td.GenerateTestDoubleSign(height+2, 0)
+		td.state.TestStore.UpdateValidator(validator.NewValidator(valKey.PublicKey(), td.RandInt32(1000)))
+		msg := message.NewDoubleSignMessage(ev)
+		pid := td.RandPeerID()
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		assert.Empty(t, td.consMgr.DoubleSigns())
+	})
+
+	t.Run("Parsing double-sign message", func(t *testing.T) {
+		ev, valKey := td.GenerateTestDoubleSign(height, 0)
+		td.state.TestStore.UpdateValidator(validator.NewValidator(valKey.PublicKey(), td.RandInt32(1000)))
+		msg := message.NewDoubleSignMessage(ev)
+		pid := td.RandPeerID()
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		assert.Equal(t, ev.Hash(), td.consMgr.DoubleSigns()[0].Hash())
+	})
+}
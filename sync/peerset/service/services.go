@@ -12,9 +12,9 @@ type (
 )
 
 const (
-	None    Service = 0x00
-	Network Service = 0x01
-	Foo     Service = 0x02 // For future use
+	None       Service = 0x00
+	Network    Service = 0x01
+	PrunedNode Service = 0x02
 )
 
 func New(flags ...Service) Services {
@@ -37,9 +37,9 @@ func (s Services) String() string {
 		s = util.UnsetFlag(s, Services(Network))
 	}
 
-	if util.IsFlagSet(s, Services(Foo)) {
-		services += "FOO | "
-		s = util.UnsetFlag(s, Services(Foo))
+	if util.IsFlagSet(s, Services(PrunedNode)) {
+		services += "PRUNED | "
+		s = util.UnsetFlag(s, Services(PrunedNode))
 	}
 
 	if s != 0 {
@@ -55,6 +55,6 @@ func (s Services) IsNetwork() bool {
 	return util.IsFlagSet(s, Services(Network))
 }
 
-func (s Services) IsFoo() bool {
-	return util.IsFlagSet(s, Services(Foo))
+func (s Services) IsPrunedNode() bool {
+	return util.IsFlagSet(s, Services(PrunedNode))
 }
@@ -91,6 +91,7 @@ func NewSynchronizer(
 	handlers[message.TypeBlockAnnounce] = newBlockAnnounceHandler(sync)
 	handlers[message.TypeBlocksRequest] = newBlocksRequestHandler(sync)
 	handlers[message.TypeBlocksResponse] = newBlocksResponseHandler(sync)
+	handlers[message.TypeDoubleSign] = newDoubleSignHandler(sync)
 
 	sync.handlers = handlers
 
@@ -218,6 +219,11 @@ func (sync *synchronizer) PeerSet() *peerset.PeerSet {
 }
 
 func (sync *synchronizer) Services() service.Services {
+	// A pruned node can't serve the old blocks to other peers.
+	if sync.state.IsPruned() {
+		return service.New(service.PrunedNode)
+	}
+
 	return sync.config.Services()
 }
 
@@ -226,7 +232,7 @@ func (sync *synchronizer) sayHello(to peer.ID) {
 		sync.SelfID(),
 		sync.config.Moniker,
 		sync.stateHeight(),
-		sync.config.Services(),
+		sync.Services(),
 		sync.state.LastBlockHash(),
 		sync.state.Genesis().Hash(),
 	)
@@ -559,6 +565,12 @@ func (sync *synchronizer) prepareBlocks(from, count uint32) [][]byte {
 		return nil
 	}
 
+	if from <= sync.state.PruningHeight() {
+		sync.logger.Debug("the block at this height is pruned", "height", from)
+
+		return nil
+	}
+
 	if from+count > ourHeight {
 		count = ourHeight - from + 1
 	}
@@ -55,6 +55,40 @@ func (conf *Config) withdrawPoolSize() int {
 	return int(float32(conf.MaxSize) * 0.1)
 }
 
+// slashPoolSize returns the size of the slash pool.
+// Slash transactions are rare, but there should be room for at least one of them.
+func (conf *Config) slashPoolSize() int {
+	size := int(float32(conf.MaxSize) * 0.01)
+	if size < 1 {
+		return 1
+	}
+
+	return size
+}
+
+// rotateKeyPoolSize returns the size of the rotate key pool.
+// Rotating keys is rare, but there should be room for at least one of them.
+func (conf *Config) rotateKeyPoolSize() int {
+	size := int(float32(conf.MaxSize) * 0.01)
+	if size < 1 {
+		return 1
+	}
+
+	return size
+}
+
+func (conf *Config) batchTransferPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.05)
+}
+
+func (conf *Config) vestingTransferPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.02)
+}
+
+func (conf *Config) partialUnbondPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.02)
+}
+
 func (conf *Config) transferPoolSize() int {
-	return int(float32(conf.MaxSize) * 0.6)
+	return int(float32(conf.MaxSize) * 0.49)
 }
@@ -10,18 +10,32 @@ func TestDefaultConfigCheck(t *testing.T) {
 	c := DefaultConfig()
 	assert.NoError(t, c.BasicCheck())
 
-	assert.Equal(t, 600, c.transferPoolSize())
+	assert.Equal(t, 490, c.transferPoolSize())
+	assert.Equal(t, 50, c.batchTransferPoolSize())
+	assert.Equal(t, 20, c.vestingTransferPoolSize())
+	assert.Equal(t, 20, c.partialUnbondPoolSize())
 	assert.Equal(t, 100, c.bondPoolSize())
 	assert.Equal(t, 100, c.unbondPoolSize())
 	assert.Equal(t, 100, c.withdrawPoolSize())
 	assert.Equal(t, 100, c.sortitionPoolSize())
+	assert.Equal(t, 10, c.slashPoolSize())
+	assert.Equal(t, 10, c.rotateKeyPoolSize())
 
 	assert.Equal(t,
 		c.transferPoolSize()+
+			c.batchTransferPoolSize()+
+			c.vestingTransferPoolSize()+
+			c.partialUnbondPoolSize()+
 			c.bondPoolSize()+
 			c.unbondPoolSize()+
 			c.withdrawPoolSize()+
-			c.sortitionPoolSize(), c.MaxSize)
+			c.sortitionPoolSize()+
+			c.slashPoolSize()+
+			c.rotateKeyPoolSize(), c.MaxSize)
+
+	small := Config{MaxSize: 10}
+	assert.Equal(t, 1, small.slashPoolSize())
+	assert.Equal(t, 1, small.rotateKeyPoolSize())
 }
 
 func TestInvalidConfig(t *testing.T) {
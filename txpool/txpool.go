@@ -35,6 +35,11 @@ func NewTxPool(conf *Config, broadcastCh chan message.Message) TxPool {
 	pools[payload.TypeUnbond] = newPool(conf.unbondPoolSize(), 0)
 	pools[payload.TypeWithdraw] = newPool(conf.withdrawPoolSize(), minValue)
 	pools[payload.TypeSortition] = newPool(conf.sortitionPoolSize(), 0)
+	pools[payload.TypeSlash] = newPool(conf.slashPoolSize(), 0)
+	pools[payload.TypeBatchTransfer] = newPool(conf.batchTransferPoolSize(), minValue)
+	pools[payload.TypeVestingTransfer] = newPool(conf.vestingTransferPoolSize(), minValue)
+	pools[payload.TypePartialUnbond] = newPool(conf.partialUnbondPoolSize(), 0)
+	pools[payload.TypeRotateKey] = newPool(conf.rotateKeyPoolSize(), 0)
 
 	pool := &txPool{
 		config:      conf,
@@ -173,6 +178,19 @@ func (p *txPool) PrepareBlockTransactions() block.Txs {
 		trxs = append(trxs, n.Data.Value)
 	}
 
+	// Appending slash transactions, before unbonding the offenders
+	poolSlash := p.pools[payload.TypeSlash]
+	for n := poolSlash.list.HeadNode(); n != nil; n = n.Next {
+		trxs = append(trxs, n.Data.Value)
+	}
+
+	// Appending rotate key transactions, after slashing the offenders and
+	// before any other transaction that is signed by the previous keys
+	poolRotateKey := p.pools[payload.TypeRotateKey]
+	for n := poolRotateKey.list.HeadNode(); n != nil; n = n.Next {
+		trxs = append(trxs, n.Data.Value)
+	}
+
 	// Appending bond transactions
 	poolBond := p.pools[payload.TypeBond]
 	for n := poolBond.list.HeadNode(); n != nil; n = n.Next {
@@ -185,6 +203,12 @@ func (p *txPool) PrepareBlockTransactions() block.Txs {
 		trxs = append(trxs, n.Data.Value)
 	}
 
+	// Appending partial unbond transactions
+	poolPartialUnbond := p.pools[payload.TypePartialUnbond]
+	for n := poolPartialUnbond.list.HeadNode(); n != nil; n = n.Next {
+		trxs = append(trxs, n.Data.Value)
+	}
+
 	// Appending withdraw transactions
 	poolWithdraw := p.pools[payload.TypeWithdraw]
 	for n := poolWithdraw.list.HeadNode(); n != nil; n = n.Next {
@@ -197,6 +221,18 @@ func (p *txPool) PrepareBlockTransactions() block.Txs {
 		trxs = append(trxs, n.Data.Value)
 	}
 
+	// Appending batch transfer transactions
+	poolBatchTransfer := p.pools[payload.TypeBatchTransfer]
+	for n := poolBatchTransfer.list.HeadNode(); n != nil; n = n.Next {
+		trxs = append(trxs, n.Data.Value)
+	}
+
+	// Appending vesting transfer transactions
+	poolVestingTransfer := p.pools[payload.TypeVestingTransfer]
+	for n := poolVestingTransfer.list.HeadNode(); n != nil; n = n.Next {
+		trxs = append(trxs, n.Data.Value)
+	}
+
 	return trxs
 }
 
@@ -226,11 +262,16 @@ func (p *txPool) Size() int {
 }
 
 func (p *txPool) String() string {
-	return fmt.Sprintf("{💸 %v 🔐 %v 🔓 %v 🎯 %v 🧾 %v}",
+	return fmt.Sprintf("{💸 %v 📦 %v ⏳ %v 🔐 %v 🔓 %v ✂ %v 🎯 %v 🧾 %v ⚔ %v 🔑 %v}",
 		p.pools[payload.TypeTransfer].list.Size(),
+		p.pools[payload.TypeBatchTransfer].list.Size(),
+		p.pools[payload.TypeVestingTransfer].list.Size(),
 		p.pools[payload.TypeBond].list.Size(),
 		p.pools[payload.TypeUnbond].list.Size(),
+		p.pools[payload.TypePartialUnbond].list.Size(),
 		p.pools[payload.TypeSortition].list.Size(),
 		p.pools[payload.TypeWithdraw].list.Size(),
+		p.pools[payload.TypeSlash].list.Size(),
+		p.pools[payload.TypeRotateKey].list.Size(),
 	)
 }
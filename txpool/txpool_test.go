@@ -9,7 +9,9 @@ import (
 	"github.com/pactus-project/pactus/sandbox"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/param"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/util/testsuite"
@@ -134,6 +136,7 @@ func TestEmptyPool(t *testing.T) {
 func TestPrepareBlockTransactions(t *testing.T) {
 	td := setup(t)
 
+	td.sandbox.TestParams.BlockVersion = param.BlockVersionV2
 	randHeight := td.RandHeight() + td.sandbox.TestParams.UnbondInterval
 	_ = td.sandbox.TestStore.AddTestBlock(randHeight)
 
@@ -170,23 +173,56 @@ func TestPrepareBlockTransactions(t *testing.T) {
 	withdrawTx := tx.NewWithdrawTx(randHeight+4, val2.Address(),
 		td.RandAccAddress(), 1e9, 100_000, "withdraw-tx")
 
+	batchTransferTx := tx.NewBatchTransferTx(randHeight+5, acc1Addr,
+		[]payload.BatchTransferRecipient{
+			{To: td.RandAccAddress(), Amount: 1e9},
+			{To: td.RandAccAddress(), Amount: 1e9},
+		}, 200_000, "batch-transfer-tx")
+
+	val4PubKey, _ := td.RandBLSKeyPair()
+	val4 := validator.NewValidator(val4PubKey, 0)
+	val4.AddToStake(1000e9)
+	td.sandbox.UpdateValidator(val4)
+
+	partialUnbondTx := tx.NewPartialUnbondTx(randHeight+7, val4.Address(), 1e9, "partial-unbond-tx")
+
+	vestingTransferTx := tx.NewVestingTransferTx(randHeight+6, acc1Addr,
+		td.RandAccAddress(), 1e9, randHeight+100, randHeight+200, 100_000, "vesting-transfer-tx")
+
+	val5PubKey, _ := td.RandBLSKeyPair()
+	val5 := validator.NewValidator(val5PubKey, 0)
+	val5.AddToStake(1000e9)
+	td.sandbox.UpdateValidator(val5)
+
+	newPub, newPrv := td.RandBLSKeyPair()
+	newKeySig := newPrv.SignNative(payload.RotateKeySignBytes(val5.Address(), newPub))
+	rotateKeyTx := tx.NewRotateKeyTx(randHeight+8, val5.Address(), newPub, newKeySig, "rotate-key-tx")
+
 	td.sandbox.TestAcceptSortition = true
 	sortitionTx := tx.NewSortitionTx(randHeight, val3.Address(),
 		td.RandProof())
 
 	assert.NoError(t, td.pool.AppendTx(transferTx))
+	assert.NoError(t, td.pool.AppendTx(batchTransferTx))
+	assert.NoError(t, td.pool.AppendTx(vestingTransferTx))
+	assert.NoError(t, td.pool.AppendTx(partialUnbondTx))
 	assert.NoError(t, td.pool.AppendTx(unbondTx))
 	assert.NoError(t, td.pool.AppendTx(withdrawTx))
 	assert.NoError(t, td.pool.AppendTx(bondTx))
 	assert.NoError(t, td.pool.AppendTx(sortitionTx))
+	assert.NoError(t, td.pool.AppendTx(rotateKeyTx))
 
 	trxs := td.pool.PrepareBlockTransactions()
-	assert.Len(t, trxs, 5)
+	assert.Len(t, trxs, 9)
 	assert.Equal(t, trxs[0].ID(), sortitionTx.ID())
-	assert.Equal(t, trxs[1].ID(), bondTx.ID())
-	assert.Equal(t, trxs[2].ID(), unbondTx.ID())
-	assert.Equal(t, trxs[3].ID(), withdrawTx.ID())
-	assert.Equal(t, trxs[4].ID(), transferTx.ID())
+	assert.Equal(t, trxs[1].ID(), rotateKeyTx.ID())
+	assert.Equal(t, trxs[2].ID(), bondTx.ID())
+	assert.Equal(t, trxs[3].ID(), unbondTx.ID())
+	assert.Equal(t, trxs[4].ID(), partialUnbondTx.ID())
+	assert.Equal(t, trxs[5].ID(), withdrawTx.ID())
+	assert.Equal(t, trxs[6].ID(), transferTx.ID())
+	assert.Equal(t, trxs[7].ID(), batchTransferTx.ID())
+	assert.Equal(t, trxs[8].ID(), vestingTransferTx.ID())
 }
 
 func TestAppendAndBroadcast(t *testing.T) {
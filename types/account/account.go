@@ -3,6 +3,7 @@ package account
 
 import (
 	"bytes"
+	"fmt"
 
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/types/amount"
@@ -18,6 +19,9 @@ type Account struct {
 type accountData struct {
 	Number  int32
 	Balance amount.Amount
+	// Vestings are encoded only if there is any.
+	// Therefore, the encoding of the accounts without vesting schedules remains unchanged.
+	Vestings []Vesting
 }
 
 // NewAccount constructs a new account from the given number.
@@ -40,6 +44,27 @@ func FromBytes(data []byte) (*Account, error) {
 		return nil, err
 	}
 
+	if r.Len() > 0 {
+		count, err := encoding.ReadVarInt(r)
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 || count > uint64(r.Len()/16) {
+			return nil, fmt.Errorf("invalid number of vesting schedules: %d", count)
+		}
+		acc.data.Vestings = make([]Vesting, count)
+		for i := range acc.data.Vestings {
+			vesting := &acc.data.Vestings[i]
+			err = encoding.ReadElements(r,
+				&vesting.StartHeight,
+				&vesting.EndHeight,
+				&vesting.Amount)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return acc, nil
 }
 
@@ -53,6 +78,50 @@ func (acc Account) Balance() amount.Amount {
 	return acc.data.Balance
 }
 
+// Vestings returns the vesting schedules of the account.
+func (acc Account) Vestings() []Vesting {
+	return acc.data.Vestings
+}
+
+// AddVesting adds a vesting schedule to the account.
+func (acc *Account) AddVesting(vesting *Vesting) {
+	acc.data.Vestings = append(acc.data.Vestings, *vesting)
+}
+
+// RemoveFinishedVestings removes the vesting schedules that are finished at the given height.
+func (acc *Account) RemoveFinishedVestings(height uint32) {
+	active := acc.data.Vestings[:0]
+	for _, vesting := range acc.data.Vestings {
+		if !vesting.IsFinished(height) {
+			active = append(active, vesting)
+		}
+	}
+	if len(active) == 0 {
+		active = nil
+	}
+	acc.data.Vestings = active
+}
+
+// LockedBalance returns the part of the balance that is locked by the vesting schedules at the given height.
+func (acc Account) LockedBalance(height uint32) amount.Amount {
+	locked := amount.Amount(0)
+	for i := range acc.data.Vestings {
+		locked += acc.data.Vestings[i].LockedAmount(height)
+	}
+
+	return locked
+}
+
+// SpendableBalance returns the part of the balance that can be spent at the given height.
+func (acc Account) SpendableBalance(height uint32) amount.Amount {
+	spendable := acc.data.Balance - acc.LockedBalance(height)
+	if spendable < 0 {
+		return 0
+	}
+
+	return spendable
+}
+
 // SubtractFromBalance subtracts the given amount from the account's balance.
 func (acc *Account) SubtractFromBalance(amt amount.Amount) {
 	acc.data.Balance -= amt
@@ -75,7 +144,12 @@ func (acc *Account) Hash() hash.Hash {
 
 // SerializeSize returns the size in bytes required to serialize the account.
 func (acc *Account) SerializeSize() int {
-	return 12 // 4+8
+	size := 12 // 4+8
+	if count := len(acc.data.Vestings); count > 0 {
+		size += encoding.VarIntSerializeSize(uint64(count)) + count*16 // 4+4+8
+	}
+
+	return size
 }
 
 // Bytes returns the serialized byte representation of the account.
@@ -88,6 +162,21 @@ func (acc *Account) Bytes() ([]byte, error) {
 		return nil, err
 	}
 
+	if count := len(acc.data.Vestings); count > 0 {
+		if err := encoding.WriteVarInt(w, uint64(count)); err != nil {
+			return nil, err
+		}
+		for _, vesting := range acc.data.Vestings {
+			err = encoding.WriteElements(w,
+				vesting.StartHeight,
+				vesting.EndHeight,
+				vesting.Amount)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return w.Bytes(), nil
 }
 
@@ -95,6 +184,10 @@ func (acc *Account) Bytes() ([]byte, error) {
 func (acc *Account) Clone() *Account {
 	cloned := new(Account)
 	*cloned = *acc
+	if acc.data.Vestings != nil {
+		cloned.data.Vestings = make([]Vesting, len(acc.data.Vestings))
+		copy(cloned.data.Vestings, acc.data.Vestings)
+	}
 
 	return cloned
 }
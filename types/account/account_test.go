@@ -2,6 +2,7 @@ package account_test
 
 import (
 	"encoding/hex"
+	"math"
 	"testing"
 
 	"github.com/pactus-project/pactus/crypto/hash"
@@ -71,3 +72,117 @@ func TestClone(t *testing.T) {
 
 	assert.NotEqual(t, acc.Balance(), cloned.Balance())
 }
+
+func TestVestingEncoding(t *testing.T) {
+	d, _ := hex.DecodeString(
+		"01000000" + // number
+			"0200000000000000" + // balance
+			"02" + // number of vesting schedules
+			"0a000000" + // vesting start height
+			"14000000" + // vesting end height
+			"0200000000000000" + // vesting amount
+			"1e000000" + // vesting start height
+			"1e000000" + // vesting end height
+			"0300000000000000") // vesting amount
+
+	acc, err := account.FromBytes(d)
+	require.NoError(t, err)
+	assert.Equal(t, []account.Vesting{
+		*account.NewVesting(10, 20, 2),
+		*account.NewVesting(30, 30, 3),
+	}, acc.Vestings())
+	d2, _ := acc.Bytes()
+	assert.Equal(t, d, d2)
+	assert.Equal(t, acc.SerializeSize(), len(d))
+
+	_, err = account.FromBytes(d[:30])
+	assert.Error(t, err)
+
+	// No vesting schedule
+	_, err = account.FromBytes(append(d[:12:12], 0x00))
+	assert.Error(t, err)
+}
+
+func TestLockedBalance(t *testing.T) {
+	acc := account.NewAccount(1)
+	acc.AddToBalance(1500)
+	assert.Zero(t, acc.LockedBalance(0))
+	assert.Equal(t, amount.Amount(1500), acc.SpendableBalance(0))
+
+	acc.AddVesting(account.NewVesting(100, 200, 1000))
+
+	tests := []struct {
+		height    uint32
+		locked    amount.Amount
+		spendable amount.Amount
+	}{
+		{0, 1000, 500},
+		{99, 1000, 500},
+		{100, 1000, 500},
+		{101, 990, 510},
+		{150, 500, 1000},
+		{199, 10, 1490},
+		{200, 0, 1500},
+		{300, 0, 1500},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.locked, acc.LockedBalance(tt.height), "height %d", tt.height)
+		assert.Equal(t, tt.spendable, acc.SpendableBalance(tt.height), "height %d", tt.height)
+	}
+
+	// Spending the unlocked coins never makes the spendable balance negative
+	acc.SubtractFromBalance(1000)
+	assert.Zero(t, acc.SpendableBalance(100))
+}
+
+func TestCliffVesting(t *testing.T) {
+	acc := account.NewAccount(1)
+	acc.AddToBalance(1000)
+	acc.AddVesting(account.NewVesting(100, 100, 1000))
+
+	assert.Equal(t, amount.Amount(1000), acc.LockedBalance(99))
+	assert.Zero(t, acc.LockedBalance(100))
+	assert.True(t, acc.Vestings()[0].IsFinished(100))
+	assert.False(t, acc.Vestings()[0].IsFinished(99))
+}
+
+func TestMultipleVestings(t *testing.T) {
+	acc := account.NewAccount(1)
+	acc.AddToBalance(3000)
+	acc.AddVesting(account.NewVesting(100, 200, 1000))
+	acc.AddVesting(account.NewVesting(150, 150, 1000))
+	acc.AddVesting(account.NewVesting(math.MaxUint32, math.MaxUint32, 1))
+
+	assert.Equal(t, amount.Amount(2001), acc.LockedBalance(100))
+	assert.Equal(t, amount.Amount(501), acc.LockedBalance(150))
+	assert.Equal(t, amount.Amount(1), acc.LockedBalance(200))
+	assert.Equal(t, amount.Amount(2999), acc.SpendableBalance(200))
+
+	acc.RemoveFinishedVestings(150)
+	assert.Equal(t, []account.Vesting{
+		*account.NewVesting(100, 200, 1000),
+		*account.NewVesting(math.MaxUint32, math.MaxUint32, 1),
+	}, acc.Vestings())
+
+	acc.RemoveFinishedVestings(200)
+	assert.Equal(t, []account.Vesting{
+		*account.NewVesting(math.MaxUint32, math.MaxUint32, 1),
+	}, acc.Vestings())
+}
+
+func TestVestingBasicCheck(t *testing.T) {
+	assert.Error(t, account.NewVesting(20, 10, 1).BasicCheck())
+	assert.Error(t, account.NewVesting(10, 20, 0).BasicCheck())
+	assert.Error(t, account.NewVesting(10, 20, amount.MaxNanoPAC+1).BasicCheck())
+	assert.NoError(t, account.NewVesting(10, 10, 1).BasicCheck())
+}
+
+func TestCloneVesting(t *testing.T) {
+	acc := account.NewAccount(1)
+	acc.AddVesting(account.NewVesting(10, 20, 1))
+	cloned := acc.Clone()
+	cloned.Vestings()[0].Amount = 2
+	cloned.AddVesting(account.NewVesting(10, 20, 3))
+
+	assert.Equal(t, []account.Vesting{*account.NewVesting(10, 20, 1)}, acc.Vestings())
+}
@@ -0,0 +1,60 @@
+package account
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/pactus-project/pactus/types/amount"
+)
+
+// Vesting is a schedule that locks part of the account balance and
+// unlocks it linearly between the start and the end heights.
+// A cliff, that unlocks the whole amount at once, is defined by equal start and end heights.
+type Vesting struct {
+	StartHeight uint32
+	EndHeight   uint32
+	Amount      amount.Amount
+}
+
+// NewVesting constructs a new vesting schedule.
+func NewVesting(startHeight, endHeight uint32, amt amount.Amount) *Vesting {
+	return &Vesting{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Amount:      amt,
+	}
+}
+
+// BasicCheck performs basic checks on the vesting schedule.
+func (v *Vesting) BasicCheck() error {
+	if v.EndHeight < v.StartHeight {
+		return fmt.Errorf("end height is less than start height: %d < %d",
+			v.EndHeight, v.StartHeight)
+	}
+	if v.Amount <= 0 || v.Amount > amount.MaxNanoPAC {
+		return fmt.Errorf("invalid vesting amount: %s", v.Amount)
+	}
+
+	return nil
+}
+
+// LockedAmount returns the amount that is still locked at the given height.
+func (v *Vesting) LockedAmount(height uint32) amount.Amount {
+	if height >= v.EndHeight {
+		return 0
+	}
+	if height < v.StartHeight {
+		return v.Amount
+	}
+
+	// Amount * (EndHeight - height) / (EndHeight - StartHeight), without overflow.
+	hi, lo := bits.Mul64(uint64(v.Amount), uint64(v.EndHeight-height))
+	locked, _ := bits.Div64(hi, lo, uint64(v.EndHeight-v.StartHeight))
+
+	return amount.Amount(locked)
+}
+
+// IsFinished returns true if the whole amount is unlocked at the given height.
+func (v *Vesting) IsFinished(height uint32) bool {
+	return height >= v.EndHeight
+}
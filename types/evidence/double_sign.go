@@ -0,0 +1,174 @@
+// Package evidence provides the evidences of the misbehavior of validators.
+package evidence
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+// DoubleSign is the evidence that a validator signed two different block hashes
+// for the same height, round and vote type.
+// The votes are sorted by the hashes of their sign bytes, so the same conflicting votes always make the same evidence.
+type DoubleSign struct {
+	data doubleSignData
+}
+
+type doubleSignData struct {
+	Vote1 *vote.Vote `cbor:"1,keyasint"`
+	Vote2 *vote.Vote `cbor:"2,keyasint"`
+}
+
+// NewDoubleSign creates a new double-sign evidence from two conflicting votes.
+func NewDoubleSign(vote1, vote2 *vote.Vote) *DoubleSign {
+	if !isSorted(vote1, vote2) {
+		vote1, vote2 = vote2, vote1
+	}
+
+	return &DoubleSign{
+		data: doubleSignData{
+			Vote1: vote1,
+			Vote2: vote2,
+		},
+	}
+}
+
+// isSorted checks if the votes are sorted by the hashes of their sign bytes.
+// Unlike the hash of the vote, the hash of the sign bytes doesn't depend on the signature.
+func isSorted(vote1, vote2 *vote.Vote) bool {
+	h1 := hash.CalcHash(vote1.SignBytes())
+	h2 := hash.CalcHash(vote2.SignBytes())
+
+	return bytes.Compare(h1.Bytes(), h2.Bytes()) <= 0
+}
+
+// IsConflicting checks if the given votes are signed by the same signer
+// for two different block hashes at the same height, round and vote type.
+func IsConflicting(vote1, vote2 *vote.Vote) bool {
+	if vote1.Signer() != vote2.Signer() ||
+		vote1.Type() != vote2.Type() ||
+		vote1.Height() != vote2.Height() ||
+		vote1.Round() != vote2.Round() {
+		return false
+	}
+
+	if vote1.IsCPVote() && vote1.CPRound() != vote2.CPRound() {
+		return false
+	}
+
+	return vote1.BlockHash() != vote2.BlockHash()
+}
+
+// FromBytes decodes the double-sign evidence from the given bytes.
+func FromBytes(data []byte) (*DoubleSign, error) {
+	ev := new(DoubleSign)
+	if err := cbor.Unmarshal(data, ev); err != nil {
+		return nil, err
+	}
+
+	return ev, nil
+}
+
+// Vote1 returns the first conflicting vote.
+func (ev *DoubleSign) Vote1() *vote.Vote {
+	return ev.data.Vote1
+}
+
+// Vote2 returns the second conflicting vote.
+func (ev *DoubleSign) Vote2() *vote.Vote {
+	return ev.data.Vote2
+}
+
+// Signer returns the address of the validator that signed the conflicting votes.
+func (ev *DoubleSign) Signer() crypto.Address {
+	return ev.data.Vote1.Signer()
+}
+
+// Height returns the height of the conflicting votes.
+func (ev *DoubleSign) Height() uint32 {
+	return ev.data.Vote1.Height()
+}
+
+// Round returns the round of the conflicting votes.
+func (ev *DoubleSign) Round() int16 {
+	return ev.data.Vote1.Round()
+}
+
+// BasicCheck performs a basic check on the evidence.
+// It doesn't verify the signatures of the votes.
+func (ev *DoubleSign) BasicCheck() error {
+	if ev.data.Vote1 == nil || ev.data.Vote2 == nil {
+		return BasicCheckError{
+			Reason: "no vote",
+		}
+	}
+	if err := ev.data.Vote1.BasicCheck(); err != nil {
+		return err
+	}
+	if err := ev.data.Vote2.BasicCheck(); err != nil {
+		return err
+	}
+	if !IsConflicting(ev.data.Vote1, ev.data.Vote2) {
+		return BasicCheckError{
+			Reason: "votes are not conflicting",
+		}
+	}
+	if !isSorted(ev.data.Vote1, ev.data.Vote2) {
+		return BasicCheckError{
+			Reason: "votes are not sorted",
+		}
+	}
+
+	return nil
+}
+
+// Verify checks the signatures of both votes with the public key of the signer.
+func (ev *DoubleSign) Verify(pubKey *bls.PublicKey) error {
+	if err := ev.data.Vote1.Verify(pubKey); err != nil {
+		return err
+	}
+
+	return ev.data.Vote2.Verify(pubKey)
+}
+
+// MarshalCBOR marshals the evidence into CBOR format.
+func (ev *DoubleSign) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(ev.data)
+}
+
+// UnmarshalCBOR unmarshals the evidence from CBOR format.
+func (ev *DoubleSign) UnmarshalCBOR(bs []byte) error {
+	return cbor.Unmarshal(bs, &ev.data)
+}
+
+// Bytes returns the serialized bytes of the evidence.
+func (ev *DoubleSign) Bytes() ([]byte, error) {
+	return cbor.Marshal(ev.data)
+}
+
+// Hash calculates the hash of the evidence.
+// It is calculated from the sign bytes of the votes in the sorted order,
+// so it doesn't depend on the order of the votes or their signatures.
+func (ev *DoubleSign) Hash() hash.Hash {
+	vote1, vote2 := ev.data.Vote1, ev.data.Vote2
+	if !isSorted(vote1, vote2) {
+		vote1, vote2 = vote2, vote1
+	}
+
+	h1 := hash.CalcHash(vote1.SignBytes())
+	h2 := hash.CalcHash(vote2.SignBytes())
+
+	return hash.CalcHash(append(h1.Bytes(), h2.Bytes()...))
+}
+
+func (ev *DoubleSign) String() string {
+	return fmt.Sprintf("{double-sign 👤 %s %v ⚔ %v}",
+		ev.Signer().ShortString(),
+		ev.data.Vote1.String(),
+		ev.data.Vote2.String())
+}
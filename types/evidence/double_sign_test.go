@@ -0,0 +1,107 @@
+package evidence_test
+
+import (
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/types/evidence"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoubleSignEncoding(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	ev, _ := ts.GenerateTestDoubleSign(ts.RandHeight(), ts.RandRound())
+	data, err := ev.Bytes()
+	require.NoError(t, err)
+
+	decoded, err := evidence.FromBytes(data)
+	require.NoError(t, err)
+	assert.Equal(t, ev.Hash(), decoded.Hash())
+	assert.NoError(t, decoded.BasicCheck())
+
+	_, err = evidence.FromBytes([]byte{1})
+	assert.Error(t, err)
+}
+
+func TestDoubleSignOrder(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	ev, _ := ts.GenerateTestDoubleSign(ts.RandHeight(), ts.RandRound())
+	swapped := evidence.NewDoubleSign(ev.Vote2(), ev.Vote1())
+
+	assert.Equal(t, ev.Hash(), swapped.Hash())
+	assert.Equal(t, ev.Vote1(), swapped.Vote1())
+	assert.Equal(t, ev.Vote1().Signer(), ev.Signer())
+	assert.Equal(t, ev.Vote1().Height(), ev.Height())
+	assert.Equal(t, ev.Vote1().Round(), ev.Round())
+}
+
+func TestDoubleSignBasicCheck(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("Same block hash", func(t *testing.T) {
+		vote1, valKey := ts.GenerateTestPrepareVote(ts.RandHeight(), 0)
+		vote2 := vote.NewPrepareVote(vote1.BlockHash(), vote1.Height(), 0, valKey.Address())
+		ts.HelperSignVote(valKey, vote2)
+
+		ev := evidence.NewDoubleSign(vote1, vote2)
+		assert.ErrorIs(t, ev.BasicCheck(), evidence.BasicCheckError{Reason: "votes are not conflicting"})
+	})
+
+	t.Run("Different rounds", func(t *testing.T) {
+		vote1, valKey := ts.GenerateTestPrepareVote(ts.RandHeight(), 0)
+		vote2 := vote.NewPrepareVote(ts.RandHash(), vote1.Height(), 1, valKey.Address())
+		ts.HelperSignVote(valKey, vote2)
+
+		ev := evidence.NewDoubleSign(vote1, vote2)
+		assert.ErrorIs(t, ev.BasicCheck(), evidence.BasicCheckError{Reason: "votes are not conflicting"})
+	})
+
+	t.Run("Different signers", func(t *testing.T) {
+		vote1, _ := ts.GenerateTestPrepareVote(ts.RandHeight(), 0)
+		vote2, _ := ts.GenerateTestPrepareVote(vote1.Height(), 0)
+
+		ev := evidence.NewDoubleSign(vote1, vote2)
+		assert.ErrorIs(t, ev.BasicCheck(), evidence.BasicCheckError{Reason: "votes are not conflicting"})
+	})
+
+	t.Run("Different vote types", func(t *testing.T) {
+		vote1, valKey := ts.GenerateTestPrepareVote(ts.RandHeight(), 0)
+		vote2 := vote.NewPrecommitVote(ts.RandHash(), vote1.Height(), 0, valKey.Address())
+		ts.HelperSignVote(valKey, vote2)
+
+		ev := evidence.NewDoubleSign(vote1, vote2)
+		assert.ErrorIs(t, ev.BasicCheck(), evidence.BasicCheckError{Reason: "votes are not conflicting"})
+	})
+
+	t.Run("Unsorted votes", func(t *testing.T) {
+		ev, _ := ts.GenerateTestDoubleSign(ts.RandHeight(), ts.RandRound())
+		data, _ := cbor.Marshal(map[int]*vote.Vote{1: ev.Vote2(), 2: ev.Vote1()})
+		unsorted, err := evidence.FromBytes(data)
+		require.NoError(t, err)
+
+		assert.ErrorIs(t, unsorted.BasicCheck(), evidence.BasicCheckError{Reason: "votes are not sorted"})
+		assert.Equal(t, ev.Hash(), unsorted.Hash())
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		ev, _ := ts.GenerateTestDoubleSign(ts.RandHeight(), ts.RandRound())
+
+		assert.NoError(t, ev.BasicCheck())
+		assert.Contains(t, ev.String(), ev.Signer().ShortString())
+	})
+}
+
+func TestDoubleSignVerify(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	ev, valKey := ts.GenerateTestDoubleSign(ts.RandHeight(), ts.RandRound())
+	assert.NoError(t, ev.Verify(valKey.PublicKey()))
+
+	otherKey := ts.RandValKey()
+	assert.Error(t, ev.Verify(otherKey.PublicKey()))
+}
@@ -0,0 +1,10 @@
+package evidence
+
+// BasicCheckError is returned when the basic check on the evidence fails.
+type BasicCheckError struct {
+	Reason string
+}
+
+func (e BasicCheckError) Error() string {
+	return e.Reason
+}
@@ -6,6 +6,10 @@ import (
 	"github.com/pactus-project/pactus/types/amount"
 )
 
+// BlockVersionV2 is the block version that activates the features added after the first block version.
+// It is activated by an upgrade that bumps the block version.
+const BlockVersionV2 uint8 = 2
+
 type Params struct {
 	BlockVersion              uint8         `cbor:"1,keyasint"  json:"block_version"`
 	BlockIntervalInSecond     int           `cbor:"2,keyasint"  json:"block_interval_in_second"`
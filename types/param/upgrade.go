@@ -0,0 +1,61 @@
+package param
+
+import (
+	"github.com/pactus-project/pactus/types/amount"
+)
+
+// Overrides holds the parameters that are changed by an upgrade.
+// Nil fields keep their previous values.
+type Overrides struct {
+	BlockIntervalInSecond     *int           `cbor:"2,keyasint,omitempty"  json:"block_interval_in_second,omitempty"`
+	CommitteeSize             *int           `cbor:"3,keyasint,omitempty"  json:"committee_size,omitempty"`
+	BlockReward               *amount.Amount `cbor:"4,keyasint,omitempty"  json:"block_reward,omitempty"`
+	TransactionToLiveInterval *uint32        `cbor:"5,keyasint,omitempty"  json:"transaction_to_live_interval,omitempty"`
+	BondInterval              *uint32        `cbor:"6,keyasint,omitempty"  json:"bond_interval,omitempty"`
+	UnbondInterval            *uint32        `cbor:"7,keyasint,omitempty"  json:"unbond_interval,omitempty"`
+	SortitionInterval         *uint32        `cbor:"8,keyasint,omitempty"  json:"sortition_interval,omitempty"`
+	FeeFraction               *float64       `cbor:"9,keyasint,omitempty"  json:"fee_fraction,omitempty"`
+	MinimumFee                *amount.Amount `cbor:"10,keyasint,omitempty" json:"minimum_fee,omitempty"`
+	MaximumFee                *amount.Amount `cbor:"11,keyasint,omitempty" json:"maximum_fee,omitempty"`
+	MinimumStake              *amount.Amount `cbor:"12,keyasint,omitempty" json:"minimum_stake,omitempty"`
+	MaximumStake              *amount.Amount `cbor:"13,keyasint,omitempty" json:"maximum_stake,omitempty"`
+}
+
+// Upgrade changes the parameters of the chain, starting from the block at the given height.
+// A zero block version keeps the previous block version.
+type Upgrade struct {
+	Height       uint32    `cbor:"1,keyasint" json:"height"`
+	BlockVersion uint8     `cbor:"2,keyasint" json:"block_version,omitempty"`
+	Overrides    Overrides `cbor:"3,keyasint" json:"params"`
+}
+
+// Apply returns a copy of the given parameters with the upgrade applied.
+func (u *Upgrade) Apply(params *Params) *Params {
+	upgraded := *params
+
+	if u.BlockVersion != 0 {
+		upgraded.BlockVersion = u.BlockVersion
+	}
+
+	o := u.Overrides
+	override(&upgraded.BlockIntervalInSecond, o.BlockIntervalInSecond)
+	override(&upgraded.CommitteeSize, o.CommitteeSize)
+	override(&upgraded.BlockReward, o.BlockReward)
+	override(&upgraded.TransactionToLiveInterval, o.TransactionToLiveInterval)
+	override(&upgraded.BondInterval, o.BondInterval)
+	override(&upgraded.UnbondInterval, o.UnbondInterval)
+	override(&upgraded.SortitionInterval, o.SortitionInterval)
+	override(&upgraded.FeeFraction, o.FeeFraction)
+	override(&upgraded.MinimumFee, o.MinimumFee)
+	override(&upgraded.MaximumFee, o.MaximumFee)
+	override(&upgraded.MinimumStake, o.MinimumStake)
+	override(&upgraded.MaximumStake, o.MaximumStake)
+
+	return &upgraded
+}
+
+func override[T any](field, value *T) {
+	if value != nil {
+		*field = *value
+	}
+}
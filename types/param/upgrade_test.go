@@ -0,0 +1,35 @@
+package param_test
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyUpgrade(t *testing.T) {
+	params := param.DefaultParams()
+
+	minFee := amount.Amount(2000)
+	feeFraction := 0.0002
+	upgrade := param.Upgrade{
+		Height: 1000,
+		Overrides: param.Overrides{
+			MinimumFee:  &minFee,
+			FeeFraction: &feeFraction,
+		},
+	}
+
+	upgraded := upgrade.Apply(params)
+	assert.Equal(t, minFee, upgraded.MinimumFee)
+	assert.Equal(t, feeFraction, upgraded.FeeFraction)
+	assert.Equal(t, params.BlockVersion, upgraded.BlockVersion)
+	assert.Equal(t, params.MaximumFee, upgraded.MaximumFee)
+
+	// The original parameters should not be changed.
+	assert.Equal(t, param.DefaultParams(), params)
+
+	upgrade.BlockVersion = 2
+	assert.Equal(t, uint8(2), upgrade.Apply(params).BlockVersion)
+}
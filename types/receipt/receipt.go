@@ -0,0 +1,119 @@
+// Package receipt provides the receipt of an executed transaction.
+package receipt
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+// Change represents the effect of a transaction on an account or a validator.
+// For accounts, only the balance delta is set.
+// For validators, only the stake and power deltas are set.
+type Change struct {
+	Address      crypto.Address
+	BalanceDelta amount.Amount
+	StakeDelta   amount.Amount
+	PowerDelta   int64
+}
+
+// Receipt holds the outcome of executing a transaction.
+type Receipt struct {
+	Fee     amount.Amount
+	Changes []Change
+}
+
+// FromBytes constructs a new receipt from byte array.
+func FromBytes(data []byte) (*Receipt, error) {
+	rcpt := new(Receipt)
+	if err := rcpt.Decode(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	return rcpt, nil
+}
+
+// Change returns the change of the given address, or nil if the address is not affected.
+func (rcpt *Receipt) Change(addr crypto.Address) *Change {
+	for i, change := range rcpt.Changes {
+		if change.Address == addr {
+			return &rcpt.Changes[i]
+		}
+	}
+
+	return nil
+}
+
+// SerializeSize returns the size in bytes required to serialize the receipt.
+func (rcpt *Receipt) SerializeSize() int {
+	size := 8 + encoding.VarIntSerializeSize(uint64(len(rcpt.Changes)))
+	for _, change := range rcpt.Changes {
+		size += change.Address.SerializeSize() + 24 // 8+8+8
+	}
+
+	return size
+}
+
+// Encode writes the receipt to the given writer.
+func (rcpt *Receipt) Encode(w io.Writer) error {
+	if err := encoding.WriteElements(w, rcpt.Fee); err != nil {
+		return err
+	}
+	if err := encoding.WriteVarInt(w, uint64(len(rcpt.Changes))); err != nil {
+		return err
+	}
+	for _, change := range rcpt.Changes {
+		if err := change.Address.Encode(w); err != nil {
+			return err
+		}
+		err := encoding.WriteElements(w,
+			change.BalanceDelta,
+			change.StakeDelta,
+			change.PowerDelta)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode reads the receipt from the given reader.
+func (rcpt *Receipt) Decode(r io.Reader) error {
+	if err := encoding.ReadElements(r, &rcpt.Fee); err != nil {
+		return err
+	}
+	count, err := encoding.ReadVarInt(r)
+	if err != nil {
+		return err
+	}
+	rcpt.Changes = make([]Change, count)
+	for i := range rcpt.Changes {
+		change := &rcpt.Changes[i]
+		if err := change.Address.Decode(r); err != nil {
+			return err
+		}
+		err := encoding.ReadElements(r,
+			&change.BalanceDelta,
+			&change.StakeDelta,
+			&change.PowerDelta)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Bytes returns the serialized byte representation of the receipt.
+func (rcpt *Receipt) Bytes() ([]byte, error) {
+	w := bytes.NewBuffer(make([]byte, 0, rcpt.SerializeSize()))
+	if err := rcpt.Encode(w); err != nil {
+		return nil, err
+	}
+
+	return w.Bytes(), nil
+}
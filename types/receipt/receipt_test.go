@@ -0,0 +1,48 @@
+package receipt_test
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/receipt"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromBytes(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valAddr := ts.RandValAddress()
+	rcpt := &receipt.Receipt{
+		Fee: ts.RandAmount(),
+		Changes: []receipt.Change{
+			{Address: ts.RandAccAddress(), BalanceDelta: -ts.RandAmount()},
+			{Address: crypto.TreasuryAddress, BalanceDelta: ts.RandAmount()},
+			{Address: valAddr, StakeDelta: ts.RandAmount(), PowerDelta: ts.RandInt64(1e9)},
+		},
+	}
+	bs, err := rcpt.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, rcpt.SerializeSize(), len(bs))
+
+	rcpt2, err := receipt.FromBytes(bs)
+	require.NoError(t, err)
+	assert.Equal(t, rcpt, rcpt2)
+	assert.Equal(t, &rcpt.Changes[2], rcpt2.Change(valAddr))
+	assert.Nil(t, rcpt2.Change(ts.RandValAddress()))
+
+	_, err = receipt.FromBytes(bs[:len(bs)-1])
+	assert.Error(t, err)
+}
+
+func TestEmptyReceipt(t *testing.T) {
+	rcpt := &receipt.Receipt{Changes: []receipt.Change{}}
+	bs, err := rcpt.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, rcpt.SerializeSize(), len(bs))
+
+	rcpt2, err := receipt.FromBytes(bs)
+	require.NoError(t, err)
+	assert.Equal(t, rcpt, rcpt2)
+}
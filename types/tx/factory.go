@@ -6,6 +6,7 @@ import (
 	"github.com/pactus-project/pactus/sortition"
 	"github.com/pactus-project/pactus/types/amount"
 	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/vote"
 )
 
 func NewSubsidyTx(lockTime uint32,
@@ -33,6 +34,36 @@ func NewTransferTx(lockTime uint32,
 	return newTx(lockTime, pld, fee, memo)
 }
 
+func NewBatchTransferTx(lockTime uint32,
+	sender crypto.Address,
+	recipients []payload.BatchTransferRecipient,
+	fee amount.Amount, memo string,
+) *Tx {
+	pld := &payload.BatchTransferPayload{
+		From:       sender,
+		Recipients: recipients,
+	}
+
+	return newTx(lockTime, pld, fee, memo)
+}
+
+func NewVestingTransferTx(lockTime uint32,
+	sender, receiver crypto.Address,
+	amt amount.Amount,
+	startHeight, endHeight uint32,
+	fee amount.Amount, memo string,
+) *Tx {
+	pld := &payload.VestingTransferPayload{
+		From:        sender,
+		To:          receiver,
+		Amount:      amt,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+
+	return newTx(lockTime, pld, fee, memo)
+}
+
 func NewBondTx(lockTime uint32,
 	sender, receiver crypto.Address,
 	pubKey *bls.PublicKey,
@@ -59,6 +90,34 @@ func NewUnbondTx(lockTime uint32,
 	return newTx(lockTime, pld, 0, memo)
 }
 
+func NewPartialUnbondTx(lockTime uint32,
+	val crypto.Address,
+	amt amount.Amount,
+	memo string,
+) *Tx {
+	pld := &payload.PartialUnbondPayload{
+		Validator: val,
+		Amount:    amt,
+	}
+
+	return newTx(lockTime, pld, 0, memo)
+}
+
+func NewRotateKeyTx(lockTime uint32,
+	val crypto.Address,
+	newPub *bls.PublicKey,
+	newKeySig *bls.Signature,
+	memo string,
+) *Tx {
+	pld := &payload.RotateKeyPayload{
+		Validator:       val,
+		NewPublicKey:    newPub,
+		NewKeySignature: newKeySig,
+	}
+
+	return newTx(lockTime, pld, 0, memo)
+}
+
 func NewWithdrawTx(lockTime uint32,
 	val, acc crypto.Address,
 	amt, fee amount.Amount,
@@ -73,6 +132,20 @@ func NewWithdrawTx(lockTime uint32,
 	return newTx(lockTime, pld, fee, memo)
 }
 
+func NewSlashTx(lockTime uint32,
+	reporter crypto.Address,
+	vote1, vote2 *vote.Vote,
+	memo string,
+) *Tx {
+	pld := &payload.SlashPayload{
+		Reporter: reporter,
+		Vote1:    vote1,
+		Vote2:    vote2,
+	}
+
+	return newTx(lockTime, pld, 0, memo)
+}
+
 func NewSortitionTx(lockTime uint32,
 	addr crypto.Address,
 	proof sortition.Proof,
@@ -0,0 +1,180 @@
+package payload
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+// MaxBatchTransferRecipients is the maximum number of recipients in a batch transfer.
+const MaxBatchTransferRecipients = 256
+
+// BatchTransferRecipient is a receiver of a batch transfer, alongside the amount it receives.
+type BatchTransferRecipient struct {
+	To     crypto.Address
+	Amount amount.Amount
+}
+
+// BatchTransferPayload moves funds from one sender to multiple receivers in a single transaction.
+type BatchTransferPayload struct {
+	From       crypto.Address
+	Recipients []BatchTransferRecipient
+}
+
+func (p *BatchTransferPayload) Type() Type {
+	return TypeBatchTransfer
+}
+
+func (p *BatchTransferPayload) Signer() crypto.Address {
+	return p.From
+}
+
+// Value returns the sum of the amounts that are transferred to the recipients.
+func (p *BatchTransferPayload) Value() amount.Amount {
+	total := amount.Amount(0)
+	for _, rcp := range p.Recipients {
+		total += rcp.Amount
+	}
+
+	return total
+}
+
+func (p *BatchTransferPayload) BasicCheck() error {
+	if !p.From.IsAccountAddress() {
+		return BasicCheckError{
+			Reason: "sender is not an account address: " + p.From.String(),
+		}
+	}
+	if len(p.Recipients) == 0 {
+		return BasicCheckError{
+			Reason: "no recipient",
+		}
+	}
+	if len(p.Recipients) > MaxBatchTransferRecipients {
+		return BasicCheckError{
+			Reason: fmt.Sprintf("too many recipients: %d", len(p.Recipients)),
+		}
+	}
+
+	total := amount.Amount(0)
+	receivers := make(map[crypto.Address]bool, len(p.Recipients))
+	for _, rcp := range p.Recipients {
+		if !rcp.To.IsAccountAddress() {
+			return BasicCheckError{
+				Reason: "receiver is not an account address: " + rcp.To.String(),
+			}
+		}
+		if receivers[rcp.To] {
+			return BasicCheckError{
+				Reason: "duplicated receiver: " + rcp.To.String(),
+			}
+		}
+		receivers[rcp.To] = true
+
+		// Checking the amounts one by one, so the total amount can't overflow.
+		total += rcp.Amount
+		if rcp.Amount <= 0 || total > amount.MaxNanoPAC {
+			return BasicCheckError{
+				Reason: fmt.Sprintf("invalid amount: %s", rcp.Amount),
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *BatchTransferPayload) SerializeSize() int {
+	size := p.From.SerializeSize() +
+		encoding.VarIntSerializeSize(uint64(len(p.Recipients)))
+	for _, rcp := range p.Recipients {
+		size += rcp.To.SerializeSize() +
+			encoding.VarIntSerializeSize(uint64(rcp.Amount))
+	}
+
+	return size
+}
+
+func (p *BatchTransferPayload) Encode(w io.Writer) error {
+	err := p.From.Encode(w)
+	if err != nil {
+		return err
+	}
+
+	err = encoding.WriteVarInt(w, uint64(len(p.Recipients)))
+	if err != nil {
+		return err
+	}
+
+	for _, rcp := range p.Recipients {
+		err = rcp.To.Encode(w)
+		if err != nil {
+			return err
+		}
+
+		err = encoding.WriteVarInt(w, uint64(rcp.Amount))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *BatchTransferPayload) Decode(r io.Reader) error {
+	err := p.From.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	count, err := encoding.ReadVarInt(r)
+	if err != nil {
+		return err
+	}
+	if count > MaxBatchTransferRecipients {
+		return BasicCheckError{
+			Reason: fmt.Sprintf("too many recipients: %d", count),
+		}
+	}
+
+	p.Recipients = make([]BatchTransferRecipient, count)
+	for i := range p.Recipients {
+		err = p.Recipients[i].To.Decode(r)
+		if err != nil {
+			return err
+		}
+
+		amt, err := encoding.ReadVarInt(r)
+		if err != nil {
+			return err
+		}
+		p.Recipients[i].Amount = amount.Amount(amt)
+	}
+
+	return nil
+}
+
+func (p *BatchTransferPayload) String() string {
+	return fmt.Sprintf("{Batch 💸 %s->%d recipients %s",
+		p.From.ShortString(),
+		len(p.Recipients),
+		p.Value())
+}
+
+// Receiver returns nil, since a batch transfer has multiple receivers.
+// Use Receivers to get all of them.
+func (p *BatchTransferPayload) Receiver() *crypto.Address {
+	return nil
+}
+
+// Receivers returns the addresses of the recipients.
+func (p *BatchTransferPayload) Receivers() []crypto.Address {
+	addrs := make([]crypto.Address, 0, len(p.Recipients))
+	for _, rcp := range p.Recipients {
+		addrs = append(addrs, rcp.To)
+	}
+
+	return addrs
+}
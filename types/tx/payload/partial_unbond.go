@@ -0,0 +1,83 @@
+package payload
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+// PartialUnbondPayload unbonds part of the validator's stake.
+// The remaining stake keeps validating, and the unbonded amount
+// can be withdrawn once the unbonding interval has passed.
+type PartialUnbondPayload struct {
+	Validator crypto.Address
+	Amount    amount.Amount
+}
+
+func (p *PartialUnbondPayload) Type() Type {
+	return TypePartialUnbond
+}
+
+func (p *PartialUnbondPayload) Signer() crypto.Address {
+	return p.Validator
+}
+
+func (p *PartialUnbondPayload) Value() amount.Amount {
+	return p.Amount
+}
+
+func (p *PartialUnbondPayload) BasicCheck() error {
+	if !p.Validator.IsValidatorAddress() {
+		return BasicCheckError{
+			Reason: "address is not a validator address",
+		}
+	}
+	if p.Amount <= 0 {
+		return BasicCheckError{
+			Reason: "unbond amount should be positive",
+		}
+	}
+
+	return nil
+}
+
+func (p *PartialUnbondPayload) SerializeSize() int {
+	return 21 + encoding.VarIntSerializeSize(uint64(p.Amount))
+}
+
+func (p *PartialUnbondPayload) Encode(w io.Writer) error {
+	err := p.Validator.Encode(w)
+	if err != nil {
+		return err
+	}
+
+	return encoding.WriteVarInt(w, uint64(p.Amount))
+}
+
+func (p *PartialUnbondPayload) Decode(r io.Reader) error {
+	err := p.Validator.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	amt, err := encoding.ReadVarInt(r)
+	if err != nil {
+		return err
+	}
+	p.Amount = amount.Amount(amt)
+
+	return nil
+}
+
+func (p *PartialUnbondPayload) String() string {
+	return fmt.Sprintf("{Unbond 🔓 %s %s",
+		p.Validator.ShortString(),
+		p.Amount)
+}
+
+func (p *PartialUnbondPayload) Receiver() *crypto.Address {
+	return nil
+}
@@ -11,11 +11,16 @@ import (
 type Type uint8
 
 const (
-	TypeTransfer  = Type(1)
-	TypeBond      = Type(2)
-	TypeSortition = Type(3)
-	TypeUnbond    = Type(4)
-	TypeWithdraw  = Type(5)
+	TypeTransfer        = Type(1)
+	TypeBond            = Type(2)
+	TypeSortition       = Type(3)
+	TypeUnbond          = Type(4)
+	TypeWithdraw        = Type(5)
+	TypeSlash           = Type(6)
+	TypeBatchTransfer   = Type(7)
+	TypeVestingTransfer = Type(8)
+	TypePartialUnbond   = Type(9)
+	TypeRotateKey       = Type(10)
 )
 
 func (t Type) String() string {
@@ -30,6 +35,16 @@ func (t Type) String() string {
 		return "withdraw"
 	case TypeSortition:
 		return "sortition"
+	case TypeSlash:
+		return "slash"
+	case TypeBatchTransfer:
+		return "batch transfer"
+	case TypeVestingTransfer:
+		return "vesting transfer"
+	case TypePartialUnbond:
+		return "partial unbond"
+	case TypeRotateKey:
+		return "rotate key"
 	}
 
 	return fmt.Sprintf("%d", t)
@@ -46,3 +61,15 @@ type Payload interface {
 	String() string
 	Receiver() *crypto.Address
 }
+
+// Receivers returns the receivers of the payload, including all the recipients of a batch transfer.
+func Receivers(pld Payload) []crypto.Address {
+	if batch, ok := pld.(*BatchTransferPayload); ok {
+		return batch.Receivers()
+	}
+	if receiver := pld.Receiver(); receiver != nil {
+		return []crypto.Address{*receiver}
+	}
+
+	return nil
+}
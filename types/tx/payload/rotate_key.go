@@ -0,0 +1,124 @@
+package payload
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/util"
+)
+
+// RotateKeyPayload replaces the consensus key of a validator with a new one.
+// The transaction is signed by the current key of the validator,
+// and the new key signs the rotation to prove that it is owned by the validator.
+// The validator keeps its number, stake and bonding history,
+// but its address changes to the address of the new key.
+// The new key is effective from the height of the block that includes the transaction,
+// and the previous key is kept by the validator, so the votes signed by it can still be slashed.
+type RotateKeyPayload struct {
+	Validator       crypto.Address
+	NewPublicKey    *bls.PublicKey
+	NewKeySignature *bls.Signature
+}
+
+// RotateKeySignBytes returns the bytes that should be signed by the new key of the validator.
+func RotateKeySignBytes(valAddr crypto.Address, newPublicKey *bls.PublicKey) []byte {
+	sb := util.StringToBytes(TypeRotateKey.String())
+	sb = append(sb, valAddr.Bytes()...)
+	sb = append(sb, newPublicKey.Bytes()...)
+
+	return sb
+}
+
+func (p *RotateKeyPayload) Type() Type {
+	return TypeRotateKey
+}
+
+func (p *RotateKeyPayload) Signer() crypto.Address {
+	return p.Validator
+}
+
+func (p *RotateKeyPayload) Value() amount.Amount {
+	return 0
+}
+
+func (p *RotateKeyPayload) BasicCheck() error {
+	if !p.Validator.IsValidatorAddress() {
+		return BasicCheckError{
+			Reason: "address is not a validator address",
+		}
+	}
+	if p.NewPublicKey == nil || p.NewKeySignature == nil {
+		return BasicCheckError{
+			Reason: "new key is not set",
+		}
+	}
+	if p.NewPublicKey.ValidatorAddress() == p.Validator {
+		return BasicCheckError{
+			Reason: "new key is the same as the current key",
+		}
+	}
+
+	signBytes := RotateKeySignBytes(p.Validator, p.NewPublicKey)
+	if err := p.NewPublicKey.Verify(signBytes, p.NewKeySignature); err != nil {
+		return BasicCheckError{
+			Reason: "invalid signature of the new key",
+		}
+	}
+
+	return nil
+}
+
+func (p *RotateKeyPayload) SerializeSize() int {
+	return 165 // 21+96+48
+}
+
+func (p *RotateKeyPayload) Encode(w io.Writer) error {
+	err := p.Validator.Encode(w)
+	if err != nil {
+		return err
+	}
+
+	err = p.NewPublicKey.Encode(w)
+	if err != nil {
+		return err
+	}
+
+	return p.NewKeySignature.Encode(w)
+}
+
+func (p *RotateKeyPayload) Decode(r io.Reader) error {
+	err := p.Validator.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	p.NewPublicKey = new(bls.PublicKey)
+	err = p.NewPublicKey.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	p.NewKeySignature = new(bls.Signature)
+
+	return p.NewKeySignature.Decode(r)
+}
+
+func (p *RotateKeyPayload) String() string {
+	return fmt.Sprintf("{RotateKey 🔑 %s->%s",
+		p.Validator.ShortString(),
+		p.NewAddress().ShortString())
+}
+
+// NewAddress returns the validator address of the new key.
+func (p *RotateKeyPayload) NewAddress() crypto.Address {
+	return p.NewPublicKey.ValidatorAddress()
+}
+
+func (p *RotateKeyPayload) Receiver() *crypto.Address {
+	addr := p.NewAddress()
+
+	return &addr
+}
@@ -0,0 +1,125 @@
+package payload
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/evidence"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+// SlashPayload carries two conflicting votes of a validator, as the proof of equivocation.
+// The reporter signs the transaction and receives part of the slashed stake.
+type SlashPayload struct {
+	Reporter crypto.Address
+	Vote1    *vote.Vote
+	Vote2    *vote.Vote
+}
+
+func (p *SlashPayload) Type() Type {
+	return TypeSlash
+}
+
+func (p *SlashPayload) Signer() crypto.Address {
+	return p.Reporter
+}
+
+func (p *SlashPayload) Value() amount.Amount {
+	return 0
+}
+
+// Offender returns the address of the validator that signed the conflicting votes.
+func (p *SlashPayload) Offender() crypto.Address {
+	return p.Vote1.Signer()
+}
+
+func (p *SlashPayload) BasicCheck() error {
+	if !p.Reporter.IsAccountAddress() {
+		return BasicCheckError{
+			Reason: "reporter is not an account address: " + p.Reporter.String(),
+		}
+	}
+	if p.Vote1 == nil || p.Vote2 == nil {
+		return BasicCheckError{
+			Reason: "no vote",
+		}
+	}
+	if err := p.Vote1.BasicCheck(); err != nil {
+		return err
+	}
+	if err := p.Vote2.BasicCheck(); err != nil {
+		return err
+	}
+	if !evidence.IsConflicting(p.Vote1, p.Vote2) {
+		return BasicCheckError{
+			Reason: "votes are not conflicting",
+		}
+	}
+
+	return nil
+}
+
+func (p *SlashPayload) SerializeSize() int {
+	data1, _ := cbor.Marshal(p.Vote1)
+	data2, _ := cbor.Marshal(p.Vote2)
+
+	return p.Reporter.SerializeSize() + encoding.VarBytesSerializeSize(data1) + encoding.VarBytesSerializeSize(data2)
+}
+
+func (p *SlashPayload) Encode(w io.Writer) error {
+	if err := p.Reporter.Encode(w); err != nil {
+		return err
+	}
+
+	for _, v := range []*vote.Vote{p.Vote1, p.Vote2} {
+		data, err := cbor.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if err := encoding.WriteVarBytes(w, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *SlashPayload) Decode(r io.Reader) error {
+	if err := p.Reporter.Decode(r); err != nil {
+		return err
+	}
+
+	votes := make([]*vote.Vote, 2)
+	for i := range votes {
+		data, err := encoding.ReadVarBytes(r)
+		if err != nil {
+			return err
+		}
+		votes[i] = new(vote.Vote)
+		if err := cbor.Unmarshal(data, votes[i]); err != nil {
+			return err
+		}
+	}
+	p.Vote1 = votes[0]
+	p.Vote2 = votes[1]
+
+	return nil
+}
+
+func (p *SlashPayload) String() string {
+	return fmt.Sprintf("{Slash ⚔ %s->%s",
+		p.Reporter.ShortString(),
+		p.Vote1.Signer().ShortString(),
+	)
+}
+
+// Receiver returns the address of the offender, whose stake is slashed.
+func (p *SlashPayload) Receiver() *crypto.Address {
+	offender := p.Offender()
+
+	return &offender
+}
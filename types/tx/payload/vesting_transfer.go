@@ -0,0 +1,123 @@
+package payload
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+// VestingTransferPayload transfers coins to an account that are locked
+// by a vesting schedule, and unlock linearly between the start and end heights.
+// An account can receive multiple vesting schedules, and their locked amounts are summed.
+type VestingTransferPayload struct {
+	From        crypto.Address
+	To          crypto.Address
+	Amount      amount.Amount
+	StartHeight uint32
+	EndHeight   uint32
+}
+
+func (p *VestingTransferPayload) Type() Type {
+	return TypeVestingTransfer
+}
+
+func (p *VestingTransferPayload) Signer() crypto.Address {
+	return p.From
+}
+
+func (p *VestingTransferPayload) Value() amount.Amount {
+	return p.Amount
+}
+
+func (p *VestingTransferPayload) BasicCheck() error {
+	if !p.From.IsAccountAddress() {
+		return BasicCheckError{
+			Reason: "sender is not an account address: " + p.From.String(),
+		}
+	}
+	if !p.To.IsAccountAddress() {
+		return BasicCheckError{
+			Reason: "receiver is not an account address: " + p.To.String(),
+		}
+	}
+	if p.To == p.From {
+		return BasicCheckError{
+			Reason: "sender and receiver are the same",
+		}
+	}
+	if p.Amount <= 0 {
+		return BasicCheckError{
+			Reason: "vesting amount should be positive",
+		}
+	}
+	if p.EndHeight < p.StartHeight {
+		return BasicCheckError{
+			Reason: fmt.Sprintf("end height is less than start height: %d < %d",
+				p.EndHeight, p.StartHeight),
+		}
+	}
+
+	return nil
+}
+
+func (p *VestingTransferPayload) SerializeSize() int {
+	return p.From.SerializeSize() +
+		p.To.SerializeSize() +
+		encoding.VarIntSerializeSize(uint64(p.Amount)) +
+		8 // start and end heights
+}
+
+func (p *VestingTransferPayload) Encode(w io.Writer) error {
+	err := p.From.Encode(w)
+	if err != nil {
+		return err
+	}
+
+	err = p.To.Encode(w)
+	if err != nil {
+		return err
+	}
+
+	err = encoding.WriteVarInt(w, uint64(p.Amount))
+	if err != nil {
+		return err
+	}
+
+	return encoding.WriteElements(w, p.StartHeight, p.EndHeight)
+}
+
+func (p *VestingTransferPayload) Decode(r io.Reader) error {
+	err := p.From.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	err = p.To.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	amt, err := encoding.ReadVarInt(r)
+	if err != nil {
+		return err
+	}
+	p.Amount = amount.Amount(amt)
+
+	return encoding.ReadElements(r, &p.StartHeight, &p.EndHeight)
+}
+
+func (p *VestingTransferPayload) String() string {
+	return fmt.Sprintf("{Vest ⏳ %s->%s %s [%d-%d]",
+		p.From.ShortString(),
+		p.To.ShortString(),
+		p.Amount,
+		p.StartHeight,
+		p.EndHeight)
+}
+
+func (p *VestingTransferPayload) Receiver() *crypto.Address {
+	return &p.To
+}
@@ -246,10 +246,18 @@ func (tx *Tx) SerializeSize() int {
 		n += tx.Payload().SerializeSize()
 	}
 	if tx.data.Signature != nil {
-		n += bls.SignatureSize
+		if _, ok := tx.data.Signature.(*bls.MultisigSignature); ok {
+			n += bls.MultisigSignatureSize
+		} else {
+			n += bls.SignatureSize
+		}
 	}
 	if tx.data.PublicKey != nil {
-		n += bls.PublicKeySize
+		if pub, ok := tx.data.PublicKey.(*bls.MultisigPublicKey); ok {
+			n += pub.SerializeSize()
+		} else {
+			n += bls.PublicKeySize
+		}
 	}
 
 	return n
@@ -336,6 +344,16 @@ func (tx *Tx) Decode(r io.Reader) error {
 		tx.data.Payload = new(payload.WithdrawPayload)
 	case payload.TypeSortition:
 		tx.data.Payload = new(payload.SortitionPayload)
+	case payload.TypeSlash:
+		tx.data.Payload = new(payload.SlashPayload)
+	case payload.TypeBatchTransfer:
+		tx.data.Payload = new(payload.BatchTransferPayload)
+	case payload.TypeVestingTransfer:
+		tx.data.Payload = new(payload.VestingTransferPayload)
+	case payload.TypePartialUnbond:
+		tx.data.Payload = new(payload.PartialUnbondPayload)
+	case payload.TypeRotateKey:
+		tx.data.Payload = new(payload.RotateKeyPayload)
 
 	default:
 		return InvalidPayloadTypeError{
@@ -349,7 +367,18 @@ func (tx *Tx) Decode(r io.Reader) error {
 	}
 
 	if !util.IsFlagSet(tx.data.Flags, flagNotSigned) {
-		sig := new(bls.Signature)
+		// Multisig accounts sign the transactions by an aggregated signature
+		// and a set of public keys.
+		var sig crypto.Signature
+		var pub crypto.PublicKey
+		if tx.data.Payload.Signer().IsMultisigAddress() {
+			sig = new(bls.MultisigSignature)
+			pub = new(bls.MultisigPublicKey)
+		} else {
+			sig = new(bls.Signature)
+			pub = new(bls.PublicKey)
+		}
+
 		err = sig.Decode(r)
 		if err != nil {
 			return err
@@ -357,7 +386,6 @@ func (tx *Tx) Decode(r io.Reader) error {
 		tx.data.Signature = sig
 
 		if !tx.IsPublicKeyStriped() {
-			pub := new(bls.PublicKey)
 			err = pub.Decode(r)
 			if err != nil {
 				return err
@@ -422,6 +450,26 @@ func (tx *Tx) IsWithdrawTx() bool {
 	return tx.Payload().Type() == payload.TypeWithdraw
 }
 
+func (tx *Tx) IsSlashTx() bool {
+	return tx.Payload().Type() == payload.TypeSlash
+}
+
+func (tx *Tx) IsBatchTransferTx() bool {
+	return tx.Payload().Type() == payload.TypeBatchTransfer
+}
+
+func (tx *Tx) IsVestingTransferTx() bool {
+	return tx.Payload().Type() == payload.TypeVestingTransfer
+}
+
+func (tx *Tx) IsPartialUnbondTx() bool {
+	return tx.Payload().Type() == payload.TypePartialUnbond
+}
+
+func (tx *Tx) IsRotateKeyTx() bool {
+	return tx.Payload().Type() == payload.TypeRotateKey
+}
+
 // StripPublicKey removes the public key from the transaction.
 // It is an alias function for `SetPublicKey(nil)`.
 func (tx *Tx) StripPublicKey() {
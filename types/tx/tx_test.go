@@ -7,8 +7,10 @@ import (
 	"testing"
 
 	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/amount"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/util"
@@ -38,13 +40,23 @@ func TestEncodingTx(t *testing.T) {
 	trx3, _ := ts.GenerateTestUnbondTx()
 	trx4, _ := ts.GenerateTestWithdrawTx()
 	trx5, _ := ts.GenerateTestSortitionTx()
+	trx6, _ := ts.GenerateTestSlashTx()
+	trx7, _ := ts.GenerateTestBatchTransferTx()
+	trx8, _ := ts.GenerateTestVestingTransferTx()
+	trx9, _ := ts.GenerateTestPartialUnbondTx()
+	trx10, _ := ts.GenerateTestRotateKeyTx()
 	assert.True(t, trx1.IsTransferTx())
 	assert.True(t, trx2.IsBondTx())
 	assert.True(t, trx3.IsUnbondTx())
 	assert.True(t, trx4.IsWithdrawTx())
 	assert.True(t, trx5.IsSortitionTx())
+	assert.True(t, trx6.IsSlashTx())
+	assert.True(t, trx7.IsBatchTransferTx())
+	assert.True(t, trx8.IsVestingTransferTx())
+	assert.True(t, trx9.IsPartialUnbondTx())
+	assert.True(t, trx10.IsRotateKeyTx())
 
-	tests := []*tx.Tx{trx1, trx2, trx3, trx4, trx5}
+	tests := []*tx.Tx{trx1, trx2, trx3, trx4, trx5, trx6, trx7, trx8, trx9, trx10}
 	for _, trx := range tests {
 		assert.NoError(t, trx.BasicCheck())
 		assert.NoError(t, trx.BasicCheck()) // double basic check
@@ -113,7 +125,7 @@ func TestBasicCheck(t *testing.T) {
 
 	t.Run("Invalid payload, Should returns error", func(t *testing.T) {
 		invAddr := ts.RandAccAddress()
-		invAddr[0] = 3
+		invAddr[0] = 4
 		trx := tx.NewTransferTx(ts.RandHeight(),
 			ts.RandAccAddress(), invAddr, 1e9, ts.RandAmount(), "invalid address")
 
@@ -209,14 +221,14 @@ func TestInvalidPayloadType(t *testing.T) {
 			"01020300" + // LockTime
 			"01" + // Fee
 			"00" + // Memo
-			"06" + // PayloadType
+			"0b" + // PayloadType
 			"00" + // Sender (treasury)
 			"012222222222222222222222222222222222222222" + // Receiver
 			"01") // Amount
 
 	_, err := tx.FromBytes(d)
 	assert.ErrorIs(t, err, tx.InvalidPayloadTypeError{
-		PayloadType: payload.Type(6),
+		PayloadType: payload.Type(11),
 	})
 }
 
@@ -407,3 +419,346 @@ func TestFlagNotSigned(t *testing.T) {
 	trx.SetSignature(nil)
 	assert.False(t, trx.IsSigned(), "FlagNotSigned should not be set when the signature is set to nil")
 }
+
+func TestSlashTx(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("Votes are not conflicting", func(t *testing.T) {
+		vote1, _ := ts.GenerateTestPrecommitVote(ts.RandHeight(), 0)
+		vote2, _ := ts.GenerateTestPrecommitVote(vote1.Height(), 0)
+		trx := tx.NewSlashTx(ts.RandHeight(), ts.RandAccAddress(), vote1, vote2, "not conflicting")
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid payload: votes are not conflicting",
+		})
+	})
+
+	t.Run("Invalid reporter", func(t *testing.T) {
+		ev, _ := ts.GenerateTestDoubleSign(ts.RandHeight(), 0)
+		reporter := ts.RandValAddress()
+		trx := tx.NewSlashTx(ts.RandHeight(), reporter, ev.Vote1(), ev.Vote2(), "invalid reporter")
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid payload: reporter is not an account address: " + reporter.String(),
+		})
+	})
+
+	t.Run("Treasury reporter", func(t *testing.T) {
+		ev, _ := ts.GenerateTestDoubleSign(ts.RandHeight(), 0)
+		trx := tx.NewSlashTx(ts.RandHeight(), crypto.TreasuryAddress, ev.Vote1(), ev.Vote2(), "treasury")
+
+		bs, err := trx.Bytes()
+		assert.NoError(t, err)
+		assert.Len(t, bs, trx.SerializeSize())
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx, _ := ts.GenerateTestSlashTx()
+		pld := trx.Payload().(*payload.SlashPayload)
+
+		assert.NoError(t, trx.BasicCheck())
+		assert.Equal(t, pld.Vote1.Signer(), pld.Offender())
+		assert.Equal(t, pld.Offender(), *trx.Payload().Receiver())
+		assert.Zero(t, trx.Payload().Value())
+	})
+}
+
+func TestBatchTransferTx(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("No recipient", func(t *testing.T) {
+		trx := tx.NewBatchTransferTx(ts.RandHeight(), ts.RandAccAddress(), nil,
+			ts.RandAmount(), "no recipient")
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid payload: no recipient",
+		})
+	})
+
+	t.Run("Too many recipients", func(t *testing.T) {
+		recipients := make([]payload.BatchTransferRecipient, payload.MaxBatchTransferRecipients+1)
+		for i := range recipients {
+			recipients[i] = payload.BatchTransferRecipient{To: ts.RandAccAddress(), Amount: 1}
+		}
+		trx := tx.NewBatchTransferTx(ts.RandHeight(), ts.RandAccAddress(), recipients,
+			ts.RandAmount(), "too many recipients")
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid payload: too many recipients: 257",
+		})
+	})
+
+	t.Run("Duplicated receiver", func(t *testing.T) {
+		receiver := ts.RandAccAddress()
+		recipients := []payload.BatchTransferRecipient{
+			{To: receiver, Amount: ts.RandAmount()},
+			{To: receiver, Amount: ts.RandAmount()},
+		}
+		trx := tx.NewBatchTransferTx(ts.RandHeight(), ts.RandAccAddress(), recipients,
+			ts.RandAmount(), "duplicated receiver")
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid payload: duplicated receiver: " + receiver.String(),
+		})
+	})
+
+	t.Run("Zero amount", func(t *testing.T) {
+		recipients := []payload.BatchTransferRecipient{
+			{To: ts.RandAccAddress(), Amount: ts.RandAmount() + 1},
+			{To: ts.RandAccAddress(), Amount: 0},
+		}
+		trx := tx.NewBatchTransferTx(ts.RandHeight(), ts.RandAccAddress(), recipients,
+			ts.RandAmount(), "zero amount")
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid payload: invalid amount: 0 PAC",
+		})
+	})
+
+	t.Run("Invalid receiver", func(t *testing.T) {
+		receiver := ts.RandValAddress()
+		recipients := []payload.BatchTransferRecipient{
+			{To: receiver, Amount: ts.RandAmount()},
+		}
+		trx := tx.NewBatchTransferTx(ts.RandHeight(), ts.RandAccAddress(), recipients,
+			ts.RandAmount(), "invalid receiver")
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid payload: receiver is not an account address: " + receiver.String(),
+		})
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx, _ := ts.GenerateTestBatchTransferTx()
+		pld := trx.Payload().(*payload.BatchTransferPayload)
+
+		total := amount.Amount(0)
+		for _, rcp := range pld.Recipients {
+			total += rcp.Amount
+		}
+
+		assert.NoError(t, trx.BasicCheck())
+		assert.Nil(t, trx.Payload().Receiver())
+		assert.Len(t, payload.Receivers(trx.Payload()), len(pld.Recipients))
+		assert.Equal(t, total, trx.Payload().Value())
+	})
+}
+
+func TestVestingTransferTx(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("Same sender and receiver", func(t *testing.T) {
+		addr := ts.RandAccAddress()
+		trx := tx.NewVestingTransferTx(ts.RandHeight(), addr, addr,
+			1, 10, 20, ts.RandAmount(), "same receiver")
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid payload: sender and receiver are the same",
+		})
+	})
+
+	t.Run("Zero amount", func(t *testing.T) {
+		trx := tx.NewVestingTransferTx(ts.RandHeight(), ts.RandAccAddress(), ts.RandAccAddress(),
+			0, 10, 20, ts.RandAmount(), "zero amount")
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid payload: vesting amount should be positive",
+		})
+	})
+
+	t.Run("Invalid heights", func(t *testing.T) {
+		trx := tx.NewVestingTransferTx(ts.RandHeight(), ts.RandAccAddress(), ts.RandAccAddress(),
+			1, 20, 10, ts.RandAmount(), "invalid heights")
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid payload: end height is less than start height: 10 < 20",
+		})
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx, _ := ts.GenerateTestVestingTransferTx()
+		pld := trx.Payload().(*payload.VestingTransferPayload)
+
+		assert.NoError(t, trx.BasicCheck())
+		assert.Equal(t, pld.To, *trx.Payload().Receiver())
+		assert.Equal(t, pld.Amount, trx.Payload().Value())
+	})
+}
+
+func TestPartialUnbondTx(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("Invalid validator address", func(t *testing.T) {
+		trx := tx.NewPartialUnbondTx(ts.RandHeight(), ts.RandAccAddress(), 1, "invalid address")
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid payload: address is not a validator address",
+		})
+	})
+
+	t.Run("Zero amount", func(t *testing.T) {
+		trx := tx.NewPartialUnbondTx(ts.RandHeight(), ts.RandValAddress(), 0, "zero amount")
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid payload: unbond amount should be positive",
+		})
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx, _ := ts.GenerateTestPartialUnbondTx()
+		pld := trx.Payload().(*payload.PartialUnbondPayload)
+
+		assert.NoError(t, trx.BasicCheck())
+		assert.Nil(t, trx.Payload().Receiver())
+		assert.Zero(t, trx.Fee())
+		assert.Equal(t, pld.Amount, trx.Payload().Value())
+	})
+}
+
+func TestRotateKeyTx(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	newTrx := func(valAddr crypto.Address, newPub *bls.PublicKey, newPrv *bls.PrivateKey) *tx.Tx {
+		newKeySig := newPrv.SignNative(payload.RotateKeySignBytes(valAddr, newPub))
+
+		return tx.NewRotateKeyTx(ts.RandHeight(), valAddr, newPub, newKeySig, "rotate key")
+	}
+
+	t.Run("Invalid validator address", func(t *testing.T) {
+		valAddr := ts.RandAccAddress()
+		newPub, newPrv := ts.RandBLSKeyPair()
+		trx := newTrx(valAddr, newPub, newPrv)
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid payload: address is not a validator address",
+		})
+	})
+
+	t.Run("Same key", func(t *testing.T) {
+		newPub, newPrv := ts.RandBLSKeyPair()
+		trx := newTrx(newPub.ValidatorAddress(), newPub, newPrv)
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid payload: new key is the same as the current key",
+		})
+	})
+
+	t.Run("New key signature for another validator", func(t *testing.T) {
+		newPub, newPrv := ts.RandBLSKeyPair()
+		newKeySig := newPrv.SignNative(payload.RotateKeySignBytes(ts.RandValAddress(), newPub))
+		trx := tx.NewRotateKeyTx(ts.RandHeight(), ts.RandValAddress(), newPub, newKeySig, "invalid signature")
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid payload: invalid signature of the new key",
+		})
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx, _ := ts.GenerateTestRotateKeyTx()
+		pld := trx.Payload().(*payload.RotateKeyPayload)
+
+		assert.NoError(t, trx.BasicCheck())
+		assert.Equal(t, pld.NewPublicKey.ValidatorAddress(), *trx.Payload().Receiver())
+		assert.Zero(t, trx.Fee())
+		assert.Zero(t, trx.Payload().Value())
+	})
+}
+
+func TestMultisigTx(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pub, prvs := ts.RandMultisigKey(2, 3)
+	newTrx := func() *tx.Tx {
+		return tx.NewTransferTx(ts.RandHeight(), pub.AccountAddress(), ts.RandAccAddress(),
+			ts.RandAmount(), ts.RandAmount(), "multisig")
+	}
+
+	t.Run("Ok", func(t *testing.T) {
+		trx := newTrx()
+		ts.HelperSignTransactionMultisig(pub, prvs, []int{0, 2}, trx)
+		assert.NoError(t, trx.BasicCheck())
+
+		bs, err := trx.Bytes()
+		require.NoError(t, err)
+		assert.Len(t, bs, trx.SerializeSize())
+
+		decodedTrx, err := tx.FromBytes(bs)
+		require.NoError(t, err)
+		assert.NoError(t, decodedTrx.BasicCheck())
+		assert.True(t, pub.EqualsTo(decodedTrx.PublicKey()))
+		assert.True(t, trx.Signature().EqualsTo(decodedTrx.Signature()))
+		assert.Equal(t, trx.ID(), decodedTrx.ID())
+	})
+
+	t.Run("Stripped public key", func(t *testing.T) {
+		trx := newTrx()
+		ts.HelperSignTransactionMultisig(pub, prvs, []int{0, 1}, trx)
+		trx.StripPublicKey()
+
+		bs, _ := trx.Bytes()
+		assert.Len(t, bs, trx.SerializeSize())
+
+		decodedTrx, err := tx.FromBytes(bs)
+		require.NoError(t, err)
+		assert.True(t, decodedTrx.IsPublicKeyStriped())
+		assert.True(t, trx.Signature().EqualsTo(decodedTrx.Signature()))
+	})
+
+	t.Run("Not enough signers", func(t *testing.T) {
+		trx := newTrx()
+		sig, _ := bls.NewMultisigSignature([]int{1},
+			[]*bls.Signature{prvs[1].SignMultisig(trx.SignBytes())})
+		trx.SetSignature(sig)
+		trx.SetPublicKey(pub)
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid signature",
+		})
+	})
+
+	t.Run("Another multisig public key", func(t *testing.T) {
+		otherPub, otherPrvs := ts.RandMultisigKey(2, 3)
+		trx := newTrx()
+		sig, _ := bls.NewMultisigSignature([]int{0, 1}, []*bls.Signature{
+			otherPrvs[0].SignMultisig(trx.SignBytes()),
+			otherPrvs[1].SignMultisig(trx.SignBytes()),
+		})
+		trx.SetSignature(sig)
+		trx.SetPublicKey(otherPub)
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: crypto.AddressMismatchError{
+				Expected: otherPub.AccountAddress(),
+				Got:      pub.AccountAddress(),
+			}.Error(),
+		})
+	})
+
+	t.Run("BLS signature", func(t *testing.T) {
+		trx := newTrx()
+		trx.SetSignature(prvs[0].Sign(trx.SignBytes()))
+		trx.SetPublicKey(pub)
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid signature",
+		})
+	})
+}
@@ -3,6 +3,7 @@ package validator
 
 import (
 	"bytes"
+	"fmt"
 
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
@@ -11,6 +12,29 @@ import (
 	"github.com/pactus-project/pactus/util/encoding"
 )
 
+// MaxUnbondingEntries is the maximum number of partial unbondings
+// that a validator can have at the same time.
+const MaxUnbondingEntries = 8
+
+// MaxPreviousKeys is the maximum number of rotated keys
+// that a validator keeps at the same time.
+const MaxPreviousKeys = 4
+
+// UnbondingEntry is a part of the validator's stake that is unbonded,
+// and can be withdrawn once the unbonding interval has passed.
+type UnbondingEntry struct {
+	Amount amount.Amount
+	Height uint32
+}
+
+// PreviousKey is a public key of the validator that is rotated out.
+// The key is effective until the rotation height, and the new key is effective from this height.
+// The votes that are signed by the key before the rotation height remain valid evidence for slashing.
+type PreviousKey struct {
+	PublicKey *bls.PublicKey
+	Height    uint32
+}
+
 // The Validator struct represents a validator object.
 type Validator struct {
 	data validatorData
@@ -24,6 +48,11 @@ type validatorData struct {
 	LastBondingHeight   uint32
 	UnbondingHeight     uint32
 	LastSortitionHeight uint32
+	// UnbondingEntries are encoded only if there is any.
+	// Therefore, the encoding of the validators without partial unbonding remains unchanged.
+	UnbondingEntries []UnbondingEntry
+	// PreviousKeys are encoded only if there is any, after the unbonding entries.
+	PreviousKeys []PreviousKey
 }
 
 // NewValidator constructs a new validator from the given public key and number.
@@ -59,6 +88,47 @@ func FromBytes(data []byte) (*Validator, error) {
 		return nil, err
 	}
 
+	if r.Len() > 0 {
+		count, err := encoding.ReadVarInt(r)
+		if err != nil {
+			return nil, err
+		}
+		// The number of unbonding entries is zero only if the previous keys are encoded after.
+		if (count == 0 && r.Len() == 0) || count > MaxUnbondingEntries {
+			return nil, fmt.Errorf("invalid number of unbonding entries: %d", count)
+		}
+		if count > 0 {
+			acc.data.UnbondingEntries = make([]UnbondingEntry, count)
+		}
+		for i := range acc.data.UnbondingEntries {
+			entry := &acc.data.UnbondingEntries[i]
+			if err := encoding.ReadElements(r, &entry.Amount, &entry.Height); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if r.Len() > 0 {
+		count, err := encoding.ReadVarInt(r)
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 || count > MaxPreviousKeys {
+			return nil, fmt.Errorf("invalid number of previous keys: %d", count)
+		}
+		acc.data.PreviousKeys = make([]PreviousKey, count)
+		for i := range acc.data.PreviousKeys {
+			key := &acc.data.PreviousKeys[i]
+			key.PublicKey = new(bls.PublicKey)
+			if err := key.PublicKey.Decode(r); err != nil {
+				return nil, err
+			}
+			if err := encoding.ReadElement(r, &key.Height); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return acc, nil
 }
 
@@ -97,6 +167,40 @@ func (val *Validator) LastSortitionHeight() uint32 {
 	return val.data.LastSortitionHeight
 }
 
+// UnbondingEntries returns the partial unbondings of the validator, sorted by height.
+func (val *Validator) UnbondingEntries() []UnbondingEntry {
+	return val.data.UnbondingEntries
+}
+
+// UnbondingAmount returns the total amount of the partial unbondings.
+func (val *Validator) UnbondingAmount() amount.Amount {
+	total := amount.Amount(0)
+	for _, entry := range val.data.UnbondingEntries {
+		total += entry.Amount
+	}
+
+	return total
+}
+
+// MaturedUnbondingAmount returns the total amount of the partial unbondings
+// that have passed the unbonding interval at the given height.
+func (val *Validator) MaturedUnbondingAmount(height, unbondInterval uint32) amount.Amount {
+	total := amount.Amount(0)
+	for _, entry := range val.data.UnbondingEntries {
+		if height < entry.Height+unbondInterval {
+			break
+		}
+		total += entry.Amount
+	}
+
+	return total
+}
+
+// BondedStake returns the part of the stake that is not partially unbonded.
+func (val *Validator) BondedStake() amount.Amount {
+	return val.data.Stake - val.UnbondingAmount()
+}
+
 // Power returns the power of the validator.
 func (val Validator) Power() int64 {
 	if val.data.UnbondingHeight > 0 {
@@ -107,12 +211,103 @@ func (val Validator) Power() int64 {
 		return 1
 	}
 
-	return int64(val.data.Stake)
+	return int64(val.BondedStake())
 }
 
 // SubtractFromStake subtracts the given amount from the validator's stake.
+// The partial unbondings are consumed first, starting from the oldest one.
 func (val *Validator) SubtractFromStake(amt amount.Amount) {
 	val.data.Stake -= amt
+
+	remaining := amt
+	consumed := 0
+	for i := range val.data.UnbondingEntries {
+		entry := &val.data.UnbondingEntries[i]
+		if remaining < entry.Amount {
+			entry.Amount -= remaining
+
+			break
+		}
+		remaining -= entry.Amount
+		consumed++
+	}
+	val.data.UnbondingEntries = val.data.UnbondingEntries[consumed:]
+	if len(val.data.UnbondingEntries) == 0 {
+		val.data.UnbondingEntries = nil
+	}
+}
+
+// SlashStake subtracts the given amount from the validator's stake as a penalty.
+// Unlike SubtractFromStake, the amount is taken from the bonded stake first,
+// and the rest is taken from the most recent partial unbondings.
+func (val *Validator) SlashStake(amt amount.Amount) {
+	remaining := amt - min(amt, val.BondedStake())
+	val.data.Stake -= amt
+
+	for i := len(val.data.UnbondingEntries) - 1; i >= 0 && remaining > 0; i-- {
+		entry := &val.data.UnbondingEntries[i]
+		if remaining < entry.Amount {
+			entry.Amount -= remaining
+
+			break
+		}
+		remaining -= entry.Amount
+		val.data.UnbondingEntries = val.data.UnbondingEntries[:i]
+	}
+	if len(val.data.UnbondingEntries) == 0 {
+		val.data.UnbondingEntries = nil
+	}
+}
+
+// AddUnbondingEntry unbonds the given amount of the validator's stake at the given height.
+func (val *Validator) AddUnbondingEntry(amt amount.Amount, height uint32) {
+	val.data.UnbondingEntries = append(val.data.UnbondingEntries, UnbondingEntry{
+		Amount: amt,
+		Height: height,
+	})
+}
+
+// RotatePublicKey replaces the validator's public key with the given one at the given height.
+// The address of the validator changes to the address of the new key,
+// while its number, stake and bonding history are kept.
+// The current key is kept as a previous key, so the votes that are signed by it remain verifiable.
+func (val *Validator) RotatePublicKey(publicKey *bls.PublicKey, height uint32) {
+	val.data.PreviousKeys = append(val.data.PreviousKeys, PreviousKey{
+		PublicKey: val.data.PublicKey,
+		Height:    height,
+	})
+	val.data.PublicKey = publicKey
+}
+
+// PreviousKeys returns the keys that the validator has rotated out, starting from the oldest one.
+func (val *Validator) PreviousKeys() []PreviousKey {
+	return val.data.PreviousKeys
+}
+
+// PreviousKey returns the previous key of the validator that has the given address.
+func (val *Validator) PreviousKey(addr crypto.Address) (PreviousKey, bool) {
+	for _, key := range val.data.PreviousKeys {
+		if key.PublicKey.ValidatorAddress() == addr {
+			return key, true
+		}
+	}
+
+	return PreviousKey{}, false
+}
+
+// RemovePreviousKeys removes the previous keys that are rotated out before the given height.
+func (val *Validator) RemovePreviousKeys(height uint32) {
+	removed := 0
+	for _, key := range val.data.PreviousKeys {
+		if key.Height >= height {
+			break
+		}
+		removed++
+	}
+	val.data.PreviousKeys = val.data.PreviousKeys[removed:]
+	if len(val.data.PreviousKeys) == 0 {
+		val.data.PreviousKeys = nil
+	}
 }
 
 // AddToStake adds the given amount to the validator's stake.
@@ -147,7 +342,15 @@ func (val *Validator) Hash() hash.Hash {
 
 // SerializeSize returns the size in bytes required to serialize the validator.
 func (val *Validator) SerializeSize() int {
-	return 120 // 96+4+4+8+4+4
+	size := 120 // 96+4+4+8+4+4
+	if count := len(val.data.UnbondingEntries); count > 0 || len(val.data.PreviousKeys) > 0 {
+		size += encoding.VarIntSerializeSize(uint64(count)) + count*12 // 8+4
+	}
+	if count := len(val.data.PreviousKeys); count > 0 {
+		size += encoding.VarIntSerializeSize(uint64(count)) + count*100 // 96+4
+	}
+
+	return size
 }
 
 // Bytes returns the serialized byte representation of the validator.
@@ -168,6 +371,31 @@ func (val *Validator) Bytes() ([]byte, error) {
 		return nil, err
 	}
 
+	if count := len(val.data.UnbondingEntries); count > 0 || len(val.data.PreviousKeys) > 0 {
+		if err := encoding.WriteVarInt(w, uint64(count)); err != nil {
+			return nil, err
+		}
+		for _, entry := range val.data.UnbondingEntries {
+			if err := encoding.WriteElements(w, entry.Amount, entry.Height); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if count := len(val.data.PreviousKeys); count > 0 {
+		if err := encoding.WriteVarInt(w, uint64(count)); err != nil {
+			return nil, err
+		}
+		for _, key := range val.data.PreviousKeys {
+			if err := key.PublicKey.Encode(w); err != nil {
+				return nil, err
+			}
+			if err := encoding.WriteElement(w, key.Height); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return w.Bytes(), nil
 }
 
@@ -175,6 +403,14 @@ func (val *Validator) Bytes() ([]byte, error) {
 func (val *Validator) Clone() *Validator {
 	cloned := new(Validator)
 	*cloned = *val
+	if val.data.UnbondingEntries != nil {
+		cloned.data.UnbondingEntries = make([]UnbondingEntry, len(val.data.UnbondingEntries))
+		copy(cloned.data.UnbondingEntries, val.data.UnbondingEntries)
+	}
+	if val.data.PreviousKeys != nil {
+		cloned.data.PreviousKeys = make([]PreviousKey, len(val.data.PreviousKeys))
+		copy(cloned.data.PreviousKeys, val.data.PreviousKeys)
+	}
 
 	return cloned
 }
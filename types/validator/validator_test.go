@@ -100,6 +100,49 @@ func TestSubtractFromStake(t *testing.T) {
 	assert.Equal(t, val.Stake(), stake-1)
 }
 
+func TestRotatePublicKey(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	val, _ := ts.GenerateTestValidator(100)
+	val.AddUnbondingEntry(1, 1000)
+	expected := val.Clone()
+	newPub, _ := ts.RandBLSKeyPair()
+	val.RotatePublicKey(newPub, 2000)
+
+	assert.Equal(t, newPub, val.PublicKey())
+	assert.Equal(t, newPub.ValidatorAddress(), val.Address())
+	assert.Equal(t, expected.Number(), val.Number())
+	assert.Equal(t, expected.Stake(), val.Stake())
+	assert.Equal(t, expected.LastBondingHeight(), val.LastBondingHeight())
+	assert.Equal(t, expected.LastSortitionHeight(), val.LastSortitionHeight())
+	assert.Equal(t, expected.UnbondingEntries(), val.UnbondingEntries())
+	assert.Equal(t, expected.Power(), val.Power())
+	assert.Equal(t, []validator.PreviousKey{{PublicKey: expected.PublicKey(), Height: 2000}}, val.PreviousKeys())
+
+	prevKey, ok := val.PreviousKey(expected.Address())
+	assert.True(t, ok)
+	assert.Equal(t, expected.PublicKey(), prevKey.PublicKey)
+	_, ok = val.PreviousKey(val.Address())
+	assert.False(t, ok)
+
+	cloned := val.Clone()
+	cloned.RotatePublicKey(ts.RandValKey().PublicKey(), 3000)
+	assert.Len(t, val.PreviousKeys(), 1)
+	assert.Len(t, cloned.PreviousKeys(), 2)
+
+	d, _ := cloned.Bytes()
+	decoded, err := validator.FromBytes(d)
+	require.NoError(t, err)
+	assert.Equal(t, cloned.Hash(), decoded.Hash())
+	assert.Equal(t, cloned.SerializeSize(), len(d))
+
+	cloned.RemovePreviousKeys(3000)
+	assert.Equal(t, []validator.PreviousKey{{PublicKey: val.PublicKey(), Height: 3000}}, cloned.PreviousKeys())
+	cloned.RemovePreviousKeys(3001)
+	assert.Nil(t, cloned.PreviousKeys())
+	assert.Equal(t, expected.SerializeSize(), cloned.SerializeSize())
+}
+
 func TestClone(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
@@ -109,3 +152,135 @@ func TestClone(t *testing.T) {
 
 	assert.NotEqual(t, val.Stake(), cloned.Stake())
 }
+
+func TestUnbondingEntriesEncoding(t *testing.T) {
+	d, _ := hex.DecodeString(
+		"8d82fa4fcac04a3b565267685e90db1b01420285d2f8295683c138c092c209479983ba1591370778846681b7b558e061" + // PublicKey
+			"1776208c0718006311c84b4a113335c70d1f5c7c5dd93a5625c4af51c48847abd0b590c055306162d2a03ca1cbf7bcc1" +
+			"01000000" + // Number
+			"0a00000000000000" + // Stake
+			"03000000" + // LastBondingHeight
+			"00000000" + // UnbondingHeight
+			"05000000" + // LastSortitionHeight
+			"02" + // Number of unbonding entries
+			"0200000000000000" + "06000000" + // First entry
+			"0300000000000000" + "07000000") // Second entry
+
+	val, err := validator.FromBytes(d)
+	require.NoError(t, err)
+	assert.Equal(t, []validator.UnbondingEntry{
+		{Amount: 2, Height: 6},
+		{Amount: 3, Height: 7},
+	}, val.UnbondingEntries())
+	d2, _ := val.Bytes()
+	assert.Equal(t, d, d2)
+	assert.Equal(t, val.SerializeSize(), len(d))
+
+	_, err = validator.FromBytes(d[:len(d)-1])
+	assert.Error(t, err)
+}
+
+func TestPreviousKeysEncoding(t *testing.T) {
+	d, _ := hex.DecodeString(
+		"8d82fa4fcac04a3b565267685e90db1b01420285d2f8295683c138c092c209479983ba1591370778846681b7b558e061" + // PublicKey
+			"1776208c0718006311c84b4a113335c70d1f5c7c5dd93a5625c4af51c48847abd0b590c055306162d2a03ca1cbf7bcc1" +
+			"01000000" + // Number
+			"0a00000000000000" + // Stake
+			"03000000" + // LastBondingHeight
+			"00000000" + // UnbondingHeight
+			"05000000" + // LastSortitionHeight
+			"00" + // Number of unbonding entries
+			"01" + // Number of previous keys
+			"8d82fa4fcac04a3b565267685e90db1b01420285d2f8295683c138c092c209479983ba1591370778846681b7b558e061" + // PublicKey
+			"1776208c0718006311c84b4a113335c70d1f5c7c5dd93a5625c4af51c48847abd0b590c055306162d2a03ca1cbf7bcc1" +
+			"08000000") // Height
+
+	val, err := validator.FromBytes(d)
+	require.NoError(t, err)
+	assert.Nil(t, val.UnbondingEntries())
+	require.Len(t, val.PreviousKeys(), 1)
+	assert.Equal(t, uint32(8), val.PreviousKeys()[0].Height)
+	d2, _ := val.Bytes()
+	assert.Equal(t, d, d2)
+	assert.Equal(t, val.SerializeSize(), len(d))
+
+	// Zero unbonding entries without the previous keys is not canonical.
+	_, err = validator.FromBytes(d[:121])
+	assert.Error(t, err)
+
+	_, err = validator.FromBytes(d[:len(d)-1])
+	assert.Error(t, err)
+}
+
+func TestUnbondingEntries(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	val, _ := ts.GenerateTestValidator(100)
+	val.SubtractFromStake(val.Stake())
+	val.AddToStake(100)
+
+	val.AddUnbondingEntry(10, 1000)
+	val.AddUnbondingEntry(20, 2000)
+	assert.Equal(t, amount.Amount(100), val.Stake())
+	assert.Equal(t, amount.Amount(30), val.UnbondingAmount())
+	assert.Equal(t, amount.Amount(70), val.BondedStake())
+	assert.Equal(t, int64(70), val.Power())
+
+	assert.Zero(t, val.MaturedUnbondingAmount(1099, 100))
+	assert.Equal(t, amount.Amount(10), val.MaturedUnbondingAmount(1100, 100))
+	assert.Equal(t, amount.Amount(30), val.MaturedUnbondingAmount(2100, 100))
+
+	// Subtracting consumes the oldest entries first.
+	val.SubtractFromStake(15)
+	assert.Equal(t, amount.Amount(85), val.Stake())
+	assert.Equal(t, []validator.UnbondingEntry{{Amount: 15, Height: 2000}}, val.UnbondingEntries())
+	assert.Equal(t, int64(70), val.Power())
+
+	val.SubtractFromStake(20)
+	assert.Empty(t, val.UnbondingEntries())
+	assert.Equal(t, int64(65), val.Power())
+}
+
+func TestSlashStake(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	val, _ := ts.GenerateTestValidator(100)
+	val.SubtractFromStake(val.Stake())
+	val.AddToStake(100)
+
+	val.AddUnbondingEntry(10, 1000)
+	val.AddUnbondingEntry(20, 2000)
+
+	// Slashing takes the bonded stake first.
+	val.SlashStake(60)
+	assert.Equal(t, amount.Amount(40), val.Stake())
+	assert.Equal(t, amount.Amount(10), val.BondedStake())
+	assert.Equal(t, []validator.UnbondingEntry{
+		{Amount: 10, Height: 1000},
+		{Amount: 20, Height: 2000},
+	}, val.UnbondingEntries())
+
+	// The rest is taken from the most recent entries.
+	val.SlashStake(25)
+	assert.Equal(t, amount.Amount(15), val.Stake())
+	assert.Equal(t, []validator.UnbondingEntry{
+		{Amount: 10, Height: 1000},
+		{Amount: 5, Height: 2000},
+	}, val.UnbondingEntries())
+
+	val.SlashStake(15)
+	assert.Zero(t, val.Stake())
+	assert.Empty(t, val.UnbondingEntries())
+}
+
+func TestCloneUnbondingEntries(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	val, _ := ts.GenerateTestValidator(100)
+	val.AddUnbondingEntry(1, 1000)
+	cloned := val.Clone()
+	cloned.AddUnbondingEntry(1, 2000)
+	cloned.SubtractFromStake(1)
+
+	assert.Equal(t, []validator.UnbondingEntry{{Amount: 1, Height: 1000}}, val.UnbondingEntries())
+}
@@ -0,0 +1,62 @@
+package persistentmerkle
+
+import (
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util/simplemerkle"
+)
+
+// Proof is a merkle inclusion proof for a leaf.
+// Siblings are ordered from the leaf to the root, and the bits of the index,
+// from the lowest one, determine whether the node is the left (0) or the right (1) child.
+type Proof struct {
+	Index    uint64
+	Siblings []hash.Hash
+}
+
+// Proof returns the inclusion proof of the given leaf, or nil if the leaf doesn't exist.
+func (t *Tree) Proof(leaf int) *Proof {
+	if leaf < 0 || leaf >= t.maxWidth {
+		return nil
+	}
+
+	// Calculating the root ensures all the nodes have their hashes.
+	_ = t.Root()
+
+	siblings := make([]hash.Hash, 0, t.maxHeight-1)
+	w := leaf
+	for h := 0; h < t.maxHeight-1; h++ {
+		// A missing right sibling is the duplicate of its left sibling.
+		siblings = append(siblings, t.nodeHash(w^1, h))
+		w /= 2
+	}
+
+	return &Proof{
+		Index:    uint64(leaf),
+		Siblings: siblings,
+	}
+}
+
+// Root calculates the root of the tree from the given leaf hash and the proof.
+func (p *Proof) Root(leafHash hash.Hash) hash.Hash {
+	h := leafHash
+	index := p.Index
+	for i := range p.Siblings {
+		if index&1 == 0 {
+			h = *simplemerkle.HashMerkleBranches(&h, &p.Siblings[i])
+		} else {
+			h = *simplemerkle.HashMerkleBranches(&p.Siblings[i], &h)
+		}
+		index >>= 1
+	}
+
+	return h
+}
+
+// VerifyProof checks that the leaf hash is included in a tree with the given root.
+func VerifyProof(root, leafHash hash.Hash, proof *Proof) bool {
+	if proof == nil {
+		return false
+	}
+
+	return proof.Root(leafHash) == root
+}
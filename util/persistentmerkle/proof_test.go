@@ -0,0 +1,47 @@
+package persistentmerkle
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProof(t *testing.T) {
+	tree := New()
+
+	assert.Nil(t, tree.Proof(0))
+
+	for i := 0; i < 26; i++ {
+		tree.SetData(i, []byte{byte('A' + i)})
+		root := tree.Root()
+
+		for leaf := 0; leaf <= i; leaf++ {
+			proof := tree.Proof(leaf)
+			leafHash := hash.CalcHash([]byte{byte('A' + leaf)})
+			assert.True(t, VerifyProof(root, leafHash, proof), "leaf %d of %d", leaf, i+1)
+			assert.False(t, VerifyProof(root, hash.CalcHash([]byte("invalid")), proof))
+		}
+		assert.Nil(t, tree.Proof(i+1))
+		assert.Nil(t, tree.Proof(-1))
+	}
+
+	t.Run("Modified leaf", func(t *testing.T) {
+		tree.SetData(21, []byte("v"))
+		root := tree.Root()
+
+		proof := tree.Proof(21)
+		assert.True(t, VerifyProof(root, hash.CalcHash([]byte("v")), proof))
+		assert.False(t, VerifyProof(root, hash.CalcHash([]byte("V")), proof))
+	})
+
+	t.Run("Wrong index", func(t *testing.T) {
+		proof := tree.Proof(3)
+		proof.Index = 2
+		assert.False(t, VerifyProof(tree.Root(), hash.CalcHash([]byte("D")), proof))
+	})
+
+	t.Run("Nil proof", func(t *testing.T) {
+		assert.False(t, VerifyProof(tree.Root(), hash.CalcHash([]byte("A")), nil))
+	})
+}
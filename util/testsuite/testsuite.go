@@ -16,8 +16,10 @@ import (
 	"github.com/pactus-project/pactus/types/amount"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/evidence"
 	"github.com/pactus-project/pactus/types/proposal"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
 	"github.com/pactus-project/pactus/util"
@@ -200,6 +202,22 @@ func (ts *TestSuite) RandBLSKeyPair() (*bls.PublicKey, *bls.PrivateKey) {
 	return pub, prv
 }
 
+// RandMultisigKey generates a random m-of-n multisig public key for testing purposes.
+// It returns the private keys of the signers, in the same order as the public keys.
+func (ts *TestSuite) RandMultisigKey(threshold, n int) (*bls.MultisigPublicKey, []*bls.PrivateKey) {
+	pubs := make([]*bls.PublicKey, n)
+	prvs := make([]*bls.PrivateKey, n)
+	for i := 0; i < n; i++ {
+		pubs[i], prvs[i] = ts.RandBLSKeyPair()
+	}
+	pub, err := bls.NewMultisigPublicKey(threshold, pubs)
+	if err != nil {
+		panic(err)
+	}
+
+	return pub, prvs
+}
+
 // RandValKey generates a random validator key for testing purposes.
 func (ts *TestSuite) RandValKey() *bls.ValidatorKey {
 	_, prv := ts.RandBLSKeyPair()
@@ -378,6 +396,35 @@ func (ts *TestSuite) GenerateTestTransferTx() (*tx.Tx, *bls.PrivateKey) {
 	return trx, prv
 }
 
+// GenerateTestBatchTransferTx generates a batch transfer transaction for testing purposes.
+func (ts *TestSuite) GenerateTestBatchTransferTx() (*tx.Tx, *bls.PrivateKey) {
+	pub, prv := ts.RandBLSKeyPair()
+	recipients := make([]payload.BatchTransferRecipient, ts.RandInt(3)+2)
+	for i := range recipients {
+		recipients[i] = payload.BatchTransferRecipient{
+			To:     ts.RandAccAddress(),
+			Amount: amount.Amount(ts.RandInt64NonZero(1000e9)),
+		}
+	}
+	trx := tx.NewBatchTransferTx(ts.RandHeight(), pub.AccountAddress(), recipients,
+		ts.RandAmount(), "test batch-tx")
+	ts.HelperSignTransaction(prv, trx)
+
+	return trx, prv
+}
+
+// GenerateTestVestingTransferTx generates a vesting transfer transaction for testing purposes.
+func (ts *TestSuite) GenerateTestVestingTransferTx() (*tx.Tx, *bls.PrivateKey) {
+	pub, prv := ts.RandBLSKeyPair()
+	startHeight := ts.RandHeight()
+	trx := tx.NewVestingTransferTx(ts.RandHeight(), pub.AccountAddress(), ts.RandAccAddress(),
+		amount.Amount(ts.RandInt64NonZero(1000e9)), startHeight, startHeight+ts.RandUint32(1000),
+		ts.RandAmount(), "test vesting-tx")
+	ts.HelperSignTransaction(prv, trx)
+
+	return trx, prv
+}
+
 // GenerateTestBondTx generates a bond transaction for testing purposes.
 func (ts *TestSuite) GenerateTestBondTx() (*tx.Tx, *bls.PrivateKey) {
 	pub, prv := ts.RandBLSKeyPair()
@@ -398,6 +445,16 @@ func (ts *TestSuite) GenerateTestSortitionTx() (*tx.Tx, *bls.PrivateKey) {
 	return trx, prv
 }
 
+// GenerateTestSlashTx generates a slash transaction for testing purposes.
+func (ts *TestSuite) GenerateTestSlashTx() (*tx.Tx, *bls.PrivateKey) {
+	pub, prv := ts.RandBLSKeyPair()
+	ev, _ := ts.GenerateTestDoubleSign(ts.RandHeight(), ts.RandRound())
+	trx := tx.NewSlashTx(ts.RandHeight(), pub.AccountAddress(), ev.Vote1(), ev.Vote2(), "test slash-tx")
+	ts.HelperSignTransaction(prv, trx)
+
+	return trx, prv
+}
+
 // GenerateTestUnbondTx generates an unbond transaction for testing purposes.
 func (ts *TestSuite) GenerateTestUnbondTx() (*tx.Tx, *bls.PrivateKey) {
 	pub, prv := ts.RandBLSKeyPair()
@@ -407,6 +464,27 @@ func (ts *TestSuite) GenerateTestUnbondTx() (*tx.Tx, *bls.PrivateKey) {
 	return trx, prv
 }
 
+// GenerateTestPartialUnbondTx generates a partial unbond transaction for testing purposes.
+func (ts *TestSuite) GenerateTestPartialUnbondTx() (*tx.Tx, *bls.PrivateKey) {
+	pub, prv := ts.RandBLSKeyPair()
+	trx := tx.NewPartialUnbondTx(ts.RandHeight(), pub.ValidatorAddress(),
+		amount.Amount(ts.RandInt64NonZero(1000e9)), "test partial-unbond-tx")
+	ts.HelperSignTransaction(prv, trx)
+
+	return trx, prv
+}
+
+// GenerateTestRotateKeyTx generates a rotate key transaction for testing purposes.
+func (ts *TestSuite) GenerateTestRotateKeyTx() (*tx.Tx, *bls.PrivateKey) {
+	pub, prv := ts.RandBLSKeyPair()
+	newPub, newPrv := ts.RandBLSKeyPair()
+	newKeySig := newPrv.SignNative(payload.RotateKeySignBytes(pub.ValidatorAddress(), newPub))
+	trx := tx.NewRotateKeyTx(ts.RandHeight(), pub.ValidatorAddress(), newPub, newKeySig, "test rotate-key-tx")
+	ts.HelperSignTransaction(prv, trx)
+
+	return trx, prv
+}
+
 // GenerateTestWithdrawTx generates a withdraw transaction for testing purposes.
 func (ts *TestSuite) GenerateTestWithdrawTx() (*tx.Tx, *bls.PrivateKey) {
 	pub, prv := ts.RandBLSKeyPair()
@@ -441,6 +519,16 @@ func (ts *TestSuite) GenerateTestPrepareVote(height uint32, round int16) (*vote.
 	return v, valKey
 }
 
+// GenerateTestDoubleSign generates a double-sign evidence for testing purposes.
+// The signer signs two precommit votes for different block hashes.
+func (ts *TestSuite) GenerateTestDoubleSign(height uint32, round int16) (*evidence.DoubleSign, *bls.ValidatorKey) {
+	vote1, valKey := ts.GenerateTestPrecommitVote(height, round)
+	vote2 := vote.NewPrecommitVote(ts.RandHash(), height, round, valKey.Address())
+	ts.HelperSignVote(valKey, vote2)
+
+	return evidence.NewDoubleSign(vote1, vote2), valKey
+}
+
 // GenerateTestCommittee generates a committee for testing purposes.
 // All committee members have the same power.
 func (ts *TestSuite) GenerateTestCommittee(num int) (committee.Committee, []*bls.ValidatorKey) {
@@ -480,6 +568,27 @@ func (ts *TestSuite) HelperSignProposal(valKey *bls.ValidatorKey, p *proposal.Pr
 	}
 }
 
+// HelperSignTransactionMultisig signs the transaction by the given signers of a multisig account.
+// The signers are the indexes of the private keys of the signing keys.
+func (ts *TestSuite) HelperSignTransactionMultisig(pub *bls.MultisigPublicKey,
+	prvs []*bls.PrivateKey, signers []int, trx *tx.Tx,
+) {
+	sigs := make([]*bls.Signature, 0, len(signers))
+	for _, i := range signers {
+		sigs = append(sigs, prvs[i].SignMultisig(trx.SignBytes()))
+	}
+	sig, err := bls.NewMultisigSignature(signers, sigs)
+	if err != nil {
+		panic(err)
+	}
+	trx.SetSignature(sig)
+	trx.SetPublicKey(pub)
+
+	if err := trx.BasicCheck(); err != nil {
+		panic(err)
+	}
+}
+
 func (ts *TestSuite) HelperSignTransaction(prv crypto.PrivateKey, trx *tx.Tx) {
 	sig := prv.Sign(trx.SignBytes())
 	trx.SetSignature(sig)
@@ -103,6 +103,36 @@ func (s *mockService) GetPublicKey(_ context.Context,
 	return &pactus.GetPublicKeyResponse{}, nil
 }
 
+func (s *mockService) GetValidatorAvailability(_ context.Context,
+	_ *pactus.GetValidatorAvailabilityRequest,
+) (*pactus.GetValidatorAvailabilityResponse, error) {
+	return &pactus.GetValidatorAvailabilityResponse{}, nil
+}
+
+func (s *mockService) GetDoubleSignEvidences(_ context.Context,
+	_ *pactus.GetDoubleSignEvidencesRequest,
+) (*pactus.GetDoubleSignEvidencesResponse, error) {
+	return &pactus.GetDoubleSignEvidencesResponse{}, nil
+}
+
+func (s *mockService) GetSupplyInfo(_ context.Context,
+	_ *pactus.GetSupplyInfoRequest,
+) (*pactus.GetSupplyInfoResponse, error) {
+	return &pactus.GetSupplyInfoResponse{}, nil
+}
+
+func (s *mockService) GetValidatorRewards(_ context.Context,
+	_ *pactus.GetValidatorRewardsRequest,
+) (*pactus.GetValidatorRewardsResponse, error) {
+	return &pactus.GetValidatorRewardsResponse{}, nil
+}
+
+func (s *mockService) GetAccountTransactions(_ context.Context,
+	_ *pactus.GetAccountTransactionsRequest,
+) (*pactus.GetAccountTransactionsResponse, error) {
+	return &pactus.GetAccountTransactionsResponse{}, nil
+}
+
 func (s *mockService) GetTransaction(_ context.Context,
 	_ *pactus.GetTransactionRequest,
 ) (*pactus.GetTransactionResponse, error) {
@@ -125,6 +155,12 @@ func (s *mockService) BroadcastTransaction(_ context.Context,
 	}, nil
 }
 
+func (s *mockService) SimulateTransaction(_ context.Context,
+	_ *pactus.SimulateTransactionRequest,
+) (*pactus.SimulateTransactionResponse, error) {
+	return &pactus.SimulateTransactionResponse{}, nil
+}
+
 func (s *mockService) GetRawBondTransaction(_ context.Context,
 	_ *pactus.GetRawBondTransactionRequest,
 ) (*pactus.GetRawTransactionResponse, error) {
@@ -156,3 +192,19 @@ func (s *mockService) GetRawWithdrawTransaction(_ context.Context,
 		RawTransaction: make([]byte, 0),
 	}, nil
 }
+
+func (s *mockService) GetRawBatchTransferTransaction(_ context.Context,
+	_ *pactus.GetRawBatchTransferTransactionRequest,
+) (*pactus.GetRawTransactionResponse, error) {
+	return &pactus.GetRawTransactionResponse{
+		RawTransaction: make([]byte, 0),
+	}, nil
+}
+
+func (s *mockService) GetRawVestingTransferTransaction(_ context.Context,
+	_ *pactus.GetRawVestingTransferTransactionRequest,
+) (*pactus.GetRawTransactionResponse, error) {
+	return &pactus.GetRawTransactionResponse{
+		RawTransaction: make([]byte, 0),
+	}, nil
+}
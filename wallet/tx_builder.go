@@ -109,11 +109,26 @@ func (m *txBuilder) build() (*tx.Tx, error) {
 	case payload.TypeUnbond:
 		trx = tx.NewUnbondTx(m.lockTime, *m.from, m.memo)
 
+	case payload.TypePartialUnbond:
+		trx = tx.NewPartialUnbondTx(m.lockTime, *m.from, m.amount, m.memo)
+
 	case payload.TypeWithdraw:
 		trx = tx.NewWithdrawTx(m.lockTime, *m.from, *m.to, m.amount, m.fee, m.memo)
 
 	case payload.TypeSortition:
 		return nil, fmt.Errorf("unable to build sortition transactions")
+
+	case payload.TypeSlash:
+		return nil, fmt.Errorf("unable to build slash transactions")
+
+	case payload.TypeBatchTransfer:
+		return nil, fmt.Errorf("unable to build batch transfer transactions")
+
+	case payload.TypeVestingTransfer:
+		return nil, fmt.Errorf("unable to build vesting transfer transactions")
+
+	case payload.TypeRotateKey:
+		return nil, fmt.Errorf("unable to build rotate key transactions")
 	}
 
 	return trx, nil
@@ -286,6 +286,24 @@ func (w *Wallet) MakeUnbondTx(addr string, opts ...TxOption) (*tx.Tx, error) {
 	return maker.build()
 }
 
+// MakePartialUnbondTx creates a new partial unbond transaction based on the given parameters.
+// It unbonds the given amount of the validator's stake, and the validator
+// keeps validating with the remaining stake.
+func (w *Wallet) MakePartialUnbondTx(addr string, amt amount.Amount, opts ...TxOption) (*tx.Tx, error) {
+	maker, err := newTxBuilder(w.lazyClient, opts...)
+	if err != nil {
+		return nil, err
+	}
+	err = maker.setFromAddr(addr)
+	if err != nil {
+		return nil, err
+	}
+	maker.amount = amt
+	maker.typ = payload.TypePartialUnbond
+
+	return maker.build()
+}
+
 // MakeWithdrawTx creates a new withdraw transaction based on the given
 // parameters.
 func (w *Wallet) MakeWithdrawTx(sender, receiver string, amt amount.Amount,
@@ -608,3 +608,28 @@ func TestTotalBalance(t *testing.T) {
 	totalBalance := td.wallet.TotalBalance()
 	assert.Equal(t, totalBalance, acc1.Balance()+acc3.Balance())
 }
+
+func TestMakePartialUnbondTx(t *testing.T) {
+	td := setup(t)
+	defer td.Close()
+
+	senderInfo, _ := td.wallet.NewValidatorAddress("testing addr")
+	amt := td.RandAmount() + 1
+
+	t.Run("query parameters from the node", func(t *testing.T) {
+		testHeight := td.RandHeight()
+		_ = td.mockService.mockState.TestStore.AddTestBlock(testHeight)
+
+		trx, err := td.wallet.MakePartialUnbondTx(senderInfo.Address, amt)
+		assert.NoError(t, err)
+		assert.True(t, trx.IsPartialUnbondTx())
+		assert.Equal(t, trx.LockTime(), testHeight+1)
+		assert.Equal(t, amt, trx.Payload().Value())
+		assert.Zero(t, trx.Fee()) // Fee for unbond transaction is zero
+	})
+
+	t.Run("invalid sender address", func(t *testing.T) {
+		_, err := td.wallet.MakePartialUnbondTx("invalid_addr_string", amt)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidAddress)
+	})
+}
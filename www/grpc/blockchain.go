@@ -2,9 +2,12 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
@@ -13,6 +16,13 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	defaultTransactionsLimit = 10
+	maxTransactionsLimit     = 100
+	defaultRewardsLimit      = 10
+	maxRewardsLimit          = 100
+)
+
 type blockchainServer struct {
 	*Server
 }
@@ -26,20 +36,24 @@ func newBlockchainServer(server *Server) *blockchainServer {
 func (s *blockchainServer) GetBlockchainInfo(_ context.Context,
 	_ *pactus.GetBlockchainInfoRequest,
 ) (*pactus.GetBlockchainInfoResponse, error) {
-	vals := s.state.CommitteeValidators()
+	snap := s.state.ReadSnapshot()
+	defer snap.Release()
+
+	vals := snap.CommitteeValidators()
 	cv := make([]*pactus.ValidatorInfo, 0, len(vals))
 	for _, v := range vals {
-		cv = append(cv, s.validatorToProto(v))
+		cv = append(cv, validatorToProto(snap, v))
 	}
 
 	return &pactus.GetBlockchainInfoResponse{
-		LastBlockHeight:     s.state.LastBlockHeight(),
-		LastBlockHash:       s.state.LastBlockHash().Bytes(),
-		TotalAccounts:       s.state.TotalAccounts(),
-		TotalValidators:     s.state.TotalValidators(),
-		TotalPower:          s.state.TotalPower(),
-		CommitteePower:      s.state.CommitteePower(),
+		LastBlockHeight:     snap.LastBlockHeight(),
+		LastBlockHash:       snap.LastBlockHash().Bytes(),
+		TotalAccounts:       snap.TotalAccounts(),
+		TotalValidators:     snap.TotalValidators(),
+		TotalPower:          snap.TotalPower(),
+		CommitteePower:      snap.CommitteePower(),
 		CommitteeValidators: cv,
+		Halted:              s.state.IsHalted(),
 	}, nil
 }
 
@@ -68,11 +82,58 @@ func (s *blockchainServer) GetConsensusInfo(_ context.Context,
 	return &pactus.GetConsensusInfoResponse{Instances: instances}, nil
 }
 
+func (s *blockchainServer) GetDoubleSignEvidences(_ context.Context,
+	_ *pactus.GetDoubleSignEvidencesRequest,
+) (*pactus.GetDoubleSignEvidencesResponse, error) {
+	evs := s.consMgr.DoubleSigns()
+	evidences := make([]*pactus.DoubleSignEvidence, 0, len(evs))
+	for _, ev := range evs {
+		data, err := ev.Bytes()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		evidences = append(evidences,
+			&pactus.DoubleSignEvidence{
+				Hash:   ev.Hash().Bytes(),
+				Signer: ev.Signer().String(),
+				Height: ev.Height(),
+				Vote1:  s.voteToProto(ev.Vote1()),
+				Vote2:  s.voteToProto(ev.Vote2()),
+				Data:   data,
+			})
+	}
+
+	return &pactus.GetDoubleSignEvidencesResponse{Evidences: evidences}, nil
+}
+
+func (s *blockchainServer) GetSupplyInfo(_ context.Context,
+	_ *pactus.GetSupplyInfoRequest,
+) (*pactus.GetSupplyInfoResponse, error) {
+	info := s.state.SupplyInfo()
+
+	return &pactus.GetSupplyInfoResponse{
+		Height:            info.Height,
+		TotalSupply:       info.TotalSupply.ToNanoPAC(),
+		CirculatingSupply: info.CirculatingSupply.ToNanoPAC(),
+		TreasuryBalance:   info.TreasuryBalance.ToNanoPAC(),
+		TotalStaked:       info.TotalStaked.ToNanoPAC(),
+		UnbondingAmount:   info.UnbondingAmount.ToNanoPAC(),
+		TotalFees:         info.TotalFees.ToNanoPAC(),
+		TotalRewards:      info.TotalRewards.ToNanoPAC(),
+		CountedFromHeight: info.CountedFromHeight,
+		CountersComplete:  info.CountersComplete,
+	}, nil
+}
+
 func (s *blockchainServer) GetBlockHash(_ context.Context,
 	req *pactus.GetBlockHashRequest,
 ) (*pactus.GetBlockHashResponse, error) {
+	snap := s.state.ReadSnapshot()
+	defer snap.Release()
+
 	height := req.GetHeight()
-	h := s.state.BlockHash(height)
+	h := snap.BlockHash(height)
 	if h.IsUndef() {
 		return nil, status.Errorf(codes.NotFound, "block not found with this height")
 	}
@@ -89,7 +150,10 @@ func (s *blockchainServer) GetBlockHeight(_ context.Context,
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid hash: %v", err)
 	}
-	height := s.state.BlockHeight(h)
+	snap := s.state.ReadSnapshot()
+	defer snap.Release()
+
+	height := snap.BlockHeight(h)
 	if height == 0 {
 		return nil, status.Errorf(codes.NotFound, "block not found with this hash")
 	}
@@ -102,8 +166,11 @@ func (s *blockchainServer) GetBlockHeight(_ context.Context,
 func (s *blockchainServer) GetBlock(_ context.Context,
 	req *pactus.GetBlockRequest,
 ) (*pactus.GetBlockResponse, error) {
+	snap := s.state.ReadSnapshot()
+	defer snap.Release()
+
 	height := req.GetHeight()
-	committedBlock := s.state.CommittedBlock(height)
+	committedBlock := snap.CommittedBlock(height)
 	if committedBlock == nil {
 		return nil, status.Errorf(codes.NotFound, "block not found")
 	}
@@ -181,12 +248,41 @@ func (s *blockchainServer) GetAccount(_ context.Context,
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
 	}
-	acc := s.state.AccountByAddress(addr)
+
+	if req.WithProof {
+		if req.Height != 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "proofs are only available for the latest state")
+		}
+
+		acc, proof, err := s.state.AccountProof(addr)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+
+		return &pactus.GetAccountResponse{
+			Account: s.accountToProto(addr, acc, s.state.LastBlockHeight()),
+			Proof:   stateProofToProto(proof),
+		}, nil
+	}
+
+	var acc *account.Account
+	height := req.Height
+	if height == 0 {
+		snap := s.state.ReadSnapshot()
+		acc = snap.AccountByAddress(addr)
+		height = snap.LastBlockHeight()
+		snap.Release()
+	} else {
+		acc, err = s.state.AccountAtHeight(addr, req.Height)
+		if err != nil {
+			return nil, historicalStateError(err, "account not found")
+		}
+	}
 	if acc == nil {
 		return nil, status.Errorf(codes.NotFound, "account not found")
 	}
 	res := &pactus.GetAccountResponse{
-		Account: s.accountToProto(addr, acc),
+		Account: s.accountToProto(addr, acc, height),
 	}
 
 	return res, nil
@@ -195,13 +291,16 @@ func (s *blockchainServer) GetAccount(_ context.Context,
 func (s *blockchainServer) GetValidatorByNumber(_ context.Context,
 	req *pactus.GetValidatorByNumberRequest,
 ) (*pactus.GetValidatorResponse, error) {
-	val := s.state.ValidatorByNumber(req.Number)
+	snap := s.state.ReadSnapshot()
+	defer snap.Release()
+
+	val := snap.ValidatorByNumber(req.Number)
 	if val == nil {
 		return nil, status.Errorf(codes.NotFound, "validator not found")
 	}
 
 	return &pactus.GetValidatorResponse{
-		Validator: s.validatorToProto(val),
+		Validator: validatorToProto(snap, val),
 	}, nil
 }
 
@@ -212,13 +311,108 @@ func (s *blockchainServer) GetValidator(_ context.Context,
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %v", err.Error())
 	}
-	val := s.state.ValidatorByAddress(addr)
+
+	if req.WithProof {
+		if req.Height != 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "proofs are only available for the latest state")
+		}
+
+		val, proof, err := s.state.ValidatorProof(addr)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "validator not found")
+		}
+
+		return &pactus.GetValidatorResponse{
+			Validator: validatorToProto(s.state, val),
+			Proof:     stateProofToProto(proof),
+		}, nil
+	}
+
+	snap := s.state.ReadSnapshot()
+	defer snap.Release()
+
+	var val *validator.Validator
+	if req.Height == 0 {
+		val = snap.ValidatorByAddress(addr)
+	} else {
+		val, err = s.state.ValidatorAtHeight(addr, req.Height)
+		if err != nil {
+			return nil, historicalStateError(err, "validator not found")
+		}
+	}
 	if val == nil {
 		return nil, status.Errorf(codes.NotFound, "validator not found")
 	}
 
 	return &pactus.GetValidatorResponse{
-		Validator: s.validatorToProto(val),
+		Validator: validatorToProto(snap, val),
+	}, nil
+}
+
+func stateProofToProto(proof *state.StateProof) *pactus.StateProof {
+	siblings := make([][]byte, 0, len(proof.Siblings))
+	for _, sibling := range proof.Siblings {
+		siblings = append(siblings, sibling.Bytes())
+	}
+
+	return &pactus.StateProof{
+		Index:     proof.Index,
+		Siblings:  siblings,
+		StateRoot: proof.StateRoot.Bytes(),
+		Height:    proof.Height,
+	}
+}
+
+// historicalStateError converts the error of a historical state query into a gRPC status error.
+func historicalStateError(err error, notFoundMsg string) error {
+	var heightErr store.ArchivedHeightError
+	switch {
+	case errors.Is(err, store.ErrNotArchival):
+		return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	case errors.As(err, &heightErr):
+		return status.Errorf(codes.OutOfRange, "%s", err.Error())
+	default:
+		return status.Errorf(codes.NotFound, "%s", notFoundMsg)
+	}
+}
+
+func (s *blockchainServer) GetValidatorAvailability(_ context.Context,
+	req *pactus.GetValidatorAvailabilityRequest,
+) (*pactus.GetValidatorAvailabilityResponse, error) {
+	addr, err := crypto.AddressFromString(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %v", err.Error())
+	}
+
+	val := s.state.ValidatorByAddress(addr)
+	if val == nil {
+		return nil, status.Errorf(codes.NotFound, "validator not found")
+	}
+
+	startHeight, lastHeight, ok := s.state.AvailabilityRange()
+	if !ok {
+		return nil, status.Errorf(codes.Unavailable, "availability is not recorded yet")
+	}
+
+	from, to := req.FromHeight, req.ToHeight
+	if from == 0 {
+		from = startHeight
+	}
+	if to == 0 {
+		to = lastHeight
+	}
+
+	avail, err := s.state.ValidatorAvailability(val.Number(), from, to)
+	if err != nil {
+		return nil, status.Errorf(codes.OutOfRange, "%s", err.Error())
+	}
+
+	return &pactus.GetValidatorAvailabilityResponse{
+		FromHeight:        from,
+		ToHeight:          to,
+		InCommittee:       avail.InCommittee,
+		Absent:            avail.Absent,
+		AvailabilityScore: avail.Score(),
 	}, nil
 }
 
@@ -242,7 +436,10 @@ func (s *blockchainServer) GetPublicKey(_ context.Context,
 		return nil, status.Errorf(codes.InvalidArgument, "invalid account address: %v", err.Error())
 	}
 
-	publicKey, err := s.state.PublicKey(addr)
+	snap := s.state.ReadSnapshot()
+	defer snap.Release()
+
+	publicKey, err := snap.PublicKey(addr)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "public key not found")
 	}
@@ -250,9 +447,97 @@ func (s *blockchainServer) GetPublicKey(_ context.Context,
 	return &pactus.GetPublicKeyResponse{PublicKey: publicKey.String()}, nil
 }
 
-func (s *blockchainServer) validatorToProto(val *validator.Validator) *pactus.ValidatorInfo {
+func (s *blockchainServer) GetAccountTransactions(_ context.Context,
+	req *pactus.GetAccountTransactionsRequest,
+) (*pactus.GetAccountTransactionsResponse, error) {
+	addr, err := crypto.AddressFromString(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultTransactionsLimit
+	}
+	if limit > maxTransactionsLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit is more than %d", maxTransactionsLimit)
+	}
+
+	committedTxs, err := s.state.AddressTransactions(addr, int(req.Offset), limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	trxs := make([]*pactus.GetTransactionResponse, 0, len(committedTxs))
+	for _, committedTx := range committedTxs {
+		trx, err := committedTxToProto(committedTx, req.Verbosity)
+		if err != nil {
+			return nil, err
+		}
+		setReceipt(s.state, trx, committedTx.TxID)
+		trxs = append(trxs, trx)
+	}
+
+	return &pactus.GetAccountTransactionsResponse{Transactions: trxs}, nil
+}
+
+func (s *blockchainServer) GetValidatorRewards(_ context.Context,
+	req *pactus.GetValidatorRewardsRequest,
+) (*pactus.GetValidatorRewardsResponse, error) {
+	addr, err := crypto.AddressFromString(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	if !addr.IsValidatorAddress() {
+		return nil, status.Errorf(codes.InvalidArgument, "not a validator address: %s", req.Address)
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultRewardsLimit
+	}
+	if limit > maxRewardsLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit is more than %d", maxRewardsLimit)
+	}
+
+	summary, rewards := s.state.ValidatorRewards(addr, int(req.Offset), limit)
+	rewardInfos := make([]*pactus.ValidatorRewardInfo, 0, len(rewards))
+	for _, reward := range rewards {
+		rewardInfos = append(rewardInfos, &pactus.ValidatorRewardInfo{
+			Height:        reward.Height,
+			RewardAddress: reward.RewardAddress.String(),
+			Reward:        reward.Reward.ToNanoPAC(),
+			Fee:           reward.Fee.ToNanoPAC(),
+		})
+	}
+
+	return &pactus.GetValidatorRewardsResponse{
+		Address:        addr.String(),
+		ProposedBlocks: summary.ProposedBlocks,
+		TotalRewards:   summary.Rewards.ToNanoPAC(),
+		TotalFees:      summary.Fees.ToNanoPAC(),
+		Rewards:        rewardInfos,
+		FromHeight:     summary.FromHeight,
+	}, nil
+}
+
+// availabilityScorer calculates the availability score of the validators.
+// Both the state and its read snapshots implement it.
+type availabilityScorer interface {
+	AvailabilityScore(valNum int32) float64
+}
+
+func validatorToProto(scorer availabilityScorer, val *validator.Validator) *pactus.ValidatorInfo {
 	data, _ := val.Bytes()
 
+	entries := make([]*pactus.UnbondingEntry, 0, len(val.UnbondingEntries()))
+	for _, entry := range val.UnbondingEntries() {
+		entries = append(entries, &pactus.UnbondingEntry{
+			Amount: entry.Amount.ToNanoPAC(),
+			Height: entry.Height,
+		})
+	}
+
 	return &pactus.ValidatorInfo{
 		Hash:                val.Hash().Bytes(),
 		Data:                data,
@@ -263,20 +548,35 @@ func (s *blockchainServer) validatorToProto(val *validator.Validator) *pactus.Va
 		LastBondingHeight:   val.LastBondingHeight(),
 		LastSortitionHeight: val.LastSortitionHeight(),
 		UnbondingHeight:     val.UnbondingHeight(),
-		AvailabilityScore:   s.state.AvailabilityScore(val.Number()),
+		AvailabilityScore:   scorer.AvailabilityScore(val.Number()),
+		UnbondingEntries:    entries,
 	}
 }
 
-func (s *blockchainServer) accountToProto(addr crypto.Address, acc *account.Account) *pactus.AccountInfo {
+// accountToProto converts the account to its protobuf representation.
+// The locked amounts of the vesting schedules are calculated for the block after the given height.
+func (s *blockchainServer) accountToProto(addr crypto.Address, acc *account.Account,
+	height uint32,
+) *pactus.AccountInfo {
 	data, _ := acc.Bytes()
 
-	return &pactus.AccountInfo{
+	info := &pactus.AccountInfo{
 		Hash:    acc.Hash().Bytes(),
 		Data:    data,
 		Number:  acc.Number(),
 		Balance: acc.Balance().ToNanoPAC(),
 		Address: addr.String(),
 	}
+	for _, vesting := range acc.Vestings() {
+		info.Vestings = append(info.Vestings, &pactus.AccountVesting{
+			StartHeight: vesting.StartHeight,
+			EndHeight:   vesting.EndHeight,
+			Amount:      vesting.Amount.ToNanoPAC(),
+			Locked:      vesting.LockedAmount(height + 1).ToNanoPAC(),
+		})
+	}
+
+	return info
 }
 
 func (s *blockchainServer) voteToProto(v *vote.Vote) *pactus.VoteInfo {
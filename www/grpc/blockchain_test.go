@@ -2,10 +2,20 @@ package grpc
 
 import (
 	"context"
+	"math"
 	"testing"
 
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/simplemerkle"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestGetBlock(t *testing.T) {
@@ -162,6 +172,15 @@ func TestGetBlockchainInfo(t *testing.T) {
 		assert.NotEmpty(t, res.LastBlockHash)
 	})
 
+	t.Run("Should return the halted status", func(t *testing.T) {
+		td.mockState.TestHalted = true
+		res, err := client.GetBlockchainInfo(context.Background(),
+			&pactus.GetBlockchainInfoRequest{})
+
+		assert.NoError(t, err)
+		assert.True(t, res.Halted)
+	})
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 	td.StopServer()
 }
@@ -196,6 +215,69 @@ func TestGetAccount(t *testing.T) {
 		assert.NotNil(t, res)
 		assert.Equal(t, res.Account.Balance, acc.Balance().ToNanoPAC())
 		assert.Equal(t, res.Account.Number, acc.Number())
+		assert.Empty(t, res.Account.Vestings)
+	})
+
+	t.Run("Should return vesting schedule", func(t *testing.T) {
+		vestingAcc, vestingAddr := td.mockState.TestStore.AddTestAccount()
+		vestingAcc.AddVesting(account.NewVesting(math.MaxUint32, math.MaxUint32, vestingAcc.Balance()))
+		td.mockState.TestStore.UpdateAccount(vestingAddr, vestingAcc)
+
+		res, err := client.GetAccount(context.Background(),
+			&pactus.GetAccountRequest{Address: vestingAddr.String()})
+
+		require.NoError(t, err)
+		require.Len(t, res.Account.Vestings, 1)
+		assert.Equal(t, uint32(math.MaxUint32), res.Account.Vestings[0].StartHeight)
+		assert.Equal(t, uint32(math.MaxUint32), res.Account.Vestings[0].EndHeight)
+		assert.Equal(t, vestingAcc.Balance().ToNanoPAC(), res.Account.Vestings[0].Amount)
+		assert.Equal(t, vestingAcc.Balance().ToNanoPAC(), res.Account.Vestings[0].Locked)
+	})
+
+	t.Run("Should return account details at height", func(t *testing.T) {
+		res, err := client.GetAccount(context.Background(),
+			&pactus.GetAccountRequest{Address: addr.String(), Height: 5})
+
+		assert.NoError(t, err)
+		assert.Equal(t, res.Account.Balance, acc.Balance().ToNanoPAC())
+	})
+
+	t.Run("Should return error for not committed height", func(t *testing.T) {
+		res, err := client.GetAccount(context.Background(),
+			&pactus.GetAccountRequest{Address: addr.String(), Height: 11})
+
+		assert.Equal(t, codes.OutOfRange, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return account with proof", func(t *testing.T) {
+		res, err := client.GetAccount(context.Background(),
+			&pactus.GetAccountRequest{Address: addr.String(), WithProof: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, acc.Hash().Bytes(), res.Account.Hash)
+		assert.Equal(t, uint64(0), res.Proof.Index)
+		assert.Len(t, res.Proof.Siblings, 1)
+		accHash := acc.Hash()
+		expectedRoot := simplemerkle.HashMerkleBranches(&accHash, &hash.UndefHash)
+		assert.Equal(t, expectedRoot.Bytes(), res.Proof.StateRoot)
+		assert.Equal(t, td.mockState.TestStore.LastHeight+1, res.Proof.Height)
+	})
+
+	t.Run("Should return error for proof at height", func(t *testing.T) {
+		res, err := client.GetAccount(context.Background(),
+			&pactus.GetAccountRequest{Address: addr.String(), Height: 5, WithProof: true})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for proof of non existing account", func(t *testing.T) {
+		res, err := client.GetAccount(context.Background(),
+			&pactus.GetAccountRequest{Address: td.RandAccAddress().String(), WithProof: true})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, res)
 	})
 
 	assert.Nil(t, conn.Close(), "Error closing connection")
@@ -233,6 +315,42 @@ func TestGetValidator(t *testing.T) {
 		assert.Equal(t, val1.PublicKey().String(), res.GetValidator().PublicKey)
 	})
 
+	t.Run("Should return validator at height", func(t *testing.T) {
+		res, err := client.GetValidator(context.Background(),
+			&pactus.GetValidatorRequest{Address: val1.Address().String(), Height: 5})
+
+		assert.NoError(t, err)
+		assert.Equal(t, val1.PublicKey().String(), res.GetValidator().PublicKey)
+	})
+
+	t.Run("Should return error for not committed height", func(t *testing.T) {
+		res, err := client.GetValidator(context.Background(),
+			&pactus.GetValidatorRequest{Address: val1.Address().String(), Height: 11})
+
+		assert.Equal(t, codes.OutOfRange, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return validator with proof", func(t *testing.T) {
+		res, err := client.GetValidator(context.Background(),
+			&pactus.GetValidatorRequest{Address: val1.Address().String(), WithProof: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), res.Proof.Index)
+		valHash := val1.Hash()
+		expectedRoot := simplemerkle.HashMerkleBranches(&hash.UndefHash, &valHash)
+		assert.Equal(t, expectedRoot.Bytes(), res.Proof.StateRoot)
+		assert.Equal(t, td.mockState.TestStore.LastHeight+1, res.Proof.Height)
+	})
+
+	t.Run("Should return error for proof at height", func(t *testing.T) {
+		res, err := client.GetValidator(context.Background(),
+			&pactus.GetValidatorRequest{Address: val1.Address().String(), Height: 5, WithProof: true})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 	td.StopServer()
 }
@@ -328,6 +446,58 @@ func TestGetPublicKey(t *testing.T) {
 	td.StopServer()
 }
 
+func TestGetAccountTransactions(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	blk := td.mockState.TestStore.AddTestBlock(td.mockState.LastBlockHeight() + 1)
+	trx := blk.Transactions()[0]
+
+	t.Run("Should return error for non-parsable address ", func(t *testing.T) {
+		res, err := client.GetAccountTransactions(context.Background(),
+			&pactus.GetAccountTransactionsRequest{Address: ""})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for too big limit", func(t *testing.T) {
+		res, err := client.GetAccountTransactions(context.Background(),
+			&pactus.GetAccountTransactionsRequest{
+				Address: trx.Payload().Signer().String(),
+				Limit:   maxTransactionsLimit + 1,
+			})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return empty list for unknown address", func(t *testing.T) {
+		res, err := client.GetAccountTransactions(context.Background(),
+			&pactus.GetAccountTransactionsRequest{Address: td.RandAccAddress().String()})
+
+		assert.NoError(t, err)
+		assert.Empty(t, res.Transactions)
+	})
+
+	t.Run("Should return the transactions", func(t *testing.T) {
+		res, err := client.GetAccountTransactions(context.Background(),
+			&pactus.GetAccountTransactionsRequest{
+				Address:   trx.Payload().Signer().String(),
+				Verbosity: pactus.TransactionVerbosity_TRANSACTION_INFO,
+			})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Transactions, 1)
+		assert.Equal(t, blk.Header().UnixTime(), res.Transactions[0].BlockTime)
+		assert.Equal(t, trx.ID().Bytes(), res.Transactions[0].Transaction.Id)
+		assert.Equal(t, trx.Payload().Signer().String(), res.Transactions[0].Transaction.GetTransfer().Sender)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
 func TestConsensusInfo(t *testing.T) {
 	td := setup(t, nil)
 	conn, client := td.blockchainClient(t)
@@ -353,3 +523,196 @@ func TestConsensusInfo(t *testing.T) {
 	assert.Nil(t, conn.Close(), "Error closing connection")
 	td.StopServer()
 }
+
+func TestGetDoubleSignEvidences(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	t.Run("Should return no evidence", func(t *testing.T) {
+		res, err := client.GetDoubleSignEvidences(context.Background(), &pactus.GetDoubleSignEvidencesRequest{})
+
+		assert.NoError(t, err)
+		assert.Empty(t, res.Evidences)
+	})
+
+	t.Run("Should return the evidences", func(t *testing.T) {
+		ev, _ := td.GenerateTestDoubleSign(100, 2)
+		td.consMocks[0].AddDoubleSign(ev)
+		td.consMocks[1].AddDoubleSign(ev)
+
+		res, err := client.GetDoubleSignEvidences(context.Background(), &pactus.GetDoubleSignEvidencesRequest{})
+		assert.NoError(t, err)
+		require.Len(t, res.Evidences, 1)
+
+		evInfo := res.Evidences[0]
+		data, _ := ev.Bytes()
+		assert.Equal(t, ev.Hash().Bytes(), evInfo.Hash)
+		assert.Equal(t, ev.Signer().String(), evInfo.Signer)
+		assert.Equal(t, uint32(100), evInfo.Height)
+		assert.Equal(t, ev.Vote1().BlockHash().Bytes(), evInfo.Vote1.BlockHash)
+		assert.Equal(t, ev.Vote2().BlockHash().Bytes(), evInfo.Vote2.BlockHash)
+		assert.Equal(t, pactus.VoteType_VOTE_PRECOMMIT, evInfo.Vote1.Type)
+		assert.Equal(t, data, evInfo.Data)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetValidatorAvailability(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	// The mock state has 10 blocks, so the certificates of blocks 1 to 9 are recorded.
+	num := td.mockState.TestStore.Blocks[5].PrevCertificate().Committers()[0]
+	val2, _ := td.GenerateTestValidator(num)
+	td.mockState.TestStore.UpdateValidator(val2)
+
+	t.Run("Should return error, invalid address", func(t *testing.T) {
+		res, err := client.GetValidatorAvailability(context.Background(),
+			&pactus.GetValidatorAvailabilityRequest{Address: ""})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error, validator not found", func(t *testing.T) {
+		res, err := client.GetValidatorAvailability(context.Background(),
+			&pactus.GetValidatorAvailabilityRequest{Address: td.RandValAddress().String()})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error, out of range", func(t *testing.T) {
+		res, err := client.GetValidatorAvailability(context.Background(),
+			&pactus.GetValidatorAvailabilityRequest{
+				Address:    val2.Address().String(),
+				FromHeight: 5,
+				ToHeight:   10,
+			})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return the availability in the whole range", func(t *testing.T) {
+		res, err := client.GetValidatorAvailability(context.Background(),
+			&pactus.GetValidatorAvailabilityRequest{Address: val2.Address().String()})
+		require.NoError(t, err)
+
+		expected, _ := td.mockState.TestStore.Availability(num, 1, 9)
+		assert.Equal(t, uint32(1), res.FromHeight)
+		assert.Equal(t, uint32(9), res.ToHeight)
+		assert.Equal(t, expected.InCommittee, res.InCommittee)
+		assert.Equal(t, expected.Absent, res.Absent)
+		assert.Equal(t, expected.Score(), res.AvailabilityScore)
+		assert.NotZero(t, res.InCommittee)
+	})
+
+	t.Run("Should return the availability in a range", func(t *testing.T) {
+		res, err := client.GetValidatorAvailability(context.Background(),
+			&pactus.GetValidatorAvailabilityRequest{
+				Address:    val2.Address().String(),
+				FromHeight: 4,
+				ToHeight:   4,
+			})
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(4), res.FromHeight)
+		assert.Equal(t, uint32(4), res.ToHeight)
+		assert.Equal(t, uint32(1), res.InCommittee)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetSupplyInfo(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	acc, _ := td.mockState.TestStore.AddTestAccount()
+	val := td.mockState.TestStore.AddTestValidator()
+	treasury := account.NewAccount(0)
+	treasury.AddToBalance(td.RandAmount())
+	td.mockState.TestStore.UpdateAccount(crypto.TreasuryAddress, treasury)
+
+	res, err := client.GetSupplyInfo(context.Background(), &pactus.GetSupplyInfoRequest{})
+	require.NoError(t, err)
+
+	total := acc.Balance() + val.Stake() + treasury.Balance()
+	assert.Equal(t, td.mockState.LastBlockHeight(), res.Height)
+	assert.Equal(t, total.ToNanoPAC(), res.TotalSupply)
+	assert.Equal(t, treasury.Balance().ToNanoPAC(), res.TreasuryBalance)
+	assert.Equal(t, (total - treasury.Balance()).ToNanoPAC(), res.CirculatingSupply)
+	assert.Equal(t, val.Stake().ToNanoPAC(), res.TotalStaked)
+	assert.Zero(t, res.UnbondingAmount)
+	assert.Equal(t, uint32(1), res.CountedFromHeight)
+	assert.True(t, res.CountersComplete)
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetValidatorRewards(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	proposer := td.RandValAddress()
+	rewardAddr := td.RandAccAddress()
+	height := td.mockState.LastBlockHeight() + 1
+	blk, cert := td.GenerateTestBlock(height)
+	txs := block.NewTxs()
+	txs.Append(tx.NewSubsidyTx(height, rewardAddr, 1e9, ""))
+	header := blk.Header()
+	blk = block.MakeBlock(header.Version(), header.Time(), txs, header.PrevBlockHash(),
+		header.StateRoot(), blk.PrevCertificate(), header.SortitionSeed(), proposer)
+	td.mockState.TestStore.SaveBlock(blk, cert)
+
+	t.Run("Should return error for non-validator address", func(t *testing.T) {
+		res, err := client.GetValidatorRewards(context.Background(),
+			&pactus.GetValidatorRewardsRequest{Address: rewardAddr.String()})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for too big limit", func(t *testing.T) {
+		res, err := client.GetValidatorRewards(context.Background(),
+			&pactus.GetValidatorRewardsRequest{
+				Address: proposer.String(),
+				Limit:   maxRewardsLimit + 1,
+			})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return the rewards", func(t *testing.T) {
+		res, err := client.GetValidatorRewards(context.Background(),
+			&pactus.GetValidatorRewardsRequest{Address: proposer.String()})
+		require.NoError(t, err)
+
+		assert.Equal(t, proposer.String(), res.Address)
+		assert.Equal(t, uint32(1), res.ProposedBlocks)
+		assert.Equal(t, int64(1e9), res.TotalRewards)
+		assert.Zero(t, res.TotalFees)
+		assert.Equal(t, uint32(1), res.FromHeight)
+		require.Len(t, res.Rewards, 1)
+		assert.Equal(t, height, res.Rewards[0].Height)
+		assert.Equal(t, rewardAddr.String(), res.Rewards[0].RewardAddress)
+	})
+
+	t.Run("Should return empty list for unknown validator", func(t *testing.T) {
+		res, err := client.GetValidatorRewards(context.Background(),
+			&pactus.GetValidatorRewardsRequest{Address: td.RandValAddress().String()})
+		require.NoError(t, err)
+
+		assert.Zero(t, res.ProposedBlocks)
+		assert.Empty(t, res.Rewards)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
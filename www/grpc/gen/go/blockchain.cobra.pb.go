@@ -30,6 +30,11 @@ func BlockchainClientCommand(options ...client.Option) *cobra.Command {
 		_BlockchainGetValidatorByNumberCommand(cfg),
 		_BlockchainGetValidatorAddressesCommand(cfg),
 		_BlockchainGetPublicKeyCommand(cfg),
+		_BlockchainGetAccountTransactionsCommand(cfg),
+		_BlockchainGetValidatorAvailabilityCommand(cfg),
+		_BlockchainGetDoubleSignEvidencesCommand(cfg),
+		_BlockchainGetSupplyInfoCommand(cfg),
+		_BlockchainGetValidatorRewardsCommand(cfg),
 	)
 	return cmd
 }
@@ -279,6 +284,8 @@ func _BlockchainGetAccountCommand(cfg *client.Config) *cobra.Command {
 	}
 
 	cmd.PersistentFlags().StringVar(&req.Address, cfg.FlagNamer("Address"), "", "Address of the account.")
+	cmd.PersistentFlags().Uint32Var(&req.Height, cfg.FlagNamer("Height"), 0, "Optional height to retrieve the account state after committing the block\n at this height. It requires the node to be in archival mode.\n If not set or zero, the latest state is returned.")
+	cmd.PersistentFlags().BoolVar(&req.WithProof, cfg.FlagNamer("WithProof"), false, "If true, the merkle proof of the account in the state root is returned.\n Proofs are only available for the latest state.")
 
 	return cmd
 }
@@ -321,6 +328,8 @@ func _BlockchainGetValidatorCommand(cfg *client.Config) *cobra.Command {
 	}
 
 	cmd.PersistentFlags().StringVar(&req.Address, cfg.FlagNamer("Address"), "", "Address of the validator.")
+	cmd.PersistentFlags().Uint32Var(&req.Height, cfg.FlagNamer("Height"), 0, "Optional height to retrieve the validator state after committing the block\n at this height. It requires the node to be in archival mode.\n If not set or zero, the latest state is returned.")
+	cmd.PersistentFlags().BoolVar(&req.WithProof, cfg.FlagNamer("WithProof"), false, "If true, the merkle proof of the validator in the state root is returned.\n Proofs are only available for the latest state.")
 
 	return cmd
 }
@@ -448,3 +457,216 @@ func _BlockchainGetPublicKeyCommand(cfg *client.Config) *cobra.Command {
 
 	return cmd
 }
+
+func _BlockchainGetAccountTransactionsCommand(cfg *client.Config) *cobra.Command {
+	req := &GetAccountTransactionsRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetAccountTransactions"),
+		Short: "GetAccountTransactions RPC client",
+		Long:  "GetAccountTransactions retrieves the transactions related to an address,\n starting from the most recent one.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetAccountTransactions"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetAccountTransactionsRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetAccountTransactions(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.Address, cfg.FlagNamer("Address"), "", "Address of the account or validator.")
+	cmd.PersistentFlags().Uint32Var(&req.Offset, cfg.FlagNamer("Offset"), 0, "Number of the most recent transactions to skip.")
+	cmd.PersistentFlags().Uint32Var(&req.Limit, cfg.FlagNamer("Limit"), 0, "Maximum number of transactions to return.\n If not explicitly set, it defaults to 10. It can't be more than 100.")
+	flag.EnumVar(cmd.PersistentFlags(), &req.Verbosity, cfg.FlagNamer("Verbosity"), "Verbosity level for transaction details.")
+
+	return cmd
+}
+
+func _BlockchainGetValidatorAvailabilityCommand(cfg *client.Config) *cobra.Command {
+	req := &GetValidatorAvailabilityRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetValidatorAvailability"),
+		Short: "GetValidatorAvailability RPC client",
+		Long:  "GetValidatorAvailability retrieves the participation of a validator in\n the certificates over a range of heights.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetValidatorAvailability"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetValidatorAvailabilityRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetValidatorAvailability(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.Address, cfg.FlagNamer("Address"), "", "Address of the validator.")
+	cmd.PersistentFlags().Uint32Var(&req.FromHeight, cfg.FlagNamer("FromHeight"), 0, "Height of the first certificate in the range.\n If not set or zero, the range starts from the first recorded certificate.")
+	cmd.PersistentFlags().Uint32Var(&req.ToHeight, cfg.FlagNamer("ToHeight"), 0, "Height of the last certificate in the range.\n If not set or zero, the range ends at the last recorded certificate.")
+
+	return cmd
+}
+
+func _BlockchainGetDoubleSignEvidencesCommand(cfg *client.Config) *cobra.Command {
+	req := &GetDoubleSignEvidencesRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetDoubleSignEvidences"),
+		Short: "GetDoubleSignEvidences RPC client",
+		Long:  "GetDoubleSignEvidences retrieves the recent evidences of validators that\n signed two different block hashes for the same height, round and vote type.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetDoubleSignEvidences"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetDoubleSignEvidencesRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetDoubleSignEvidences(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	return cmd
+}
+
+func _BlockchainGetSupplyInfoCommand(cfg *client.Config) *cobra.Command {
+	req := &GetSupplyInfoRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetSupplyInfo"),
+		Short: "GetSupplyInfo RPC client",
+		Long:  "GetSupplyInfo retrieves information about the supply of coins, including\n the circulating supply, total stake and the cumulative fees and rewards.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetSupplyInfo"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetSupplyInfoRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetSupplyInfo(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	return cmd
+}
+
+func _BlockchainGetValidatorRewardsCommand(cfg *client.Config) *cobra.Command {
+	req := &GetValidatorRewardsRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetValidatorRewards"),
+		Short: "GetValidatorRewards RPC client",
+		Long:  "GetValidatorRewards retrieves the rewards that a validator has earned by\n proposing blocks, starting from the most recent one.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetValidatorRewards"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetValidatorRewardsRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetValidatorRewards(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.Address, cfg.FlagNamer("Address"), "", "Address of the validator.")
+	cmd.PersistentFlags().Uint32Var(&req.Offset, cfg.FlagNamer("Offset"), 0, "Number of the most recent rewards to skip.")
+	cmd.PersistentFlags().Uint32Var(&req.Limit, cfg.FlagNamer("Limit"), 0, "Maximum number of rewards to return.\n If not explicitly set, it defaults to 10. It can't be more than 100.")
+
+	return cmd
+}
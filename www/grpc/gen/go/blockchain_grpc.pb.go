@@ -19,16 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Blockchain_GetBlock_FullMethodName              = "/pactus.Blockchain/GetBlock"
-	Blockchain_GetBlockHash_FullMethodName          = "/pactus.Blockchain/GetBlockHash"
-	Blockchain_GetBlockHeight_FullMethodName        = "/pactus.Blockchain/GetBlockHeight"
-	Blockchain_GetBlockchainInfo_FullMethodName     = "/pactus.Blockchain/GetBlockchainInfo"
-	Blockchain_GetConsensusInfo_FullMethodName      = "/pactus.Blockchain/GetConsensusInfo"
-	Blockchain_GetAccount_FullMethodName            = "/pactus.Blockchain/GetAccount"
-	Blockchain_GetValidator_FullMethodName          = "/pactus.Blockchain/GetValidator"
-	Blockchain_GetValidatorByNumber_FullMethodName  = "/pactus.Blockchain/GetValidatorByNumber"
-	Blockchain_GetValidatorAddresses_FullMethodName = "/pactus.Blockchain/GetValidatorAddresses"
-	Blockchain_GetPublicKey_FullMethodName          = "/pactus.Blockchain/GetPublicKey"
+	Blockchain_GetBlock_FullMethodName                 = "/pactus.Blockchain/GetBlock"
+	Blockchain_GetBlockHash_FullMethodName             = "/pactus.Blockchain/GetBlockHash"
+	Blockchain_GetBlockHeight_FullMethodName           = "/pactus.Blockchain/GetBlockHeight"
+	Blockchain_GetBlockchainInfo_FullMethodName        = "/pactus.Blockchain/GetBlockchainInfo"
+	Blockchain_GetConsensusInfo_FullMethodName         = "/pactus.Blockchain/GetConsensusInfo"
+	Blockchain_GetAccount_FullMethodName               = "/pactus.Blockchain/GetAccount"
+	Blockchain_GetValidator_FullMethodName             = "/pactus.Blockchain/GetValidator"
+	Blockchain_GetValidatorByNumber_FullMethodName     = "/pactus.Blockchain/GetValidatorByNumber"
+	Blockchain_GetValidatorAddresses_FullMethodName    = "/pactus.Blockchain/GetValidatorAddresses"
+	Blockchain_GetPublicKey_FullMethodName             = "/pactus.Blockchain/GetPublicKey"
+	Blockchain_GetAccountTransactions_FullMethodName   = "/pactus.Blockchain/GetAccountTransactions"
+	Blockchain_GetValidatorAvailability_FullMethodName = "/pactus.Blockchain/GetValidatorAvailability"
+	Blockchain_GetDoubleSignEvidences_FullMethodName   = "/pactus.Blockchain/GetDoubleSignEvidences"
+	Blockchain_GetSupplyInfo_FullMethodName            = "/pactus.Blockchain/GetSupplyInfo"
+	Blockchain_GetValidatorRewards_FullMethodName      = "/pactus.Blockchain/GetValidatorRewards"
 )
 
 // BlockchainClient is the client API for Blockchain service.
@@ -60,6 +65,21 @@ type BlockchainClient interface {
 	// GetPublicKey retrieves the public key of an account based on the provided
 	// address.
 	GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyResponse, error)
+	// GetAccountTransactions retrieves the transactions related to an address,
+	// starting from the most recent one.
+	GetAccountTransactions(ctx context.Context, in *GetAccountTransactionsRequest, opts ...grpc.CallOption) (*GetAccountTransactionsResponse, error)
+	// GetValidatorAvailability retrieves the participation of a validator in
+	// the certificates over a range of heights.
+	GetValidatorAvailability(ctx context.Context, in *GetValidatorAvailabilityRequest, opts ...grpc.CallOption) (*GetValidatorAvailabilityResponse, error)
+	// GetDoubleSignEvidences retrieves the recent evidences of validators that
+	// signed two different block hashes for the same height, round and vote type.
+	GetDoubleSignEvidences(ctx context.Context, in *GetDoubleSignEvidencesRequest, opts ...grpc.CallOption) (*GetDoubleSignEvidencesResponse, error)
+	// GetSupplyInfo retrieves information about the supply of coins, including
+	// the circulating supply, total stake and the cumulative fees and rewards.
+	GetSupplyInfo(ctx context.Context, in *GetSupplyInfoRequest, opts ...grpc.CallOption) (*GetSupplyInfoResponse, error)
+	// GetValidatorRewards retrieves the rewards that a validator has earned by
+	// proposing blocks, starting from the most recent one.
+	GetValidatorRewards(ctx context.Context, in *GetValidatorRewardsRequest, opts ...grpc.CallOption) (*GetValidatorRewardsResponse, error)
 }
 
 type blockchainClient struct {
@@ -160,6 +180,51 @@ func (c *blockchainClient) GetPublicKey(ctx context.Context, in *GetPublicKeyReq
 	return out, nil
 }
 
+func (c *blockchainClient) GetAccountTransactions(ctx context.Context, in *GetAccountTransactionsRequest, opts ...grpc.CallOption) (*GetAccountTransactionsResponse, error) {
+	out := new(GetAccountTransactionsResponse)
+	err := c.cc.Invoke(ctx, Blockchain_GetAccountTransactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetValidatorAvailability(ctx context.Context, in *GetValidatorAvailabilityRequest, opts ...grpc.CallOption) (*GetValidatorAvailabilityResponse, error) {
+	out := new(GetValidatorAvailabilityResponse)
+	err := c.cc.Invoke(ctx, Blockchain_GetValidatorAvailability_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetDoubleSignEvidences(ctx context.Context, in *GetDoubleSignEvidencesRequest, opts ...grpc.CallOption) (*GetDoubleSignEvidencesResponse, error) {
+	out := new(GetDoubleSignEvidencesResponse)
+	err := c.cc.Invoke(ctx, Blockchain_GetDoubleSignEvidences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetSupplyInfo(ctx context.Context, in *GetSupplyInfoRequest, opts ...grpc.CallOption) (*GetSupplyInfoResponse, error) {
+	out := new(GetSupplyInfoResponse)
+	err := c.cc.Invoke(ctx, Blockchain_GetSupplyInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetValidatorRewards(ctx context.Context, in *GetValidatorRewardsRequest, opts ...grpc.CallOption) (*GetValidatorRewardsResponse, error) {
+	out := new(GetValidatorRewardsResponse)
+	err := c.cc.Invoke(ctx, Blockchain_GetValidatorRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlockchainServer is the server API for Blockchain service.
 // All implementations should embed UnimplementedBlockchainServer
 // for forward compatibility
@@ -189,6 +254,21 @@ type BlockchainServer interface {
 	// GetPublicKey retrieves the public key of an account based on the provided
 	// address.
 	GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error)
+	// GetAccountTransactions retrieves the transactions related to an address,
+	// starting from the most recent one.
+	GetAccountTransactions(context.Context, *GetAccountTransactionsRequest) (*GetAccountTransactionsResponse, error)
+	// GetValidatorAvailability retrieves the participation of a validator in
+	// the certificates over a range of heights.
+	GetValidatorAvailability(context.Context, *GetValidatorAvailabilityRequest) (*GetValidatorAvailabilityResponse, error)
+	// GetDoubleSignEvidences retrieves the recent evidences of validators that
+	// signed two different block hashes for the same height, round and vote type.
+	GetDoubleSignEvidences(context.Context, *GetDoubleSignEvidencesRequest) (*GetDoubleSignEvidencesResponse, error)
+	// GetSupplyInfo retrieves information about the supply of coins, including
+	// the circulating supply, total stake and the cumulative fees and rewards.
+	GetSupplyInfo(context.Context, *GetSupplyInfoRequest) (*GetSupplyInfoResponse, error)
+	// GetValidatorRewards retrieves the rewards that a validator has earned by
+	// proposing blocks, starting from the most recent one.
+	GetValidatorRewards(context.Context, *GetValidatorRewardsRequest) (*GetValidatorRewardsResponse, error)
 }
 
 // UnimplementedBlockchainServer should be embedded to have forward compatible implementations.
@@ -225,6 +305,21 @@ func (UnimplementedBlockchainServer) GetValidatorAddresses(context.Context, *Get
 func (UnimplementedBlockchainServer) GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPublicKey not implemented")
 }
+func (UnimplementedBlockchainServer) GetAccountTransactions(context.Context, *GetAccountTransactionsRequest) (*GetAccountTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountTransactions not implemented")
+}
+func (UnimplementedBlockchainServer) GetValidatorAvailability(context.Context, *GetValidatorAvailabilityRequest) (*GetValidatorAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorAvailability not implemented")
+}
+func (UnimplementedBlockchainServer) GetDoubleSignEvidences(context.Context, *GetDoubleSignEvidencesRequest) (*GetDoubleSignEvidencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoubleSignEvidences not implemented")
+}
+func (UnimplementedBlockchainServer) GetSupplyInfo(context.Context, *GetSupplyInfoRequest) (*GetSupplyInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSupplyInfo not implemented")
+}
+func (UnimplementedBlockchainServer) GetValidatorRewards(context.Context, *GetValidatorRewardsRequest) (*GetValidatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorRewards not implemented")
+}
 
 // UnsafeBlockchainServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to BlockchainServer will
@@ -417,6 +512,96 @@ func _Blockchain_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blockchain_GetAccountTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetAccountTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blockchain_GetAccountTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetAccountTransactions(ctx, req.(*GetAccountTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetValidatorAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetValidatorAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blockchain_GetValidatorAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetValidatorAvailability(ctx, req.(*GetValidatorAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetDoubleSignEvidences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDoubleSignEvidencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetDoubleSignEvidences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blockchain_GetDoubleSignEvidences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetDoubleSignEvidences(ctx, req.(*GetDoubleSignEvidencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetSupplyInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSupplyInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetSupplyInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blockchain_GetSupplyInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetSupplyInfo(ctx, req.(*GetSupplyInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blockchain_GetValidatorRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetValidatorRewards(ctx, req.(*GetValidatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Blockchain_ServiceDesc is the grpc.ServiceDesc for Blockchain service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -464,6 +649,26 @@ var Blockchain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPublicKey",
 			Handler:    _Blockchain_GetPublicKey_Handler,
 		},
+		{
+			MethodName: "GetAccountTransactions",
+			Handler:    _Blockchain_GetAccountTransactions_Handler,
+		},
+		{
+			MethodName: "GetValidatorAvailability",
+			Handler:    _Blockchain_GetValidatorAvailability_Handler,
+		},
+		{
+			MethodName: "GetDoubleSignEvidences",
+			Handler:    _Blockchain_GetDoubleSignEvidences_Handler,
+		},
+		{
+			MethodName: "GetSupplyInfo",
+			Handler:    _Blockchain_GetSupplyInfo_Handler,
+		},
+		{
+			MethodName: "GetValidatorRewards",
+			Handler:    _Blockchain_GetValidatorRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "blockchain.proto",
@@ -117,5 +117,50 @@ func (s *BlockchainJsonRpcService) Methods() map[string]func(ctx context.Context
 			}
 			return s.client.GetPublicKey(ctx, req)
 		},
+
+		"pactus.blockchain.get_account_transactions": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetAccountTransactionsRequest)
+			err := protojson.Unmarshal(data, req)
+			if err != nil {
+				return nil, err
+			}
+			return s.client.GetAccountTransactions(ctx, req)
+		},
+
+		"pactus.blockchain.get_validator_availability": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetValidatorAvailabilityRequest)
+			err := protojson.Unmarshal(data, req)
+			if err != nil {
+				return nil, err
+			}
+			return s.client.GetValidatorAvailability(ctx, req)
+		},
+
+		"pactus.blockchain.get_double_sign_evidences": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetDoubleSignEvidencesRequest)
+			err := protojson.Unmarshal(data, req)
+			if err != nil {
+				return nil, err
+			}
+			return s.client.GetDoubleSignEvidences(ctx, req)
+		},
+
+		"pactus.blockchain.get_supply_info": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetSupplyInfoRequest)
+			err := protojson.Unmarshal(data, req)
+			if err != nil {
+				return nil, err
+			}
+			return s.client.GetSupplyInfo(ctx, req)
+		},
+
+		"pactus.blockchain.get_validator_rewards": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetValidatorRewardsRequest)
+			err := protojson.Unmarshal(data, req)
+			if err != nil {
+				return nil, err
+			}
+			return s.client.GetValidatorRewards(ctx, req)
+		},
 	}
 }
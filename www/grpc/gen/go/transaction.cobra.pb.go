@@ -23,10 +23,13 @@ func TransactionClientCommand(options ...client.Option) *cobra.Command {
 		_TransactionGetTransactionCommand(cfg),
 		_TransactionCalculateFeeCommand(cfg),
 		_TransactionBroadcastTransactionCommand(cfg),
+		_TransactionSimulateTransactionCommand(cfg),
 		_TransactionGetRawTransferTransactionCommand(cfg),
 		_TransactionGetRawBondTransactionCommand(cfg),
 		_TransactionGetRawUnbondTransactionCommand(cfg),
 		_TransactionGetRawWithdrawTransactionCommand(cfg),
+		_TransactionGetRawBatchTransferTransactionCommand(cfg),
+		_TransactionGetRawVestingTransferTransactionCommand(cfg),
 	)
 	return cmd
 }
@@ -114,6 +117,7 @@ func _TransactionCalculateFeeCommand(cfg *client.Config) *cobra.Command {
 	cmd.PersistentFlags().Int64Var(&req.Amount, cfg.FlagNamer("Amount"), 0, "Transaction amount in NanoPAC.")
 	flag.EnumVar(cmd.PersistentFlags(), &req.PayloadType, cfg.FlagNamer("PayloadType"), "Type of transaction payload.")
 	cmd.PersistentFlags().BoolVar(&req.FixedAmount, cfg.FlagNamer("FixedAmount"), false, "Indicates that amount should be fixed and includes the fee.")
+	cmd.PersistentFlags().Int32Var(&req.Recipients, cfg.FlagNamer("Recipients"), 0, "Number of recipients for batch transfer transactions; the minimum fee is charged per recipient.")
 
 	return cmd
 }
@@ -160,6 +164,48 @@ func _TransactionBroadcastTransactionCommand(cfg *client.Config) *cobra.Command
 	return cmd
 }
 
+func _TransactionSimulateTransactionCommand(cfg *client.Config) *cobra.Command {
+	req := &SimulateTransactionRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("SimulateTransaction"),
+		Short: "SimulateTransaction RPC client",
+		Long:  "SimulateTransaction executes a raw transaction against the current state\n without committing it, and returns the changes it would make.\n The transaction can be unsigned.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction", "SimulateTransaction"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewTransactionClient(cc)
+				v := &SimulateTransactionRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.SimulateTransaction(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.RawTransaction, cfg.FlagNamer("RawTransaction"), "Raw transaction data, signed or unsigned.")
+
+	return cmd
+}
+
 func _TransactionGetRawTransferTransactionCommand(cfg *client.Config) *cobra.Command {
 	req := &GetRawTransferTransactionRequest{}
 
@@ -295,6 +341,7 @@ func _TransactionGetRawUnbondTransactionCommand(cfg *client.Config) *cobra.Comma
 	cmd.PersistentFlags().Uint32Var(&req.LockTime, cfg.FlagNamer("LockTime"), 0, "Lock time for the transaction.\n If not explicitly set, it sets to the last block height.")
 	cmd.PersistentFlags().StringVar(&req.ValidatorAddress, cfg.FlagNamer("ValidatorAddress"), "", "Address of the validator to unbond from.")
 	cmd.PersistentFlags().StringVar(&req.Memo, cfg.FlagNamer("Memo"), "", "Transaction memo.")
+	cmd.PersistentFlags().Int64Var(&req.Amount, cfg.FlagNamer("Amount"), 0, "Amount to unbond in NanoPAC.\n If set, only this amount is unbonded, and the validator keeps validating\n with the remaining stake. Otherwise, the whole stake is unbonded.")
 
 	return cmd
 }
@@ -345,3 +392,99 @@ func _TransactionGetRawWithdrawTransactionCommand(cfg *client.Config) *cobra.Com
 
 	return cmd
 }
+
+func _TransactionGetRawBatchTransferTransactionCommand(cfg *client.Config) *cobra.Command {
+	req := &GetRawBatchTransferTransactionRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetRawBatchTransferTransaction"),
+		Short: "GetRawBatchTransferTransaction RPC client",
+		Long:  "GetRawBatchTransferTransaction retrieves raw details of a batch transfer\n transaction.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction", "GetRawBatchTransferTransaction"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewTransactionClient(cc)
+				v := &GetRawBatchTransferTransactionRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetRawBatchTransferTransaction(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().Uint32Var(&req.LockTime, cfg.FlagNamer("LockTime"), 0, "Lock time for the transaction.\n If not explicitly set, it sets to the last block height.")
+	cmd.PersistentFlags().StringVar(&req.Sender, cfg.FlagNamer("Sender"), "", "Sender's account address.")
+	cmd.PersistentFlags().StringSliceVar(&req.Receivers, cfg.FlagNamer("Receivers"), nil, "Receivers' account addresses.")
+	cmd.PersistentFlags().Int64SliceVar(&req.Amounts, cfg.FlagNamer("Amounts"), nil, "Transfer amounts in NanoPAC, one for each receiver.")
+	cmd.PersistentFlags().Int64Var(&req.Fee, cfg.FlagNamer("Fee"), 0, "Transaction fee in NanoPAC.\n If not explicitly set, it is calculated based on the total amount.")
+	cmd.PersistentFlags().StringVar(&req.Memo, cfg.FlagNamer("Memo"), "", "Transaction memo.")
+
+	return cmd
+}
+
+func _TransactionGetRawVestingTransferTransactionCommand(cfg *client.Config) *cobra.Command {
+	req := &GetRawVestingTransferTransactionRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetRawVestingTransferTransaction"),
+		Short: "GetRawVestingTransferTransaction RPC client",
+		Long:  "GetRawVestingTransferTransaction retrieves raw details of a vesting\n transfer transaction.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction", "GetRawVestingTransferTransaction"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewTransactionClient(cc)
+				v := &GetRawVestingTransferTransactionRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetRawVestingTransferTransaction(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().Uint32Var(&req.LockTime, cfg.FlagNamer("LockTime"), 0, "Lock time for the transaction.\n If not explicitly set, it sets to the last block height.")
+	cmd.PersistentFlags().StringVar(&req.Sender, cfg.FlagNamer("Sender"), "", "Sender's account address.")
+	cmd.PersistentFlags().StringVar(&req.Receiver, cfg.FlagNamer("Receiver"), "", "Receiver's account address.")
+	cmd.PersistentFlags().Int64Var(&req.Amount, cfg.FlagNamer("Amount"), 0, "Vesting amount in NanoPAC.")
+	cmd.PersistentFlags().Uint32Var(&req.StartHeight, cfg.FlagNamer("StartHeight"), 0, "Block height at which the amount starts unlocking.")
+	cmd.PersistentFlags().Uint32Var(&req.EndHeight, cfg.FlagNamer("EndHeight"), 0, "Block height at which the amount is fully unlocked.")
+	cmd.PersistentFlags().Int64Var(&req.Fee, cfg.FlagNamer("Fee"), 0, "Transaction fee in NanoPAC.\n If not explicitly set, it is calculated based on the amount.")
+	cmd.PersistentFlags().StringVar(&req.Memo, cfg.FlagNamer("Memo"), "", "Transaction memo.")
+
+	return cmd
+}
@@ -19,13 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Transaction_GetTransaction_FullMethodName            = "/pactus.Transaction/GetTransaction"
-	Transaction_CalculateFee_FullMethodName              = "/pactus.Transaction/CalculateFee"
-	Transaction_BroadcastTransaction_FullMethodName      = "/pactus.Transaction/BroadcastTransaction"
-	Transaction_GetRawTransferTransaction_FullMethodName = "/pactus.Transaction/GetRawTransferTransaction"
-	Transaction_GetRawBondTransaction_FullMethodName     = "/pactus.Transaction/GetRawBondTransaction"
-	Transaction_GetRawUnbondTransaction_FullMethodName   = "/pactus.Transaction/GetRawUnbondTransaction"
-	Transaction_GetRawWithdrawTransaction_FullMethodName = "/pactus.Transaction/GetRawWithdrawTransaction"
+	Transaction_GetTransaction_FullMethodName                   = "/pactus.Transaction/GetTransaction"
+	Transaction_CalculateFee_FullMethodName                     = "/pactus.Transaction/CalculateFee"
+	Transaction_BroadcastTransaction_FullMethodName             = "/pactus.Transaction/BroadcastTransaction"
+	Transaction_SimulateTransaction_FullMethodName              = "/pactus.Transaction/SimulateTransaction"
+	Transaction_GetRawTransferTransaction_FullMethodName        = "/pactus.Transaction/GetRawTransferTransaction"
+	Transaction_GetRawBondTransaction_FullMethodName            = "/pactus.Transaction/GetRawBondTransaction"
+	Transaction_GetRawUnbondTransaction_FullMethodName          = "/pactus.Transaction/GetRawUnbondTransaction"
+	Transaction_GetRawWithdrawTransaction_FullMethodName        = "/pactus.Transaction/GetRawWithdrawTransaction"
+	Transaction_GetRawBatchTransferTransaction_FullMethodName   = "/pactus.Transaction/GetRawBatchTransferTransaction"
+	Transaction_GetRawVestingTransferTransaction_FullMethodName = "/pactus.Transaction/GetRawVestingTransferTransaction"
 )
 
 // TransactionClient is the client API for Transaction service.
@@ -40,6 +43,10 @@ type TransactionClient interface {
 	CalculateFee(ctx context.Context, in *CalculateFeeRequest, opts ...grpc.CallOption) (*CalculateFeeResponse, error)
 	// BroadcastTransaction broadcasts a signed transaction to the network.
 	BroadcastTransaction(ctx context.Context, in *BroadcastTransactionRequest, opts ...grpc.CallOption) (*BroadcastTransactionResponse, error)
+	// SimulateTransaction executes a raw transaction against the current state
+	// without committing it, and returns the changes it would make.
+	// The transaction can be unsigned.
+	SimulateTransaction(ctx context.Context, in *SimulateTransactionRequest, opts ...grpc.CallOption) (*SimulateTransactionResponse, error)
 	// GetRawTransferTransaction retrieves raw details of a transfer transaction.
 	GetRawTransferTransaction(ctx context.Context, in *GetRawTransferTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
 	// GetRawBondTransaction retrieves raw details of a bond transaction.
@@ -48,6 +55,12 @@ type TransactionClient interface {
 	GetRawUnbondTransaction(ctx context.Context, in *GetRawUnbondTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
 	// GetRawWithdrawTransaction retrieves raw details of a withdraw transaction.
 	GetRawWithdrawTransaction(ctx context.Context, in *GetRawWithdrawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
+	// GetRawBatchTransferTransaction retrieves raw details of a batch transfer
+	// transaction.
+	GetRawBatchTransferTransaction(ctx context.Context, in *GetRawBatchTransferTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
+	// GetRawVestingTransferTransaction retrieves raw details of a vesting
+	// transfer transaction.
+	GetRawVestingTransferTransaction(ctx context.Context, in *GetRawVestingTransferTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
 }
 
 type transactionClient struct {
@@ -85,6 +98,15 @@ func (c *transactionClient) BroadcastTransaction(ctx context.Context, in *Broadc
 	return out, nil
 }
 
+func (c *transactionClient) SimulateTransaction(ctx context.Context, in *SimulateTransactionRequest, opts ...grpc.CallOption) (*SimulateTransactionResponse, error) {
+	out := new(SimulateTransactionResponse)
+	err := c.cc.Invoke(ctx, Transaction_SimulateTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionClient) GetRawTransferTransaction(ctx context.Context, in *GetRawTransferTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error) {
 	out := new(GetRawTransactionResponse)
 	err := c.cc.Invoke(ctx, Transaction_GetRawTransferTransaction_FullMethodName, in, out, opts...)
@@ -121,6 +143,24 @@ func (c *transactionClient) GetRawWithdrawTransaction(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *transactionClient) GetRawBatchTransferTransaction(ctx context.Context, in *GetRawBatchTransferTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error) {
+	out := new(GetRawTransactionResponse)
+	err := c.cc.Invoke(ctx, Transaction_GetRawBatchTransferTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) GetRawVestingTransferTransaction(ctx context.Context, in *GetRawVestingTransferTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error) {
+	out := new(GetRawTransactionResponse)
+	err := c.cc.Invoke(ctx, Transaction_GetRawVestingTransferTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServer is the server API for Transaction service.
 // All implementations should embed UnimplementedTransactionServer
 // for forward compatibility
@@ -133,6 +173,10 @@ type TransactionServer interface {
 	CalculateFee(context.Context, *CalculateFeeRequest) (*CalculateFeeResponse, error)
 	// BroadcastTransaction broadcasts a signed transaction to the network.
 	BroadcastTransaction(context.Context, *BroadcastTransactionRequest) (*BroadcastTransactionResponse, error)
+	// SimulateTransaction executes a raw transaction against the current state
+	// without committing it, and returns the changes it would make.
+	// The transaction can be unsigned.
+	SimulateTransaction(context.Context, *SimulateTransactionRequest) (*SimulateTransactionResponse, error)
 	// GetRawTransferTransaction retrieves raw details of a transfer transaction.
 	GetRawTransferTransaction(context.Context, *GetRawTransferTransactionRequest) (*GetRawTransactionResponse, error)
 	// GetRawBondTransaction retrieves raw details of a bond transaction.
@@ -141,6 +185,12 @@ type TransactionServer interface {
 	GetRawUnbondTransaction(context.Context, *GetRawUnbondTransactionRequest) (*GetRawTransactionResponse, error)
 	// GetRawWithdrawTransaction retrieves raw details of a withdraw transaction.
 	GetRawWithdrawTransaction(context.Context, *GetRawWithdrawTransactionRequest) (*GetRawTransactionResponse, error)
+	// GetRawBatchTransferTransaction retrieves raw details of a batch transfer
+	// transaction.
+	GetRawBatchTransferTransaction(context.Context, *GetRawBatchTransferTransactionRequest) (*GetRawTransactionResponse, error)
+	// GetRawVestingTransferTransaction retrieves raw details of a vesting
+	// transfer transaction.
+	GetRawVestingTransferTransaction(context.Context, *GetRawVestingTransferTransactionRequest) (*GetRawTransactionResponse, error)
 }
 
 // UnimplementedTransactionServer should be embedded to have forward compatible implementations.
@@ -156,6 +206,9 @@ func (UnimplementedTransactionServer) CalculateFee(context.Context, *CalculateFe
 func (UnimplementedTransactionServer) BroadcastTransaction(context.Context, *BroadcastTransactionRequest) (*BroadcastTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BroadcastTransaction not implemented")
 }
+func (UnimplementedTransactionServer) SimulateTransaction(context.Context, *SimulateTransactionRequest) (*SimulateTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTransaction not implemented")
+}
 func (UnimplementedTransactionServer) GetRawTransferTransaction(context.Context, *GetRawTransferTransactionRequest) (*GetRawTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRawTransferTransaction not implemented")
 }
@@ -168,6 +221,12 @@ func (UnimplementedTransactionServer) GetRawUnbondTransaction(context.Context, *
 func (UnimplementedTransactionServer) GetRawWithdrawTransaction(context.Context, *GetRawWithdrawTransactionRequest) (*GetRawTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRawWithdrawTransaction not implemented")
 }
+func (UnimplementedTransactionServer) GetRawBatchTransferTransaction(context.Context, *GetRawBatchTransferTransactionRequest) (*GetRawTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRawBatchTransferTransaction not implemented")
+}
+func (UnimplementedTransactionServer) GetRawVestingTransferTransaction(context.Context, *GetRawVestingTransferTransactionRequest) (*GetRawTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRawVestingTransferTransaction not implemented")
+}
 
 // UnsafeTransactionServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to TransactionServer will
@@ -234,6 +293,24 @@ func _Transaction_BroadcastTransaction_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transaction_SimulateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).SimulateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_SimulateTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).SimulateTransaction(ctx, req.(*SimulateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Transaction_GetRawTransferTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRawTransferTransactionRequest)
 	if err := dec(in); err != nil {
@@ -306,6 +383,42 @@ func _Transaction_GetRawWithdrawTransaction_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transaction_GetRawBatchTransferTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawBatchTransferTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).GetRawBatchTransferTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_GetRawBatchTransferTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).GetRawBatchTransferTransaction(ctx, req.(*GetRawBatchTransferTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_GetRawVestingTransferTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawVestingTransferTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).GetRawVestingTransferTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_GetRawVestingTransferTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).GetRawVestingTransferTransaction(ctx, req.(*GetRawVestingTransferTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Transaction_ServiceDesc is the grpc.ServiceDesc for Transaction service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -325,6 +438,10 @@ var Transaction_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BroadcastTransaction",
 			Handler:    _Transaction_BroadcastTransaction_Handler,
 		},
+		{
+			MethodName: "SimulateTransaction",
+			Handler:    _Transaction_SimulateTransaction_Handler,
+		},
 		{
 			MethodName: "GetRawTransferTransaction",
 			Handler:    _Transaction_GetRawTransferTransaction_Handler,
@@ -341,6 +458,14 @@ var Transaction_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRawWithdrawTransaction",
 			Handler:    _Transaction_GetRawWithdrawTransaction_Handler,
 		},
+		{
+			MethodName: "GetRawBatchTransferTransaction",
+			Handler:    _Transaction_GetRawBatchTransferTransaction_Handler,
+		},
+		{
+			MethodName: "GetRawVestingTransferTransaction",
+			Handler:    _Transaction_GetRawVestingTransferTransaction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "transaction.proto",
@@ -55,6 +55,15 @@ func (s *TransactionJsonRpcService) Methods() map[string]func(ctx context.Contex
 			return s.client.BroadcastTransaction(ctx, req)
 		},
 
+		"pactus.transaction.simulate_transaction": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(SimulateTransactionRequest)
+			err := protojson.Unmarshal(data, req)
+			if err != nil {
+				return nil, err
+			}
+			return s.client.SimulateTransaction(ctx, req)
+		},
+
 		"pactus.transaction.get_raw_transfer_transaction": func(ctx context.Context, data json.RawMessage) (any, error) {
 			req := new(GetRawTransferTransactionRequest)
 			err := protojson.Unmarshal(data, req)
@@ -90,5 +99,23 @@ func (s *TransactionJsonRpcService) Methods() map[string]func(ctx context.Contex
 			}
 			return s.client.GetRawWithdrawTransaction(ctx, req)
 		},
+
+		"pactus.transaction.get_raw_batch_transfer_transaction": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetRawBatchTransferTransactionRequest)
+			err := protojson.Unmarshal(data, req)
+			if err != nil {
+				return nil, err
+			}
+			return s.client.GetRawBatchTransferTransaction(ctx, req)
+		},
+
+		"pactus.transaction.get_raw_vesting_transfer_transaction": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetRawVestingTransferTransactionRequest)
+			err := protojson.Unmarshal(data, req)
+			if err != nil {
+				return nil, err
+			}
+			return s.client.GetRawVestingTransferTransaction(ctx, req)
+		},
 	}
 }
@@ -34,6 +34,11 @@ func (s *networkServer) GetNodeInfo(_ context.Context,
 		servicesNames = append(servicesNames, "NETWORK")
 	}
 
+	if s.sync.Services().IsPrunedNode() {
+		services = append(services, int32(service.PrunedNode))
+		servicesNames = append(servicesNames, "PRUNED")
+	}
+
 	return &pactus.GetNodeInfoResponse{
 		Moniker:       s.sync.Moniker(),
 		Agent:         version.NodeAgent.String(),
@@ -6,7 +6,9 @@ import (
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/util/logger"
@@ -33,30 +35,19 @@ func (s *transactionServer) GetTransaction(_ context.Context,
 		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction ID: %v", err.Error())
 	}
 
-	committedTx := s.state.CommittedTx(id)
+	snap := s.state.ReadSnapshot()
+	defer snap.Release()
+
+	committedTx := snap.CommittedTx(id)
 	if committedTx == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "transaction not found")
 	}
 
-	res := &pactus.GetTransactionResponse{
-		BlockHeight: committedTx.Height,
-		BlockTime:   committedTx.BlockTime,
-	}
-
-	switch req.Verbosity {
-	case pactus.TransactionVerbosity_TRANSACTION_DATA:
-		res.Transaction = &pactus.TransactionInfo{
-			Id:   committedTx.TxID.Bytes(),
-			Data: committedTx.Data,
-		}
-
-	case pactus.TransactionVerbosity_TRANSACTION_INFO:
-		trx, err := committedTx.ToTx()
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, err.Error())
-		}
-		res.Transaction = transactionToProto(trx)
+	res, err := committedTxToProto(committedTx, req.Verbosity)
+	if err != nil {
+		return nil, err
 	}
+	setReceipt(snap, res, id)
 
 	return res, nil
 }
@@ -82,11 +73,47 @@ func (s *transactionServer) BroadcastTransaction(_ context.Context,
 	}, nil
 }
 
+func (s *transactionServer) SimulateTransaction(_ context.Context,
+	req *pactus.SimulateTransactionRequest,
+) (*pactus.SimulateTransactionResponse, error) {
+	trx, err := tx.FromBytes(req.RawTransaction)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "couldn't decode transaction: %v", err.Error())
+	}
+
+	// Unsigned transactions can be simulated, so the signature is only verified if it is present.
+	if trx.IsSigned() {
+		err = trx.BasicCheck()
+	} else {
+		err = trx.Payload().BasicCheck()
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "couldn't verify transaction: %v", err.Error())
+	}
+
+	res := &pactus.SimulateTransactionResponse{
+		Id: trx.ID().Bytes(),
+	}
+	rcpt, err := s.state.SimulateTransaction(trx)
+	if err != nil {
+		res.Error = err.Error()
+	} else {
+		res.Receipt = receiptToProto(rcpt)
+	}
+
+	return res, nil
+}
+
 func (s *transactionServer) CalculateFee(_ context.Context,
 	req *pactus.CalculateFeeRequest,
 ) (*pactus.CalculateFeeResponse, error) {
 	amt := amount.Amount(req.Amount)
-	fee := s.state.CalculateFee(amt, payload.Type(req.PayloadType))
+	var fee amount.Amount
+	if payload.Type(req.PayloadType) == payload.TypeBatchTransfer {
+		fee = s.state.CalculateBatchTransferFee(amt, int(req.Recipients))
+	} else {
+		fee = s.state.CalculateFee(amt, payload.Type(req.PayloadType))
+	}
 
 	if req.FixedAmount {
 		amt -= fee
@@ -174,7 +201,12 @@ func (s *transactionServer) GetRawUnbondTransaction(_ context.Context,
 
 	lockTime := s.getLockTime(req.LockTime)
 
-	unbondTx := tx.NewUnbondTx(lockTime, validatorAddr, req.Memo)
+	var unbondTx *tx.Tx
+	if req.Amount > 0 {
+		unbondTx = tx.NewPartialUnbondTx(lockTime, validatorAddr, amount.Amount(req.Amount), req.Memo)
+	} else {
+		unbondTx = tx.NewUnbondTx(lockTime, validatorAddr, req.Memo)
+	}
 	rawTx, err := unbondTx.Bytes()
 	if err != nil {
 		return nil, err
@@ -213,6 +245,85 @@ func (s *transactionServer) GetRawWithdrawTransaction(_ context.Context,
 	}, nil
 }
 
+func (s *transactionServer) GetRawBatchTransferTransaction(_ context.Context,
+	req *pactus.GetRawBatchTransferTransactionRequest,
+) (*pactus.GetRawTransactionResponse, error) {
+	sender, err := crypto.AddressFromString(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Receivers) != len(req.Amounts) {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"number of receivers and amounts are not equal: %d != %d",
+			len(req.Receivers), len(req.Amounts))
+	}
+
+	total := amount.Amount(0)
+	recipients := make([]payload.BatchTransferRecipient, 0, len(req.Receivers))
+	for i, addr := range req.Receivers {
+		receiver, err := crypto.AddressFromString(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		amt := amount.Amount(req.Amounts[i])
+		total += amt
+		recipients = append(recipients, payload.BatchTransferRecipient{
+			To:     receiver,
+			Amount: amt,
+		})
+	}
+
+	fee := amount.Amount(req.Fee)
+	if fee == 0 {
+		fee = s.state.CalculateBatchTransferFee(total, len(recipients))
+	}
+	lockTime := s.getLockTime(req.LockTime)
+
+	batchTx := tx.NewBatchTransferTx(lockTime, sender, recipients, fee, req.Memo)
+	rawTx, err := batchTx.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pactus.GetRawTransactionResponse{
+		RawTransaction: rawTx,
+	}, nil
+}
+
+func (s *transactionServer) GetRawVestingTransferTransaction(_ context.Context,
+	req *pactus.GetRawVestingTransferTransactionRequest,
+) (*pactus.GetRawTransactionResponse, error) {
+	sender, err := crypto.AddressFromString(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := crypto.AddressFromString(req.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	amt := amount.Amount(req.Amount)
+	fee := amount.Amount(req.Fee)
+	if fee == 0 {
+		fee = s.state.CalculateFee(amt, payload.TypeVestingTransfer)
+	}
+	lockTime := s.getLockTime(req.LockTime)
+
+	vestingTx := tx.NewVestingTransferTx(lockTime, sender, receiver, amt,
+		req.StartHeight, req.EndHeight, fee, req.Memo)
+	rawTx, err := vestingTx.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pactus.GetRawTransactionResponse{
+		RawTransaction: rawTx,
+	}, nil
+}
+
 func (s *transactionServer) getFee(f int64, amt amount.Amount) amount.Amount {
 	fee := amount.Amount(f)
 	if fee == 0 {
@@ -230,6 +341,64 @@ func (s *transactionServer) getLockTime(lockTime uint32) uint32 {
 	return lockTime
 }
 
+func committedTxToProto(committedTx *store.CommittedTx,
+	verbosity pactus.TransactionVerbosity,
+) (*pactus.GetTransactionResponse, error) {
+	res := &pactus.GetTransactionResponse{
+		BlockHeight: committedTx.Height,
+		BlockTime:   committedTx.BlockTime,
+	}
+
+	switch verbosity {
+	case pactus.TransactionVerbosity_TRANSACTION_DATA:
+		res.Transaction = &pactus.TransactionInfo{
+			Id:   committedTx.TxID.Bytes(),
+			Data: committedTx.Data,
+		}
+
+	case pactus.TransactionVerbosity_TRANSACTION_INFO:
+		trx, err := committedTx.ToTx()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		res.Transaction = transactionToProto(trx)
+	}
+
+	return res, nil
+}
+
+// receiptReader reads the receipts of the committed transactions.
+// Both the state and its read snapshots implement it.
+type receiptReader interface {
+	LastBlockHeight() uint32
+	TxReceipt(id tx.ID) *receipt.Receipt
+}
+
+// setReceipt sets the receipt and the number of confirmations of a committed transaction.
+func setReceipt(reader receiptReader, res *pactus.GetTransactionResponse, id tx.ID) {
+	res.Confirmations = reader.LastBlockHeight() - res.BlockHeight + 1
+	if rcpt := reader.TxReceipt(id); rcpt != nil {
+		res.Receipt = receiptToProto(rcpt)
+	}
+}
+
+func receiptToProto(rcpt *receipt.Receipt) *pactus.TransactionReceipt {
+	changes := make([]*pactus.ReceiptChange, 0, len(rcpt.Changes))
+	for _, change := range rcpt.Changes {
+		changes = append(changes, &pactus.ReceiptChange{
+			Address:      change.Address.String(),
+			BalanceDelta: change.BalanceDelta.ToNanoPAC(),
+			StakeDelta:   change.StakeDelta.ToNanoPAC(),
+			PowerDelta:   change.PowerDelta,
+		})
+	}
+
+	return &pactus.TransactionReceipt{
+		Fee:     rcpt.Fee.ToNanoPAC(),
+		Changes: changes,
+	}
+}
+
 func transactionToProto(trx *tx.Tx) *pactus.TransactionInfo {
 	transaction := &pactus.TransactionInfo{
 		Id:          trx.ID().Bytes(),
@@ -283,6 +452,23 @@ func transactionToProto(trx *tx.Tx) *pactus.TransactionInfo {
 				Validator: pld.Validator.String(),
 			},
 		}
+	case payload.TypePartialUnbond:
+		pld := trx.Payload().(*payload.PartialUnbondPayload)
+		transaction.Payload = &pactus.TransactionInfo_PartialUnbond{
+			PartialUnbond: &pactus.PayloadPartialUnbond{
+				Validator: pld.Validator.String(),
+				Amount:    pld.Amount.ToNanoPAC(),
+			},
+		}
+	case payload.TypeRotateKey:
+		pld := trx.Payload().(*payload.RotateKeyPayload)
+		transaction.Payload = &pactus.TransactionInfo_RotateKey{
+			RotateKey: &pactus.PayloadRotateKey{
+				Validator:    pld.Validator.String(),
+				NewPublicKey: pld.NewPublicKey.String(),
+				NewAddress:   pld.NewAddress().String(),
+			},
+		}
 	case payload.TypeWithdraw:
 		pld := trx.Payload().(*payload.WithdrawPayload)
 		transaction.Payload = &pactus.TransactionInfo_Withdraw{
@@ -292,6 +478,45 @@ func transactionToProto(trx *tx.Tx) *pactus.TransactionInfo {
 				Amount: pld.Amount.ToNanoPAC(),
 			},
 		}
+	case payload.TypeSlash:
+		pld := trx.Payload().(*payload.SlashPayload)
+		vote1, _ := pld.Vote1.MarshalCBOR()
+		vote2, _ := pld.Vote2.MarshalCBOR()
+		transaction.Payload = &pactus.TransactionInfo_Slash{
+			Slash: &pactus.PayloadSlash{
+				Reporter: pld.Reporter.String(),
+				Offender: pld.Offender().String(),
+				Height:   pld.Vote1.Height(),
+				Vote1:    vote1,
+				Vote2:    vote2,
+			},
+		}
+	case payload.TypeBatchTransfer:
+		pld := trx.Payload().(*payload.BatchTransferPayload)
+		recipients := make([]*pactus.BatchTransferRecipient, 0, len(pld.Recipients))
+		for _, rcp := range pld.Recipients {
+			recipients = append(recipients, &pactus.BatchTransferRecipient{
+				Receiver: rcp.To.String(),
+				Amount:   rcp.Amount.ToNanoPAC(),
+			})
+		}
+		transaction.Payload = &pactus.TransactionInfo_BatchTransfer{
+			BatchTransfer: &pactus.PayloadBatchTransfer{
+				Sender:     pld.From.String(),
+				Recipients: recipients,
+			},
+		}
+	case payload.TypeVestingTransfer:
+		pld := trx.Payload().(*payload.VestingTransferPayload)
+		transaction.Payload = &pactus.TransactionInfo_VestingTransfer{
+			VestingTransfer: &pactus.PayloadVestingTransfer{
+				Sender:      pld.From.String(),
+				Receiver:    pld.To.String(),
+				Amount:      pld.Amount.ToNanoPAC(),
+				StartHeight: pld.StartHeight,
+				EndHeight:   pld.EndHeight,
+			},
+		}
 	default:
 		logger.Error("payload type not defined", "type", trx.Payload().Type())
 	}
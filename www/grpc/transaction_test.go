@@ -4,11 +4,14 @@ import (
 	"context"
 	"testing"
 
+	"github.com/pactus-project/pactus/execution/executor"
 	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetTransaction(t *testing.T) {
@@ -61,6 +64,26 @@ func TestGetTransaction(t *testing.T) {
 		assert.Equal(t, trx1.Payload().(*payload.TransferPayload).To.String(), pld.Transfer.Receiver)
 	})
 
+	t.Run("Should return receipt and confirmations", func(t *testing.T) {
+		signer := trx1.Payload().Signer()
+		td.mockState.TestStore.SaveReceipt(trx1.ID(), &receipt.Receipt{
+			Fee: trx1.Fee(),
+			Changes: []receipt.Change{
+				{Address: signer, BalanceDelta: -(trx1.Payload().Value() + trx1.Fee())},
+			},
+		})
+		td.mockState.TestStore.AddTestBlock(2)
+
+		res, err := client.GetTransaction(context.Background(),
+			&pactus.GetTransactionRequest{Id: trx1.ID().Bytes()})
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(2), res.Confirmations)
+		assert.Equal(t, trx1.Fee().ToNanoPAC(), res.Receipt.Fee)
+		assert.Len(t, res.Receipt.Changes, 1)
+		assert.Equal(t, signer.String(), res.Receipt.Changes[0].Address)
+		assert.Equal(t, -(trx1.Payload().Value() + trx1.Fee()).ToNanoPAC(), res.Receipt.Changes[0].BalanceDelta)
+	})
+
 	t.Run("Should return nil value because transaction id is invalid", func(t *testing.T) {
 		res, err := client.GetTransaction(context.Background(),
 			&pactus.GetTransactionRequest{Id: []byte("invalid_id")})
@@ -76,6 +99,33 @@ func TestGetTransaction(t *testing.T) {
 		assert.Nil(t, res)
 	})
 
+	t.Run("Vesting transfer", func(t *testing.T) {
+		amt := td.RandAmount()
+		res, err := client.GetRawVestingTransferTransaction(context.Background(),
+			&pactus.GetRawVestingTransferTransactionRequest{
+				Sender:      td.RandAccAddress().String(),
+				Receiver:    td.RandAccAddress().String(),
+				Amount:      amt.ToNanoPAC(),
+				StartHeight: 100,
+				EndHeight:   200,
+				Memo:        td.RandString(32),
+			})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.RawTransaction)
+
+		decodedTrx, _ := tx.FromBytes(res.RawTransaction)
+		expectedLockTime := td.mockState.LastBlockHeight()
+		expectedFee := td.mockState.CalculateFee(amt, payload.TypeVestingTransfer)
+		pld := decodedTrx.Payload().(*payload.VestingTransferPayload)
+
+		assert.True(t, decodedTrx.IsVestingTransferTx())
+		assert.Equal(t, amt, decodedTrx.Payload().Value())
+		assert.Equal(t, uint32(100), pld.StartHeight)
+		assert.Equal(t, uint32(200), pld.EndHeight)
+		assert.Equal(t, expectedLockTime, decodedTrx.LockTime())
+		assert.Equal(t, expectedFee, decodedTrx.Fee())
+	})
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 	td.StopServer()
 }
@@ -119,6 +169,71 @@ func TestSendRawTransaction(t *testing.T) {
 	td.StopServer()
 }
 
+func TestSimulateTransaction(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.transactionClient(t)
+
+	acc, sender := td.mockState.TestStore.AddTestAccount()
+	receiver := td.RandAccAddress()
+	lockTime := td.mockState.LastBlockHeight() + 1
+
+	t.Run("Should fail, invalid data", func(t *testing.T) {
+		res, err := client.SimulateTransaction(context.Background(),
+			&pactus.SimulateTransactionRequest{RawTransaction: []byte("00000000")})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should fail, transaction with invalid signature", func(t *testing.T) {
+		trx, _ := td.GenerateTestTransferTx()
+		_, pValKey := td.GenerateTestTransferTx()
+		trx.SetSignature(pValKey.Sign(trx.SignBytes()))
+		data, _ := trx.Bytes()
+		res, err := client.SimulateTransaction(context.Background(),
+			&pactus.SimulateTransactionRequest{RawTransaction: data})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return the executor error", func(t *testing.T) {
+		amt := acc.Balance() + 1
+		fee := td.mockState.CalculateFee(amt, payload.TypeTransfer)
+		trx := tx.NewTransferTx(lockTime, sender, receiver, amt, fee, "")
+		data, _ := trx.Bytes()
+		res, err := client.SimulateTransaction(context.Background(),
+			&pactus.SimulateTransactionRequest{RawTransaction: data})
+		assert.NoError(t, err)
+		assert.Equal(t, trx.ID().Bytes(), res.Id)
+		assert.Nil(t, res.Receipt)
+		assert.Equal(t, executor.ErrInsufficientFunds.Error(), res.Error)
+	})
+
+	t.Run("Should simulate an unsigned transaction", func(t *testing.T) {
+		amt := acc.Balance() / 2
+		fee := td.mockState.CalculateFee(amt, payload.TypeTransfer)
+		trx := tx.NewTransferTx(lockTime, sender, receiver, amt, fee, "")
+		data, _ := trx.Bytes()
+		res, err := client.SimulateTransaction(context.Background(),
+			&pactus.SimulateTransactionRequest{RawTransaction: data})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Error)
+		assert.Equal(t, fee.ToNanoPAC(), res.Receipt.Fee)
+		require.Len(t, res.Receipt.Changes, 2)
+		assert.Equal(t, sender.String(), res.Receipt.Changes[0].Address)
+		assert.Equal(t, (-amt - fee).ToNanoPAC(), res.Receipt.Changes[0].BalanceDelta)
+		assert.Equal(t, receiver.String(), res.Receipt.Changes[1].Address)
+		assert.Equal(t, amt.ToNanoPAC(), res.Receipt.Changes[1].BalanceDelta)
+
+		// The state should not be changed.
+		updatedAcc, _ := td.mockState.TestStore.Account(sender)
+		assert.Equal(t, acc.Balance(), updatedAcc.Balance())
+		assert.False(t, td.mockState.TestStore.HasAccount(receiver))
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
 func TestGetRawTransaction(t *testing.T) {
 	td := setup(t, nil)
 	conn, client := td.transactionClient(t)
@@ -180,11 +295,32 @@ func TestGetRawTransaction(t *testing.T) {
 		decodedTrx, _ := tx.FromBytes(res.RawTransaction)
 		expectedLockTime := td.mockState.LastBlockHeight()
 
+		assert.True(t, decodedTrx.IsUnbondTx())
 		assert.Zero(t, decodedTrx.Payload().Value())
 		assert.Equal(t, expectedLockTime, decodedTrx.LockTime())
 		assert.Zero(t, decodedTrx.Fee())
 	})
 
+	t.Run("Partial unbond", func(t *testing.T) {
+		amt := td.RandAmount() + 1
+		res, err := client.GetRawUnbondTransaction(context.Background(),
+			&pactus.GetRawUnbondTransactionRequest{
+				ValidatorAddress: td.RandValAddress().String(),
+				Amount:           amt.ToNanoPAC(),
+				Memo:             td.RandString(32),
+			})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.RawTransaction)
+
+		decodedTrx, _ := tx.FromBytes(res.RawTransaction)
+		expectedLockTime := td.mockState.LastBlockHeight()
+
+		assert.True(t, decodedTrx.IsPartialUnbondTx())
+		assert.Equal(t, amt, decodedTrx.Payload().Value())
+		assert.Equal(t, expectedLockTime, decodedTrx.LockTime())
+		assert.Zero(t, decodedTrx.Fee())
+	})
+
 	t.Run("Withdraw", func(t *testing.T) {
 		amt := td.RandAmount()
 		res, err := client.GetRawWithdrawTransaction(context.Background(),
@@ -207,6 +343,40 @@ func TestGetRawTransaction(t *testing.T) {
 		assert.Equal(t, expectedFee, decodedTrx.Fee())
 	})
 
+	t.Run("Batch transfer", func(t *testing.T) {
+		amt1 := td.RandAmount()
+		amt2 := td.RandAmount()
+		res, err := client.GetRawBatchTransferTransaction(context.Background(),
+			&pactus.GetRawBatchTransferTransactionRequest{
+				Sender:    td.RandAccAddress().String(),
+				Receivers: []string{td.RandAccAddress().String(), td.RandAccAddress().String()},
+				Amounts:   []int64{amt1.ToNanoPAC(), amt2.ToNanoPAC()},
+				Memo:      td.RandString(32),
+			})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.RawTransaction)
+
+		decodedTrx, _ := tx.FromBytes(res.RawTransaction)
+		expectedLockTime := td.mockState.LastBlockHeight()
+		expectedFee := td.mockState.CalculateBatchTransferFee(amt1+amt2, 2)
+
+		assert.True(t, decodedTrx.IsBatchTransferTx())
+		assert.Equal(t, amt1+amt2, decodedTrx.Payload().Value())
+		assert.Equal(t, expectedLockTime, decodedTrx.LockTime())
+		assert.Equal(t, expectedFee, decodedTrx.Fee())
+	})
+
+	t.Run("Batch transfer, mismatched amounts", func(t *testing.T) {
+		res, err := client.GetRawBatchTransferTransaction(context.Background(),
+			&pactus.GetRawBatchTransferTransactionRequest{
+				Sender:    td.RandAccAddress().String(),
+				Receivers: []string{td.RandAccAddress().String(), td.RandAccAddress().String()},
+				Amounts:   []int64{td.RandAmount().ToNanoPAC()},
+			})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 	td.StopServer()
 }
@@ -257,6 +427,20 @@ func TestCalculateFee(t *testing.T) {
 		assert.Equal(t, res.Fee, expectedFee.ToNanoPAC())
 	})
 
+	t.Run("Batch transfer", func(t *testing.T) {
+		amt := amount.Amount(1e6)
+		expectedFee := 3 * td.mockState.TestParams.MinimumFee
+		res, err := client.CalculateFee(context.Background(),
+			&pactus.CalculateFeeRequest{
+				Amount:      amt.ToNanoPAC(),
+				PayloadType: pactus.PayloadType_BATCH_TRANSFER_PAYLOAD,
+				Recipients:  3,
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, res.Amount, amt.ToNanoPAC())
+		assert.Equal(t, res.Fee, expectedFee.ToNanoPAC())
+	})
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 	td.StopServer()
 }
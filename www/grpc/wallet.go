@@ -200,7 +200,8 @@ func (s *walletServer) GetNewAddress(_ context.Context,
 		}
 		addressInfo = info
 
-	case pactus.AddressType(crypto.AddressTypeTreasury):
+	case pactus.AddressType(crypto.AddressTypeTreasury),
+		pactus.AddressType(crypto.AddressTypeMultisigAccount):
 		return nil, status.Errorf(codes.InvalidArgument, "invalid address type")
 
 	default:
@@ -2,6 +2,7 @@ package http
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
@@ -180,6 +181,12 @@ func (s *Server) GetAccountHandler(w http.ResponseWriter, r *http.Request) {
 	tm.addRowAccAddress("Address", acc.Address)
 	tm.addRowInt("Number", int(acc.Number))
 	tm.addRowAmount("Balance", amount.Amount(acc.Balance))
+	for i, vesting := range acc.Vestings {
+		tm.addRowInt(fmt.Sprintf("Vesting %d Start Height", i+1), int(vesting.StartHeight))
+		tm.addRowInt(fmt.Sprintf("Vesting %d End Height", i+1), int(vesting.EndHeight))
+		tm.addRowAmount(fmt.Sprintf("Vesting %d Amount", i+1), amount.Amount(vesting.Amount))
+		tm.addRowAmount(fmt.Sprintf("Vesting %d Locked", i+1), amount.Amount(vesting.Locked))
+	}
 	tm.addRowBytes("Hash", acc.Hash)
 
 	s.writeHTML(w, tm.html())
@@ -260,6 +267,10 @@ func (s *Server) writeValidatorTable(val *pactus.ValidatorInfo) *tableMaker {
 	tm.addRowInt("LastBondingHeight", int(val.LastBondingHeight))
 	tm.addRowInt("LastSortitionHeight", int(val.LastSortitionHeight))
 	tm.addRowInt("UnbondingHeight", int(val.UnbondingHeight))
+	for i, entry := range val.UnbondingEntries {
+		tm.addRowAmount(fmt.Sprintf("Unbonding %d Amount", i+1), amount.Amount(entry.Amount))
+		tm.addRowInt(fmt.Sprintf("Unbonding %d Height", i+1), int(entry.Height))
+	}
 	tm.addRowDouble("AvailabilityScore", val.AvailabilityScore)
 	tm.addRowBytes("Hash", val.Hash)
 
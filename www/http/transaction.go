@@ -2,6 +2,7 @@ package http
 
 import (
 	"encoding/hex"
+	"fmt"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -82,12 +83,47 @@ func txToTable(trx *pactus.TransactionInfo, tm *tableMaker) {
 		pld := trx.Payload.(*pactus.TransactionInfo_Unbond).Unbond
 		tm.addRowValAddress("Validator", pld.Validator)
 
+	case pactus.PayloadType_PARTIAL_UNBOND_PAYLOAD:
+		pld := trx.Payload.(*pactus.TransactionInfo_PartialUnbond).PartialUnbond
+		tm.addRowValAddress("Validator", pld.Validator)
+		tm.addRowAmount("Amount", amount.Amount(pld.Amount))
+
+	case pactus.PayloadType_ROTATE_KEY_PAYLOAD:
+		pld := trx.Payload.(*pactus.TransactionInfo_RotateKey).RotateKey
+		tm.addRowValAddress("Validator", pld.Validator)
+		tm.addRowString("New public key", pld.NewPublicKey)
+		tm.addRowValAddress("New address", pld.NewAddress)
+
 	case pactus.PayloadType_WITHDRAW_PAYLOAD:
 		pld := trx.Payload.(*pactus.TransactionInfo_Withdraw).Withdraw
 		tm.addRowValAddress("Sender", pld.From)
 		tm.addRowAccAddress("Receiver", pld.To)
 		tm.addRowAmount("Amount", amount.Amount(pld.Amount))
 
+	case pactus.PayloadType_SLASH_PAYLOAD:
+		pld := trx.Payload.(*pactus.TransactionInfo_Slash).Slash
+		tm.addRowAccAddress("Reporter", pld.Reporter)
+		tm.addRowValAddress("Offender", pld.Offender)
+		tm.addRowInt("Height", int(pld.Height))
+		tm.addRowBytes("Vote1", pld.Vote1)
+		tm.addRowBytes("Vote2", pld.Vote2)
+
+	case pactus.PayloadType_BATCH_TRANSFER_PAYLOAD:
+		pld := trx.Payload.(*pactus.TransactionInfo_BatchTransfer).BatchTransfer
+		tm.addRowAccAddress("Sender", pld.Sender)
+		for i, rcp := range pld.Recipients {
+			tm.addRowAccAddress(fmt.Sprintf("Receiver %d", i+1), rcp.Receiver)
+			tm.addRowAmount(fmt.Sprintf("Amount %d", i+1), amount.Amount(rcp.Amount))
+		}
+
+	case pactus.PayloadType_VESTING_TRANSFER_PAYLOAD:
+		pld := trx.Payload.(*pactus.TransactionInfo_VestingTransfer).VestingTransfer
+		tm.addRowAccAddress("Sender", pld.Sender)
+		tm.addRowAccAddress("Receiver", pld.Receiver)
+		tm.addRowAmount("Amount", amount.Amount(pld.Amount))
+		tm.addRowInt("Start Height", int(pld.StartHeight))
+		tm.addRowInt("End Height", int(pld.EndHeight))
+
 	case pactus.PayloadType_UNKNOWN:
 		tm.addRowValAddress("error", "unknown payload type")
 	}